@@ -4,10 +4,12 @@ import lru "github.com/hashicorp/golang-lru"
 
 var txCache *lru.ARCCache
 var balanceCache *lru.ARCCache
+var prevoutCache *lru.ARCCache
 
 func init() {
 	txCache, _ = lru.NewARC(1000)
 	balanceCache, _ = lru.NewARC(1000)
+	prevoutCache, _ = lru.NewARC(100000)
 }
 
 func GetTxCache() *lru.ARCCache {
@@ -17,3 +19,7 @@ func GetTxCache() *lru.ARCCache {
 func GetBalanceCache() *lru.ARCCache {
 	return balanceCache
 }
+
+func GetPrevoutCache() *lru.ARCCache {
+	return prevoutCache
+}
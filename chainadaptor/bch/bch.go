@@ -0,0 +1,37 @@
+package bch
+
+import (
+	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+const (
+	ChainName = "bch"
+	Symbol    = "bch"
+)
+
+// Chain is bitcoin cash: the bitcoin networks with CashAddr addresses and
+// SIGHASH_FORKID signatures
+var Chain = &bitcoin.Chain{
+	Name:   ChainName,
+	Symbol: Symbol,
+	Params: bitcoin.BitcoinParams,
+	Node: func(conf *config.Config) config.Node {
+		return conf.Fullnode.Bch
+	},
+	Addresses: cashAddrCodec{},
+	SigHasher: forkIDSigHasher{},
+	TxCodec:   bitcoin.WireTxCodec{},
+	Policy:    bitcoin.BitcoinPolicy,
+	// sendrawtransaction of Bitcoin Cash Node still takes allowhighfees
+	MaxFeeRateVersion: 0,
+}
+
+func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
+	return bitcoin.NewChainAdaptorWithChain(conf, Chain)
+}
+
+func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
+	return bitcoin.NewLocalChainAdaptorWithChain(network, Chain)
+}
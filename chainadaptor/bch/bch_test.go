@@ -0,0 +1,130 @@
+package bch
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin/bitcointest"
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestCashAddr(t *testing.T) {
+	hash, err := hex.DecodeString("76a04053bda0a88bda5177b86a15c3b29f559873")
+	require.Nil(t, err)
+	p2pkh, err := btcutil.NewAddressPubKeyHash(hash, &chaincfg.MainNetParams)
+	require.Nil(t, err)
+	p2sh, err := btcutil.NewAddressScriptHashFromHash(hash, &chaincfg.MainNetParams)
+	require.Nil(t, err)
+	testP2pkh, err := btcutil.NewAddressPubKeyHash(hash, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+
+	codec := cashAddrCodec{}
+	vectors := []struct {
+		addr     btcutil.Address
+		params   *chaincfg.Params
+		cashAddr string
+	}{
+		{p2pkh, &chaincfg.MainNetParams, "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"},
+		{p2sh, &chaincfg.MainNetParams, "bitcoincash:ppm2qsznhks23z7629mms6s4cwef74vcwvn0h829pq"},
+		{testP2pkh, &chaincfg.TestNet3Params, "bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvqcw003ap"},
+	}
+	for _, v := range vectors {
+		assert.Equal(t, v.cashAddr, codec.EncodeAddress(v.addr, v.params))
+
+		decoded, err := codec.DecodeAddress(v.cashAddr, v.params)
+		require.Nil(t, err)
+		assert.Equal(t, v.addr.String(), decoded.String())
+
+		// the prefix may be left out and the address may be upper case
+		decoded, err = codec.DecodeAddress(v.cashAddr[len(v.cashAddr)-42:], v.params)
+		require.Nil(t, err)
+		assert.Equal(t, v.addr.String(), decoded.String())
+		_, err = codec.DecodeAddress(string(bytes.ToUpper([]byte(v.cashAddr))), v.params)
+		assert.Nil(t, err)
+
+		// legacy addresses are still accepted
+		decoded, err = codec.DecodeAddress(v.addr.EncodeAddress(), v.params)
+		require.Nil(t, err)
+		assert.Equal(t, v.cashAddr, codec.EncodeAddress(decoded, v.params))
+	}
+
+	illegal := []string{
+		// wrong checksum
+		"bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6b",
+		// prefix of another network
+		"bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		// mixed case
+		"bitcoincash:Qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a",
+		// segwit never was on bch
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		"bitcoincash:",
+	}
+	for _, addr := range illegal {
+		_, err := codec.DecodeAddress(addr, &chaincfg.MainNetParams)
+		assert.NotNil(t, err, addr)
+	}
+}
+
+func TestCreateSignedTransactionOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet)
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("bch offline sign key"))
+	convertReply, err := adaptor.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	from := convertReply.Address
+	assert.Contains(t, from, "bchtest:q")
+
+	validReply, err := adaptor.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: from})
+	require.Nil(t, err)
+	assert.True(t, validReply.Valid)
+	assert.Equal(t, from, validReply.CanonicalAddress)
+	_, err = adaptor.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: "bitcoincash:qpm2qsznhks23z7629mms6s4cwef74vcwvy22gdx6a"})
+	assert.NotNil(t, err)
+
+	vins := []*proto.Vin{
+		{Hash: "c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9", Index: 0, Amount: 32500000, Address: from},
+		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: 1, Amount: 33000, Address: from},
+	}
+	createReply, err := adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain:  ChainName,
+		Symbol: Symbol,
+		Vins:   vins,
+		Vouts: []*proto.Vout{
+			{Address: "bchtest:qpm2qsznhks23z7629mms6s4cwef74vcwvqcw003ap", Amount: 32000000},
+			{Address: from, Amount: 513000},
+		},
+		Fee: "20000",
+	})
+	require.Nil(t, err)
+
+	signedReply := bitcointest.SignUtxoTransaction(t, adaptor, Chain, createReply, vins, privKey)
+
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(signedReply.SignedTxData)))
+	for _, in := range msgTx.TxIn {
+		pushes, err := txscript.PushedData(in.SignatureScript)
+		require.Nil(t, err)
+		sig := pushes[0]
+		assert.Equal(t, byte(txscript.SigHashAll|SigHashForkID), sig[len(sig)-1])
+	}
+
+	// the BIP143 digest commits to the amount spent
+	vins[1].Amount++
+	_, err = adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		SignedTxData: signedReply.SignedTxData,
+		Vins:         vins,
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,196 @@
+package bch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	cashAddrCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// type bits of the CashAddr version byte, the size bits are 0 for 160 bit hashes
+	cashAddrTypeP2PKH = 0
+	cashAddrTypeP2SH  = 1
+
+	cashAddrChecksumLen = 8
+)
+
+// cashAddrCodec encodes addresses in CashAddr and also accepts legacy base58 ones
+type cashAddrCodec struct{}
+
+func (cashAddrCodec) DecodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	if !strings.Contains(address, ":") {
+		// the legacy format is still valid for payments, segwit never was on bch
+		if addr, err := btcutil.DecodeAddress(address, params); err == nil {
+			switch addr.(type) {
+			case *btcutil.AddressPubKeyHash, *btcutil.AddressScriptHash:
+				return addr, nil
+			}
+			return nil, fmt.Errorf("unsupported address type %T", addr)
+		}
+	}
+
+	prefix, err := cashAddrPrefix(params)
+	if err != nil {
+		return nil, err
+	}
+	typ, hash, err := decodeCashAddr(address, prefix)
+	if err != nil {
+		return nil, err
+	}
+	switch typ {
+	case cashAddrTypeP2PKH:
+		return btcutil.NewAddressPubKeyHash(hash, params)
+	case cashAddrTypeP2SH:
+		return btcutil.NewAddressScriptHashFromHash(hash, params)
+	}
+	return nil, fmt.Errorf("unsupported cashaddr type %d", typ)
+}
+
+func (cashAddrCodec) EncodeAddress(address btcutil.Address, params *chaincfg.Params) string {
+	prefix, err := cashAddrPrefix(params)
+	if err != nil {
+		return ""
+	}
+	switch addr := address.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return encodeCashAddr(prefix, cashAddrTypeP2PKH, addr.Hash160()[:])
+	case *btcutil.AddressScriptHash:
+		return encodeCashAddr(prefix, cashAddrTypeP2SH, addr.Hash160()[:])
+	case *btcutil.AddressPubKey:
+		return encodeCashAddr(prefix, cashAddrTypeP2PKH, addr.AddressPubKeyHash().Hash160()[:])
+	}
+	return ""
+}
+
+// cashAddrPrefix returns the human readable prefix of the network
+func cashAddrPrefix(params *chaincfg.Params) (string, error) {
+	switch params.Name {
+	case chaincfg.MainNetParams.Name:
+		return "bitcoincash", nil
+	case chaincfg.TestNet3Params.Name:
+		return "bchtest", nil
+	case chaincfg.RegressionNetParams.Name:
+		return "bchreg", nil
+	}
+	return "", fmt.Errorf("no cashaddr prefix for network %s", params.Name)
+}
+
+// encodeCashAddr encodes a 160 bit hash of the type with the prefix
+func encodeCashAddr(prefix string, typ byte, hash []byte) string {
+	payload := convertBits(append([]byte{typ << 3}, hash...), 8, 5, true)
+	checksum := cashAddrPolymod(append(append(prefixData(prefix), payload...), make([]byte, cashAddrChecksumLen)...))
+
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteByte(':')
+	for _, b := range payload {
+		sb.WriteByte(cashAddrCharset[b])
+	}
+	for i := 0; i < cashAddrChecksumLen; i++ {
+		sb.WriteByte(cashAddrCharset[(checksum>>uint(5*(cashAddrChecksumLen-1-i)))&0x1f])
+	}
+	return sb.String()
+}
+
+// decodeCashAddr decodes an address with or without the expected prefix into
+// its type and 160 bit hash
+func decodeCashAddr(address, prefix string) (byte, []byte, error) {
+	if strings.ToLower(address) != address && strings.ToUpper(address) != address {
+		return 0, nil, errors.New("cashaddr must not be mixed case")
+	}
+	address = strings.ToLower(address)
+	if i := strings.LastIndexByte(address, ':'); i >= 0 {
+		if address[:i] != prefix {
+			return 0, nil, fmt.Errorf("cashaddr prefix %s is not %s", address[:i], prefix)
+		}
+		address = address[i+1:]
+	}
+
+	data := make([]byte, len(address))
+	for i := range address {
+		c := strings.IndexByte(cashAddrCharset, address[i])
+		if c < 0 {
+			return 0, nil, fmt.Errorf("invalid cashaddr character %q", address[i])
+		}
+		data[i] = byte(c)
+	}
+	if len(data) <= cashAddrChecksumLen {
+		return 0, nil, errors.New("cashaddr is too short")
+	}
+	if cashAddrPolymod(append(prefixData(prefix), data...)) != 0 {
+		return 0, nil, errors.New("invalid cashaddr checksum")
+	}
+
+	payload := convertBits(data[:len(data)-cashAddrChecksumLen], 5, 8, false)
+	if payload == nil || len(payload) != 1+20 {
+		return 0, nil, errors.New("invalid cashaddr payload")
+	}
+	if payload[0]&0x07 != 0 {
+		return 0, nil, fmt.Errorf("unsupported cashaddr hash size bits %d", payload[0]&0x07)
+	}
+	return payload[0] >> 3, payload[1:], nil
+}
+
+// prefixData returns the lower 5 bits of the prefix characters followed by the separator
+func prefixData(prefix string) []byte {
+	data := make([]byte, 0, len(prefix)+1)
+	for i := range prefix {
+		data = append(data, prefix[i]&0x1f)
+	}
+	return append(data, 0)
+}
+
+// cashAddrPolymod is the BCH code checksum of the CashAddr spec
+func cashAddrPolymod(data []byte) uint64 {
+	c := uint64(1)
+	for _, d := range data {
+		c0 := byte(c >> 35)
+		c = ((c & 0x07ffffffff) << 5) ^ uint64(d)
+		if c0&0x01 != 0 {
+			c ^= 0x98f2bc8e61
+		}
+		if c0&0x02 != 0 {
+			c ^= 0x79b76d99e2
+		}
+		if c0&0x04 != 0 {
+			c ^= 0xf33e5fb3c4
+		}
+		if c0&0x08 != 0 {
+			c ^= 0xae2eabe2a8
+		}
+		if c0&0x10 != 0 {
+			c ^= 0x1e4f43e470
+		}
+	}
+	return c ^ 1
+}
+
+// convertBits regroups data of fromBits wide values into toBits wide ones. Without
+// pad it returns nil if bits are left over that are not zero padding.
+func convertBits(data []byte, fromBits, toBits uint, pad bool) []byte {
+	var acc uint
+	var bits uint
+	maxv := uint(1)<<toBits - 1
+	var out []byte
+	for _, d := range data {
+		acc = acc<<fromBits | uint(d)
+		bits += fromBits
+		for bits >= toBits {
+			bits -= toBits
+			out = append(out, byte(acc>>bits&maxv))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			out = append(out, byte(acc<<(toBits-bits)&maxv))
+		}
+	} else if bits >= fromBits || acc<<(toBits-bits)&maxv != 0 {
+		return nil
+	}
+	return out
+}
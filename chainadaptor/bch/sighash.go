@@ -0,0 +1,44 @@
+package bch
+
+import (
+	"errors"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+)
+
+// SigHashForkID flags signatures of the bitcoin cash fork, which hash every input
+// the BIP143 way so that they are not valid on bitcoin
+const SigHashForkID txscript.SigHashType = 0x40
+
+// forkIDSigHasher hashes inputs with SIGHASH_FORKID and a fork id of 0
+type forkIDSigHasher struct{}
+
+func (forkIDSigHasher) HashType(hashType txscript.SigHashType) txscript.SigHashType {
+	return hashType | SigHashForkID
+}
+
+func (h forkIDSigHasher) SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType) ([]byte, error) {
+	return forkIDSignHash(tx, idx, prevOuts, h.HashType(hashType))
+}
+
+// VerifySignature checks a p2pkh spend, the txscript engine knows no fork id
+func (forkIDSigHasher) VerifySignature(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) error {
+	return bitcoin.VerifyP2PKHSignature(tx, idx, prevOuts, func(hashType txscript.SigHashType) ([]byte, error) {
+		if hashType&SigHashForkID == 0 {
+			return nil, errors.New("signature lacks SIGHASH_FORKID")
+		}
+		return forkIDSignHash(tx, idx, prevOuts, hashType)
+	})
+}
+
+func forkIDSignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType) ([]byte, error) {
+	if idx < 0 || idx >= len(prevOuts) {
+		return nil, errors.New("no prevout for the input")
+	}
+	// the BIP143 digest writes the whole script code with its length, which is
+	// what the witness sighash does for a script that is no witness program
+	return txscript.CalcWitnessSigHash(prevOuts[idx].PkScript, txscript.NewTxSigHashes(tx), hashType, tx, idx, prevOuts[idx].Value)
+}
@@ -0,0 +1,81 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+const (
+	// backendBitcoind is the full node of the chain, the default backend
+	backendBitcoind = "bitcoind"
+	// backendEsplora is an Esplora REST API
+	backendEsplora = "esplora"
+)
+
+// errNoFullNode is returned by the operations that need the rpc of a full node
+// when the chain has none
+var errNoFullNode = errors.New("operation needs a full node backend")
+
+// backend is the source of chain data the adaptor needs for transactions and
+// addresses. A full node with txindex serves it through its rpc, an indexer
+// serves it natively.
+type backend interface {
+	GetLatestBlockHeight() (int64, error)
+	GetNetwork() *chaincfg.Params
+	GetBlockHash(height int64) (*chainhash.Hash, error)
+	GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error)
+	// GetBlockWithPrevouts returns the block with its txs and the outputs their
+	// inputs spend, nil where the backend does not know them
+	GetBlockWithPrevouts(blockHash *chainhash.Hash) (*GetBlockVerboseResult, [][]*VinPrevout, error)
+	GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error)
+	// GetTxOut returns nil if the output is spent or unknown
+	GetTxOut(txHash *chainhash.Hash, index uint32, mempool bool) (*btcjson.GetTxOutResult, error)
+	EstimateSmartFee(numBlocks int64) (EstimateSmartFeeResult, error)
+	SendRawTransaction(txData []byte, maxFeeRateInBtcPerK string) (*chainhash.Hash, error)
+	// ListUnspent returns the confirmed unspent outputs of the addresses
+	ListUnspent(addresses []string) (*ScanTxOutSetResult, error)
+}
+
+// newBackends returns a client for each rpc of the chain's node config, of the
+// backend the rpc names
+func newBackends(conf *config.Config, chain *Chain) ([]backend, error) {
+	params := chain.Params(networkType(conf.NetWork))
+	log.Info("btc client setup", "chain", chain.Name, "network", conf.NetWork)
+
+	var backends []backend
+	for _, rpc := range chain.Node(conf).RPCs {
+		switch rpc.Backend {
+		case "", backendBitcoind:
+			client, err := newBtcClient(rpc, chain, params)
+			if err != nil {
+				log.Error("Fail to create BTC client", "err", err)
+				continue
+			}
+			backends = append(backends, client)
+		case backendEsplora:
+			backends = append(backends, newEsploraClient(rpc, chain, params))
+		default:
+			return nil, fmt.Errorf("unsupported backend %s of %s", rpc.Backend, rpc.RPCURL)
+		}
+	}
+	if len(backends) == 0 {
+		return nil, errors.New("No clients available")
+	}
+	return backends, nil
+}
+
+// getNode returns the best full node for the operations that use its rpc, such
+// as its mempool and policy, even if an indexer is the best client
+func (a *ChainAdaptor) getNode() (*btcClient, error) {
+	if a.nodes == nil {
+		return nil, errNoFullNode
+	}
+	return a.nodes.BestClient().(*btcClient), nil
+}
@@ -1,7 +1,6 @@
 package bitcoin
 
 import (
-	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -31,9 +30,11 @@ import (
 )
 
 const (
-	confirms     = 1
-	btcDecimals  = 8
-	btcFeeBlocks = 3
+	confirms    = 1
+	btcDecimals = 8
+
+	// maxRBFSequence is the highest input sequence signaling replaceability
+	maxRBFSequence = wire.MaxTxInSequenceNum - 2
 
 	ChainName = "btc"
 	Symbol    = "btc"
@@ -42,32 +43,76 @@ const (
 type ChainAdaptor struct {
 	fallback.ChainAdaptor
 	clients *multiclient.MultiClient
+	// nodes are the full nodes among the clients, nil if there is none
+	nodes *multiclient.MultiClient
+	chain *Chain
+	// notifiers follow the zmq notifications of the nodes which publish any
+	notifiers []*zmqNotifier
 }
 
 func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
-	clients, err := newBtcClients(conf)
+	return NewChainAdaptorWithChain(conf, bitcoinChain)
+}
+
+func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
+	return NewLocalChainAdaptorWithChain(network, bitcoinChain)
+}
+
+// NewChainAdaptorWithChain returns the adaptor of a chain derived from bitcoin
+func NewChainAdaptorWithChain(conf *config.Config, chain *Chain) (chainadaptor.ChainAdaptor, error) {
+	backends, err := newBackends(conf, chain)
 	if err != nil {
 		return nil, err
 	}
-	return newChainAdaptorWithClients(clients), nil
+	return newChainAdaptorWithBackends(chain, backends), nil
 }
 
-func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
-	return newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(network)})
+// NewLocalChainAdaptorWithChain returns the adaptor of a chain derived from bitcoin
+// for the operations which need no full node
+func NewLocalChainAdaptorWithChain(network config.NetWorkType, chain *Chain) chainadaptor.ChainAdaptor {
+	return newChainAdaptorWithChain(chain, []*btcClient{newLocalClient(chain, network)})
 }
 
 func newChainAdaptorWithClients(clients []*btcClient) *ChainAdaptor {
-	clis := make([]multiclient.Client, len(clients))
+	return newChainAdaptorWithChain(bitcoinChain, clients)
+}
+
+func newChainAdaptorWithChain(chain *Chain, clients []*btcClient) *ChainAdaptor {
+	backends := make([]backend, len(clients))
 	for i, client := range clients {
+		backends[i] = client
+	}
+	return newChainAdaptorWithBackends(chain, backends)
+}
+
+func newChainAdaptorWithBackends(chain *Chain, backends []backend) *ChainAdaptor {
+	clis := make([]multiclient.Client, len(backends))
+	var nodes []multiclient.Client
+	var notifiers []*zmqNotifier
+	for i, client := range backends {
 		clis[i] = client
+		if node, ok := client.(*btcClient); ok && !node.local {
+			nodes = append(nodes, node)
+			if node.notifier != nil {
+				notifiers = append(notifiers, node.notifier)
+			}
+		}
+	}
+	a := &ChainAdaptor{
+		clients:   multiclient.New(clis),
+		chain:     chain,
+		notifiers: notifiers,
 	}
-	return &ChainAdaptor{
-		clients: multiclient.New(clis),
+	if len(nodes) == len(clis) {
+		a.nodes = a.clients
+	} else if len(nodes) > 0 {
+		a.nodes = multiclient.New(nodes)
 	}
+	return a
 }
 
-func (a *ChainAdaptor) getClient() *btcClient {
-	return a.clients.BestClient().(*btcClient)
+func (a *ChainAdaptor) getClient() backend {
+	return a.clients.BestClient().(backend)
 }
 
 func (a *ChainAdaptor) ConvertAddress(req *proto.ConvertAddressRequest) (*proto.ConvertAddressReply, error) {
@@ -81,13 +126,13 @@ func (a *ChainAdaptor) ConvertAddress(req *proto.ConvertAddressRequest) (*proto.
 
 	return &proto.ConvertAddressReply{
 		Code:    proto.ReturnCode_SUCCESS,
-		Address: addressPubKey.EncodeAddress(),
+		Address: a.encodeAddress(addressPubKey.AddressPubKeyHash()),
 	}, nil
 }
 
 // ValidAddress check whether an address is valid
 func (a *ChainAdaptor) ValidAddress(req *proto.ValidAddressRequest) (*proto.ValidAddressReply, error) {
-	address, err := btcutil.DecodeAddress(req.Address, a.getClient().GetNetwork())
+	address, err := a.decodeAddress(req.Address)
 	if err != nil {
 		return &proto.ValidAddressReply{
 			Code: proto.ReturnCode_ERROR,
@@ -106,12 +151,12 @@ func (a *ChainAdaptor) ValidAddress(req *proto.ValidAddressRequest) (*proto.Vali
 		Code:             proto.ReturnCode_SUCCESS,
 		Valid:            true,
 		CanWithdrawal:    true,
-		CanonicalAddress: address.String(),
+		CanonicalAddress: a.encodeAddress(address),
 	}, nil
 }
 
 func (a *ChainAdaptor) QueryGasPrice(*proto.QueryGasPriceRequest) (*proto.QueryGasPriceReply, error) {
-	reply, err := a.getClient().EstimateSmartFee(btcFeeBlocks)
+	reply, err := a.getClient().EstimateSmartFee(a.chain.Policy.FeeBlocks)
 	if err != nil {
 		log.Info("QueryGasPrice", "err", err)
 		return &proto.QueryGasPriceReply{
@@ -230,13 +275,14 @@ func (a *ChainAdaptor) QueryUtxoTransactionFromSignedData(req *proto.QueryTransa
 		}, err
 	}
 	return &proto.QueryUtxoTransactionReply{
-		Code:       proto.ReturnCode_SUCCESS,
-		TxHash:     res.Hash,
-		TxStatus:   proto.TxStatus_Other,
-		Vins:       res.Vins,
-		Vouts:      res.Vouts,
-		CostFee:    res.CostFee.String(),
-		SignHashes: res.SignHashes,
+		Code:         proto.ReturnCode_SUCCESS,
+		TxHash:       res.Hash,
+		TxStatus:     proto.TxStatus_Other,
+		Vins:         res.Vins,
+		Vouts:        res.Vouts,
+		CostFee:      res.CostFee.String(),
+		SignHashes:   res.SignHashes,
+		OmniTransfer: res.Omni,
 	}, nil
 }
 
@@ -251,12 +297,13 @@ func (a *ChainAdaptor) QueryUtxoTransactionFromData(req *proto.QueryTransactionF
 		}, err
 	}
 	return &proto.QueryUtxoTransactionReply{
-		Code:       proto.ReturnCode_SUCCESS,
-		SignHashes: res.SignHashes,
-		TxStatus:   proto.TxStatus_Other,
-		Vins:       res.Vins,
-		Vouts:      res.Vouts,
-		CostFee:    res.CostFee.String(),
+		Code:         proto.ReturnCode_SUCCESS,
+		SignHashes:   res.SignHashes,
+		TxStatus:     proto.TxStatus_Other,
+		Vins:         res.Vins,
+		Vouts:        res.Vouts,
+		CostFee:      res.CostFee.String(),
+		OmniTransfer: res.Omni,
 	}, nil
 }
 
@@ -298,7 +345,7 @@ func (a *ChainAdaptor) CreateUtxoTransaction(req *proto.CreateUtxoTransactionReq
 		}, err
 	}
 
-	rawTx, err := a.createRawTx(req.Vins, req.Vouts)
+	rawTx, err := a.createRawTx(req.Vins, req.Vouts, req.Replaceable)
 	if err != nil {
 		return &proto.CreateUtxoTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -306,8 +353,37 @@ func (a *ChainAdaptor) CreateUtxoTransaction(req *proto.CreateUtxoTransactionReq
 		}, err
 	}
 
-	buf := bytes.NewBuffer(make([]byte, 0, rawTx.SerializeSize()))
-	err = rawTx.Serialize(buf)
+	if req.LockTime != 0 {
+		rawTx.LockTime = req.LockTime
+		// the lock time is ignored if every input is final
+		for _, txIn := range rawTx.TxIn {
+			if txIn.Sequence == wire.MaxTxInSequenceNum {
+				txIn.Sequence = wire.MaxTxInSequenceNum - 1
+			}
+		}
+	}
+
+	// the memo rides in a zero value OP_RETURN output after the requested ones
+	if req.Memo != "" {
+		memoScript, err := buildMemoScript(req.Memo)
+		if err != nil {
+			return &proto.CreateUtxoTransactionReply{
+				Code: proto.ReturnCode_ERROR,
+				Msg:  err.Error(),
+			}, err
+		}
+		rawTx.AddTxOut(wire.NewTxOut(0, memoScript))
+	}
+
+	warnings, err := a.checkStandardness(rawTx, req.Vins, fee.Int64())
+	if err != nil {
+		return &proto.CreateUtxoTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	txData, err := a.serializeTx(rawTx)
 	if err != nil {
 		return &proto.CreateUtxoTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -316,27 +392,26 @@ func (a *ChainAdaptor) CreateUtxoTransaction(req *proto.CreateUtxoTransactionReq
 	}
 
 	// build the pkScript and Generate signhash for each Vin,
-	signHashes, err := a.calcSignHashes(req.Vins, req.Vouts)
+	signHashes, err := a.calcSignHashes(rawTx, req.Vins)
 	if err != nil {
 		return &proto.CreateUtxoTransactionReply{
 			Code: proto.ReturnCode_ERROR,
 			Msg:  err.Error(),
 		}, err
 	}
-	log.Info("CreateTransaction", "usigned tx", hex.EncodeToString(buf.Bytes()))
+	log.Info("CreateTransaction", "usigned tx", hex.EncodeToString(txData))
 
 	return &proto.CreateUtxoTransactionReply{
 		Code:       proto.ReturnCode_SUCCESS,
-		TxData:     buf.Bytes(),
+		TxData:     txData,
 		SignHashes: signHashes,
+		Warnings:   warnings,
 	}, nil
 }
 
 // CreateSignedTransaction make a transaction without signature
 func (a *ChainAdaptor) CreateUtxoSignedTransaction(req *proto.CreateUtxoSignedTransactionRequest) (*proto.CreateSignedTransactionReply, error) {
-	r := bytes.NewReader(req.TxData)
-	var msgTx wire.MsgTx
-	err := msgTx.Deserialize(r)
+	msgTx, err := a.deserializeTx(req.TxData)
 	if err != nil {
 		log.Error("CreateSignedTransaction msgTx.Deserialize", "err", err)
 
@@ -364,55 +439,58 @@ func (a *ChainAdaptor) CreateUtxoSignedTransaction(req *proto.CreateUtxoSignedTr
 		}, err
 	}
 
-	// assemble signatures
+	// with the spent outputs in the request no full node lookup is needed
+	offline := len(req.Vins) > 0
+	if offline && len(req.Vins) != len(msgTx.TxIn) {
+		err = status.Error(codes.InvalidArgument, "the length of deserialized tx's in differs from vin in req")
+		return &proto.CreateSignedTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	vins := make([]*proto.Vin, len(msgTx.TxIn))
 	for i, in := range msgTx.TxIn {
-		btcecPub, err2 := btcec.ParsePubKey(req.PublicKeys[i], btcec.S256())
+		vin, err2 := a.getVin(offline, req.Vins, i, in)
 		if err2 != nil {
-			log.Error("CreateSignedTransaction ParsePubKey", "err", err2)
+			log.Error("CreateSignedTransaction getVin", "err", err2)
+
 			return &proto.CreateSignedTransactionReply{
 				Code: proto.ReturnCode_ERROR,
 				Msg:  err2.Error(),
 			}, err2
 		}
+		log.Info("CreateSignedTransaction ", "from address", vin.Address, "amount", vin.Amount)
+		vins[i] = vin
+	}
 
-		var pkData []byte
-		if btcec.IsCompressedPubKey(req.PublicKeys[i]) {
-			pkData = btcecPub.SerializeCompressed()
-		} else {
-			pkData = btcecPub.SerializeUncompressed()
-		}
-
-		// verify transaction
-		preTx, err2 := a.getClient().GetRawTransactionVerbose(&in.PreviousOutPoint.Hash)
-		if err2 != nil {
-			log.Error("CreateSignedTransaction GetRawTransactionVerbose", "err", err2)
-
+	// without vins in the request the sighash types are recovered from the signatures
+	var prevOuts []*wire.TxOut
+	if !offline {
+		if prevOuts, err = a.prevOuts(vins); err != nil {
 			return &proto.CreateSignedTransactionReply{
 				Code: proto.ReturnCode_ERROR,
-				Msg:  err2.Error(),
-			}, err2
+				Msg:  err.Error(),
+			}, err
 		}
+	}
 
-		log.Info("CreateSignedTransaction ", "from address", preTx.Vout[in.PreviousOutPoint.Index].ScriptPubKey.Addresses[0])
-
-		fromAddress, err2 := btcutil.DecodeAddress(preTx.Vout[in.PreviousOutPoint.Index].ScriptPubKey.Addresses[0], a.getClient().GetNetwork())
+	// assemble signatures
+	for i := range msgTx.TxIn {
+		btcecPub, err2 := btcec.ParsePubKey(req.PublicKeys[i], btcec.S256())
 		if err2 != nil {
-			log.Error("CreateSignedTransaction DecodeAddress", "err", err2)
-
+			log.Error("CreateSignedTransaction ParsePubKey", "err", err2)
 			return &proto.CreateSignedTransactionReply{
 				Code: proto.ReturnCode_ERROR,
 				Msg:  err2.Error(),
 			}, err2
 		}
 
-		fromPkScript, err2 := txscript.PayToAddrScript(fromAddress)
-		if err2 != nil {
-			log.Error("CreateSignedTransaction PayToAddrScript", "err", err2)
-
-			return &proto.CreateSignedTransactionReply{
-				Code: proto.ReturnCode_ERROR,
-				Msg:  err2.Error(),
-			}, err2
+		var pkData []byte
+		if btcec.IsCompressedPubKey(req.PublicKeys[i]) {
+			pkData = btcecPub.SerializeCompressed()
+		} else {
+			pkData = btcecPub.SerializeUncompressed()
 		}
 
 		// creat sigscript and verify
@@ -430,45 +508,50 @@ func (a *ChainAdaptor) CreateUtxoSignedTransaction(req *proto.CreateUtxoSignedTr
 			R: r,
 			S: s,
 		}
-		sig := append(btcecSig.Serialize(), byte(txscript.SigHashAll))
-		sigScript, err2 := txscript.NewScriptBuilder().AddData(sig).AddData(pkData).Script()
+		var reqVin *proto.Vin
+		var hashType txscript.SigHashType
+		if offline {
+			reqVin = req.Vins[i]
+			hashType, err2 = vinSigHashType(reqVin, msgTx, i)
+		} else {
+			hashType, err2 = a.signedSigHashType(msgTx, i, prevOuts, btcecSig, btcecPub)
+		}
 		if err2 != nil {
-			log.Error("CreateSignedTransaction NewScriptBuilder", "err", err2)
-
 			return &proto.CreateSignedTransactionReply{
 				Code: proto.ReturnCode_ERROR,
 				Msg:  err2.Error(),
 			}, err2
 		}
-
-		msgTx.TxIn[i].SignatureScript = sigScript
-		amount := btcToSatoshi(preTx.Vout[in.PreviousOutPoint.Index].Value).Int64()
-		log.Info("CreateSignedTransaction ", "amount", preTx.Vout[in.PreviousOutPoint.Index].Value, "int amount", amount)
-
-		vm, err2 := txscript.NewEngine(fromPkScript, &msgTx, i, txscript.StandardVerifyFlags, nil, nil, amount)
+		sig := append(btcecSig.Serialize(), byte(a.chain.SigHasher.HashType(hashType)))
+		sigScript, err2 := txscript.NewScriptBuilder().AddData(sig).AddData(pkData).Script()
 		if err2 != nil {
-			log.Error("CreateSignedTransaction NewEngine", "err", err2)
+			log.Error("CreateSignedTransaction NewScriptBuilder", "err", err2)
 
 			return &proto.CreateSignedTransactionReply{
 				Code: proto.ReturnCode_ERROR,
 				Msg:  err2.Error(),
 			}, err2
 		}
-		if err3 := vm.Execute(); err3 != nil {
-			log.Error("CreateSignedTransaction NewEngine Execute", "err", err3)
 
-			return &proto.CreateSignedTransactionReply{
-				Code: proto.ReturnCode_ERROR,
-				Msg:  err3.Error(),
-			}, err3
+		if reqVin != nil && len(reqVin.WitnessScript) > 0 {
+			msgTx.TxIn[i].Witness = witnessScriptStack(sig, pkData, reqVin.WitnessScript, reqVin.WitnessBranches)
+		} else {
+			msgTx.TxIn[i].SignatureScript = sigScript
 		}
+	}
+
+	// verify transaction, the sign hash may commit to the outputs spent by all inputs
+	if err = a.verifySigns(vins, msgTx); err != nil {
+		log.Error("CreateSignedTransaction verifySign", "err", err)
 
+		return &proto.CreateSignedTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
 	}
 
 	// serialize tx
-	buf := bytes.NewBuffer(make([]byte, 0, msgTx.SerializeSize()))
-
-	err = msgTx.Serialize(buf)
+	signedTxData, err := a.serializeTx(msgTx)
 	if err != nil {
 		log.Error("CreateSignedTransaction tx Serialize", "err", err)
 
@@ -478,19 +561,17 @@ func (a *ChainAdaptor) CreateUtxoSignedTransaction(req *proto.CreateUtxoSignedTr
 		}, err
 	}
 
-	hash := msgTx.TxHash()
+	hash := a.txHash(msgTx)
 	return &proto.CreateSignedTransactionReply{
 		Code:         proto.ReturnCode_SUCCESS,
-		SignedTxData: buf.Bytes(),
+		SignedTxData: signedTxData,
 		Hash:         (&hash).CloneBytes(),
 	}, nil
 }
 
 // BroadcastTransaction add signature into transaction and broadcast it to chain
 func (a *ChainAdaptor) BroadcastTransaction(req *proto.BroadcastTransactionRequest) (*proto.BroadcastTransactionReply, error) {
-	r := bytes.NewReader(req.SignedTxData)
-	var msgTx wire.MsgTx
-	err := msgTx.Deserialize(r)
+	msgTx, err := a.deserializeTx(req.SignedTxData)
 	if err != nil {
 		return &proto.BroadcastTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -498,16 +579,37 @@ func (a *ChainAdaptor) BroadcastTransaction(req *proto.BroadcastTransactionReque
 		}, err
 	}
 
-	txHash, err := a.getClient().SendRawTransaction(&msgTx)
+	maxFeeRate := a.maxFeeRateParam(req.MaxFeeRate)
+	if req.CheckPolicy {
+		if node, err := a.getNode(); err != nil {
+			// indexers have no policy check and relay what their node accepts
+			log.Warn("BroadcastTransaction CheckPolicy", "err", err)
+		} else if result, err := node.TestMempoolAccept(req.SignedTxData, maxFeeRate); err != nil {
+			// nodes without testmempoolaccept still check on broadcast
+			log.Warn("BroadcastTransaction TestMempoolAccept", "err", err)
+		} else if !result.Allowed {
+			err = fmt.Errorf("rejected by mempool policy: %s", result.RejectReason)
+			return &proto.BroadcastTransactionReply{
+				Code:         proto.ReturnCode_ERROR,
+				Msg:          err.Error(),
+				RejectCode:   rejectCode(result.RejectReason),
+				RejectReason: result.RejectReason,
+			}, err
+		}
+	}
+
+	txHash, err := a.getClient().SendRawTransaction(req.SignedTxData, maxFeeRate)
 	if err != nil {
 		return &proto.BroadcastTransactionReply{
-			Code: proto.ReturnCode_ERROR,
-			Msg:  err.Error(),
+			Code:         proto.ReturnCode_ERROR,
+			Msg:          err.Error(),
+			RejectCode:   rejectCode(err.Error()),
+			RejectReason: err.Error(),
 		}, err
 	}
 
-	if strings.Compare(msgTx.TxHash().String(), txHash.String()) != 0 {
-		log.Error("BroadcastTransaction, txhash mismatch", "local hash", msgTx.TxHash().String(), "hash from net", txHash.String(), "signedTx", hex.EncodeToString(req.SignedTxData))
+	if localHash := a.txHash(msgTx); strings.Compare(localHash.String(), txHash.String()) != 0 {
+		log.Error("BroadcastTransaction, txhash mismatch", "local hash", localHash.String(), "hash from net", txHash.String(), "signedTx", hex.EncodeToString(req.SignedTxData))
 	}
 
 	return &proto.BroadcastTransactionReply{
@@ -535,7 +637,7 @@ func (a *ChainAdaptor) VerifyUtxoSignedTransaction(req *proto.VerifySignedTransa
 
 func (a *ChainAdaptor) QueryUtxoInsFromData(req *proto.QueryUtxoInsFromDataRequest) (*proto.QueryUtxoInsReply, error) {
 	log.Info("QueryUtxoInsFromData", "req", req)
-	vins, err := decodeProtoVinsFromData(req.Data)
+	vins, err := a.decodeProtoVinsFromData(req.Data)
 	if err != nil {
 		return &proto.QueryUtxoInsReply{
 			Code: proto.ReturnCode_ERROR,
@@ -555,21 +657,34 @@ func (a *ChainAdaptor) GetLatestBlockHeight() (int64, error) {
 
 func (a *ChainAdaptor) GetUtxoTransactionByHeight(height int64, replyCh chan *proto.QueryUtxoTransactionReply, errCh chan error) {
 
-	hash, err := a.getClient().GetBlockHash(height)
+	client := a.getClient()
+	hash, err := client.GetBlockHash(height)
 	if err != nil {
 		errCh <- err
 		return
 	}
-	block, err := a.getClient().GetBlockWithRawTransactionVerbose(hash)
+	block, prevouts, err := client.GetBlockWithPrevouts(hash)
 	if err != nil {
 		errCh <- err
 		return
 	}
 
+	resolver := a.newPrevoutResolver()
+	for i, tx := range block.Tx {
+		resolver.addTx(tx)
+		for j, in := range tx.Vin {
+			if i < len(prevouts) && j < len(prevouts[i]) {
+				resolver.addVinPrevout(in, prevouts[i][j])
+			}
+		}
+	}
+	if err = resolver.resolve(block.Tx); err != nil {
+		errCh <- err
+		return
+	}
+
 	for _, tx := range block.Tx {
-		reply, err := a.assembleUtxoTransactionReply(tx, block.Height, block.Time, func(txid string, index uint32) (int64, string, error) {
-			return 0, "", nil
-		})
+		reply, err := a.assembleUtxoTransactionReply(tx, block.Height, block.Time, resolver.get)
 		if err != nil {
 			errCh <- err
 			return
@@ -606,10 +721,19 @@ func (a *ChainAdaptor) queryTransaction(txhash *chainhash.Hash) (*proto.QueryUtx
 	if tx.Confirmations < confirms {
 		log.Error("queryTransaction confirmes too low", "tx confirms", tx.Confirmations, "need confirms", confirms)
 
-		return &proto.QueryUtxoTransactionReply{
+		reply := &proto.QueryUtxoTransactionReply{
 			Code:     proto.ReturnCode_SUCCESS,
+			TxHash:   tx.Txid,
 			TxStatus: proto.TxStatus_Pending,
-		}, nil
+		}
+		if node, err := a.getNode(); err != nil {
+			log.Warn("queryTransaction GetMempoolEntry", "err", err)
+		} else if entry, err := node.GetMempoolEntry(tx.Txid); err == nil {
+			reply.Mempool = mempoolEntry(entry)
+		} else {
+			log.Warn("queryTransaction GetMempoolEntry", "err", err)
+		}
+		return reply, nil
 	}
 
 	blockHash, _ := chainhash.NewHashFromStr(tx.BlockHash)
@@ -623,20 +747,19 @@ func (a *ChainAdaptor) queryTransaction(txhash *chainhash.Hash) (*proto.QueryUtx
 		}, err
 	}
 
-	reply, err := a.assembleUtxoTransactionReply(tx, block.Height, block.Time, func(txid string, index uint32) (int64, string, error) {
-		preHash, err2 := chainhash.NewHashFromStr(txid)
-		if err2 != nil {
-			return 0, "", err2
-		}
-		preTx, err2 := a.getClient().GetRawTransactionVerbose(preHash)
-		if err2 != nil {
-			return 0, "", err2
-		}
-		amount := btcToSatoshi(preTx.Vout[index].Value).Int64()
+	resolver := a.newPrevoutResolver()
+	if err = resolver.resolve([]*btcjson.TxRawResult{tx}); err != nil {
+		log.Error("queryTransaction resolve prevouts", "err", err)
+
+		return &proto.QueryUtxoTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
 
-		return amount, preTx.Vout[index].ScriptPubKey.Addresses[0], nil
-	})
+	reply, err := a.assembleUtxoTransactionReply(tx, block.Height, block.Time, resolver.get)
 	if err != nil {
+
 		return &proto.QueryUtxoTransactionReply{
 			Code: proto.ReturnCode_ERROR,
 			Msg:  err.Error(),
@@ -651,8 +774,20 @@ func (a *ChainAdaptor) assembleUtxoTransactionReply(tx *btcjson.TxRawResult, blo
 	ins := make([]*proto.Vin, 0, len(tx.Vin))
 	outs := make([]*proto.Vout, 0, len(tx.Vout))
 
+	coinbase, unresolved := false, false
 	for _, in := range tx.Vin {
+		// a coinbase input spends nothing
+		if in.IsCoinBase() {
+			coinbase = true
+			ins = append(ins, &proto.Vin{Coinbase: true})
+			continue
+		}
 		amount, address, err := getPrevTxInfo(in.Txid, in.Vout)
+		if err == errPrevoutUnresolvable {
+			unresolved = true
+			ins = append(ins, &proto.Vin{Hash: in.Txid, Index: in.Vout, Unresolved: true})
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -669,10 +804,7 @@ func (a *ChainAdaptor) assembleUtxoTransactionReply(tx *btcjson.TxRawResult, blo
 
 	for index, out := range tx.Vout {
 		amount := btcToSatoshi(out.Value).Int64()
-		addr := ""
-		if len(out.ScriptPubKey.Addresses) > 0 {
-			addr = out.ScriptPubKey.Addresses[0]
-		}
+		addr := a.scriptPubKeyAddress(out.ScriptPubKey)
 
 		totalAmountOut += amount
 		t := proto.Vout{
@@ -680,20 +812,32 @@ func (a *ChainAdaptor) assembleUtxoTransactionReply(tx *btcjson.TxRawResult, blo
 			Amount:  amount,
 			Index:   uint32(index),
 		}
+		if pkScript, err := hex.DecodeString(out.ScriptPubKey.Hex); err == nil {
+			fillVoutScript(&t, pkScript)
+		}
 
 		outs = append(outs, &t)
 	}
 
 	gasUsed := totalAmountIn - totalAmountOut
+	if coinbase {
+		gasUsed = 0
+	}
+	// the fee is unknown without the amounts of all inputs
+	costFee := strconv.FormatInt(gasUsed, 10)
+	if unresolved {
+		costFee = ""
+	}
 	reply := &proto.QueryUtxoTransactionReply{
-		Code:        proto.ReturnCode_SUCCESS,
-		TxHash:      tx.Txid,
-		TxStatus:    proto.TxStatus_Success,
-		Vins:        ins,
-		Vouts:       outs,
-		CostFee:     strconv.FormatInt(gasUsed, 10),
-		BlockHeight: uint64(blockHeight),
-		BlockTime:   uint64(blockTime),
+		Code:         proto.ReturnCode_SUCCESS,
+		TxHash:       tx.Txid,
+		TxStatus:     proto.TxStatus_Success,
+		Vins:         ins,
+		Vouts:        outs,
+		CostFee:      costFee,
+		BlockHeight:  uint64(blockHeight),
+		BlockTime:    uint64(blockTime),
+		OmniTransfer: omniTransfer(ins, outs),
 	}
 	return reply, nil
 }
@@ -706,12 +850,12 @@ func btcToSatoshi(btcCount float64) *big.Int {
 	return satoshiDm
 }
 
-func (a *ChainAdaptor) createRawTx(ins []*proto.Vin, outs []*proto.Vout) (*wire.MsgTx, error) {
+func (a *ChainAdaptor) createRawTx(ins []*proto.Vin, outs []*proto.Vout, replaceable bool) (*wire.MsgTx, error) {
 	if len(ins) == 0 || len(outs) == 0 {
 		return nil, errors.New("invalid len in or out")
 	}
 
-	rawTx := wire.NewMsgTx(wire.TxVersion)
+	rawTx := a.chain.TxCodec.NewTx()
 	for _, in := range ins {
 		// convert string hash to a bitcoin hash
 		utxoHash, err := chainhash.NewHashFromStr(in.Hash)
@@ -721,28 +865,24 @@ func (a *ChainAdaptor) createRawTx(ins []*proto.Vin, outs []*proto.Vout) (*wire.
 
 		// make a txin
 		txIn := wire.NewTxIn(wire.NewOutPoint(utxoHash, in.Index), nil, nil)
+		if replaceable {
+			// opt in to replace-by-fee as defined in BIP125
+			txIn.Sequence = maxRBFSequence
+		}
+		if in.Sequence != 0 {
+			txIn.Sequence = in.Sequence
+			// relative lock times are only enforced from version 2 on, see BIP68
+			if in.Sequence&wire.SequenceLockTimeDisabled == 0 && rawTx.Version < 2 {
+				rawTx.Version = 2
+			}
+		}
 		// add txIn to transaction
 		rawTx.AddTxIn(txIn)
 	}
 
 	// build tx output
 	for _, out := range outs {
-		if strings.HasPrefix(out.Address, omniPrefix) {
-			toPkScript, err := buildOmniScript(out.Address)
-			if err != nil {
-				return nil, err
-			}
-			rawTx.AddTxOut(wire.NewTxOut(out.Amount, toPkScript))
-			continue
-		}
-
-		toAddress, err := btcutil.DecodeAddress(out.Address, a.getClient().GetNetwork())
-		if err != nil {
-			return nil, err
-		}
-
-		// build the pkScript
-		toPkScript, err := txscript.PayToAddrScript(toAddress)
+		toPkScript, err := a.voutPkScript(out)
 		if err != nil {
 			return nil, err
 		}
@@ -753,6 +893,23 @@ func (a *ChainAdaptor) createRawTx(ins []*proto.Vin, outs []*proto.Vout) (*wire.
 	return rawTx, nil
 }
 
+func (a *ChainAdaptor) voutPkScript(out *proto.Vout) ([]byte, error) {
+	if out.Omni != nil {
+		return buildOmniSimpleSendScript(out.Omni)
+	}
+	if strings.HasPrefix(out.Address, omniPrefix) {
+		return buildOmniScript(out.Address)
+	}
+
+	toAddress, err := a.decodeAddress(out.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// build the pkScript
+	return txscript.PayToAddrScript(toAddress)
+}
+
 func buildOmniScript(addr string) ([]byte, error) {
 	omniData, err := hex.DecodeString(addr)
 	if err != nil {
@@ -767,11 +924,11 @@ type DecodeTxRes struct {
 	Vins       []*proto.Vin
 	Vouts      []*proto.Vout
 	CostFee    *big.Int
+	Omni       *proto.OmniTransfer
 }
 
 func (a *ChainAdaptor) decodeTx(txData []byte, vins []*proto.Vin, sign bool) (*DecodeTxRes, error) {
-	var msgTx wire.MsgTx
-	err := msgTx.Deserialize(bytes.NewReader(txData))
+	msgTx, err := a.deserializeTx(txData)
 	if err != nil {
 		return nil, err
 	}
@@ -784,18 +941,18 @@ func (a *ChainAdaptor) decodeTx(txData []byte, vins []*proto.Vin, sign bool) (*D
 		return nil, status.Error(codes.InvalidArgument, "the length of deserialized tx's in differs from vin in req")
 	}
 
-	ins, totalAmountIn, err := a.decodeVins(msgTx, offline, vins, sign)
+	ins, totalAmountIn, err := a.decodeVins(*msgTx, offline, vins, sign)
 	if err != nil {
 		return nil, err
 	}
 
-	outs, totalAmountOut, err := a.decodeVouts(msgTx)
+	outs, totalAmountOut, err := a.decodeVouts(*msgTx)
 	if err != nil {
 		return nil, err
 	}
 
 	// build the pkScript and Generate signhash for each Vin,
-	signHashes, err := a.calcSignHashes(ins, outs)
+	signHashes, err := a.calcSignHashes(msgTx, ins)
 	if err != nil {
 		return nil, err
 	}
@@ -805,9 +962,10 @@ func (a *ChainAdaptor) decodeTx(txData []byte, vins []*proto.Vin, sign bool) (*D
 		Vins:       ins,
 		Vouts:      outs,
 		CostFee:    totalAmountIn.Sub(totalAmountIn, totalAmountOut),
+		Omni:       omniTransfer(ins, outs),
 	}
 	if sign {
-		res.Hash = msgTx.TxHash().String()
+		res.Hash = a.txHash(msgTx).String()
 	}
 	return &res, nil
 }
@@ -817,12 +975,10 @@ func (a *ChainAdaptor) decodeVouts(msgTx wire.MsgTx) ([]*proto.Vout, *big.Int, e
 	totalAmountOut := big.NewInt(0)
 	for _, out := range msgTx.TxOut {
 		var t proto.Vout
-		_, pubkeyAddrs, _, err := txscript.ExtractPkScriptAddrs(out.PkScript, a.getClient().GetNetwork())
-		if err != nil {
-			return nil, nil, err
-		}
-		t.Address = pubkeyAddrs[0].EncodeAddress()
+		t.Address = a.pkScriptAddress(out.PkScript)
 		t.Amount = out.Value
+		t.Index = uint32(len(outs))
+		fillVoutScript(&t, out.PkScript)
 		totalAmountOut.Add(totalAmountOut, big.NewInt(t.Amount))
 		outs = append(outs, &t)
 	}
@@ -839,16 +995,14 @@ func (a *ChainAdaptor) decodeVins(msgTx wire.MsgTx, offline bool, vins []*proto.
 			return nil, nil, err
 		}
 
-		if sign {
-			err = a.verifySign(vin, msgTx, index)
-			if err != nil {
-				return nil, nil, err
-			}
-		}
-
 		totalAmountIn.Add(totalAmountIn, big.NewInt(vin.Amount))
 		ins = append(ins, vin)
 	}
+	if sign {
+		if err := a.verifySigns(ins, &msgTx); err != nil {
+			return nil, nil, err
+		}
+	}
 	return ins, totalAmountIn, nil
 }
 
@@ -874,48 +1028,68 @@ func (a *ChainAdaptor) getVin(offline bool, vins []*proto.Vin, index int, in *wi
 	return vin, nil
 }
 
-func (a *ChainAdaptor) verifySign(vin *proto.Vin, msgTx wire.MsgTx, index int) error {
-	fromAddress, err := btcutil.DecodeAddress(vin.Address, a.getClient().GetNetwork())
-	if err != nil {
-		return err
-	}
-
-	fromPkScript, err := txscript.PayToAddrScript(fromAddress)
+// verifySigns verifies the signature of every input of msgTx spending vins
+func (a *ChainAdaptor) verifySigns(vins []*proto.Vin, msgTx *wire.MsgTx) error {
+	prevOuts, err := a.prevOuts(vins)
 	if err != nil {
 		return err
 	}
-
-	vm, err := txscript.NewEngine(fromPkScript, &msgTx, index, txscript.StandardVerifyFlags, nil, nil, vin.Amount)
-	if err != nil {
-		return err
+	for index := range msgTx.TxIn {
+		if err := a.chain.SigHasher.VerifySignature(msgTx, index, prevOuts); err != nil {
+			return err
+		}
 	}
-	return vm.Execute()
+	return nil
 }
 
-func (a *ChainAdaptor) calcSignHashes(Vins []*proto.Vin, Vouts []*proto.Vout) ([][]byte, error) {
-
-	rawTx, err := a.createRawTx(Vins, Vouts)
-	if err != nil {
-		return nil, err
-	}
-
-	signHashes := make([][]byte, len(Vins))
-	for i, in := range Vins {
-		from := in.Address
-		fromAddr, err := btcutil.DecodeAddress(from, a.getClient().GetNetwork())
+// prevOuts returns the outputs spent by vins
+func (a *ChainAdaptor) prevOuts(vins []*proto.Vin) ([]*wire.TxOut, error) {
+	prevOuts := make([]*wire.TxOut, len(vins))
+	for i, vin := range vins {
+		fromAddress, err := a.decodeAddress(vin.Address)
 		if err != nil {
-			log.Info("DecodeAddress err", "from", from, "err", err)
+			log.Info("DecodeAddress err", "from", vin.Address, "err", err)
 			return nil, err
 		}
-		fromPkScript, err := txscript.PayToAddrScript(fromAddr)
+		fromPkScript, err := txscript.PayToAddrScript(fromAddress)
 		if err != nil {
 			log.Info("PayToAddrScript err", "err", err)
 			return nil, err
 		}
+		prevOuts[i] = wire.NewTxOut(vin.Amount, fromPkScript)
+	}
+	return prevOuts, nil
+}
+
+func (a *ChainAdaptor) calcSignHashes(rawTx *wire.MsgTx, Vins []*proto.Vin) ([][]byte, error) {
+	signHashes := make([][]byte, len(Vins))
+	var sigHashes *txscript.TxSigHashes
+	var prevOuts []*wire.TxOut
+	for i, in := range Vins {
+		if len(in.WitnessScript) > 0 {
+			if sigHashes == nil {
+				sigHashes = txscript.NewTxSigHashes(rawTx)
+			}
+			signHash, err := a.witnessSignHash(rawTx, sigHashes, in, i)
+			if err != nil {
+				return nil, err
+			}
+			signHashes[i] = signHash
+			continue
+		}
 
-		signHash, err := txscript.CalcSignatureHash(fromPkScript, txscript.SigHashAll, rawTx, i)
+		hashType, err := vinSigHashType(in, rawTx, i)
+		if err != nil {
+			return nil, err
+		}
+		if prevOuts == nil {
+			if prevOuts, err = a.prevOuts(Vins); err != nil {
+				return nil, err
+			}
+		}
+		signHash, err := a.chain.SigHasher.SignHash(rawTx, i, prevOuts, hashType)
 		if err != nil {
-			log.Info("CalcSignatureHash err", "err", err)
+			log.Info("SignHash err", "err", err)
 			return nil, err
 		}
 		signHashes[i] = signHash
@@ -923,10 +1097,8 @@ func (a *ChainAdaptor) calcSignHashes(Vins []*proto.Vin, Vouts []*proto.Vout) ([
 	return signHashes, nil
 }
 
-func decodeProtoVinsFromData(data []byte) ([]*proto.Vin, error) {
-	r := bytes.NewReader(data)
-	var msgTx wire.MsgTx
-	err := msgTx.Deserialize(r)
+func (a *ChainAdaptor) decodeProtoVinsFromData(data []byte) ([]*proto.Vin, error) {
+	msgTx, err := a.deserializeTx(data)
 	if err != nil {
 		return nil, err
 	}
@@ -303,3 +303,62 @@ func TestCreateTransactionAmountMismatchNoFullNode(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, "CreateTransaction, total amount in != total amount out + fee", reply.Msg)
 }
+
+func TestCreateSignedTransactionNoFullNode(t *testing.T) {
+	btcChainAdaptorWithoutFullNode := NewLocalChainAdaptor(config.TestNet)
+	from := newTestKey("offline sign key")
+	to := newTestKey("offline sign to")
+
+	vin := []*proto.Vin{
+		{Hash: "c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9", Index: uint32(0), Amount: int64(32500000), Address: from.address},
+		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: uint32(1), Amount: int64(33000), Address: from.address},
+	}
+	vout := []*proto.Vout{
+		{Address: to.address, Amount: int64(32000000)},
+		{Address: from.address, Amount: int64(513000)},
+	}
+
+	reply1, err := btcChainAdaptorWithoutFullNode.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain:  ChainName,
+		Symbol: Symbol,
+		Vins:   vin,
+		Vouts:  vout,
+		Fee:    big.NewInt(0).SetInt64(20000).String(),
+	})
+	assert.Nil(t, err)
+
+	req := &proto.CreateUtxoSignedTransactionRequest{
+		Chain:  ChainName,
+		Symbol: Symbol,
+		TxData: reply1.TxData,
+		Vins:   vin,
+	}
+	for _, signHash := range reply1.SignHashes {
+		req.Signatures = append(req.Signatures, rsSignature(t, from.privKey, signHash))
+		req.PublicKeys = append(req.PublicKeys, from.privKey.PubKey().SerializeCompressed())
+	}
+
+	reply2, err := btcChainAdaptorWithoutFullNode.CreateUtxoSignedTransaction(req)
+	assert.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply2.Code)
+
+	reply3, err := btcChainAdaptorWithoutFullNode.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		SignedTxData: reply2.SignedTxData,
+		Vins:         vin,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, true, reply3.Verified)
+
+	// the signatures do not match the script of another address
+	vin[1].Address = to.address
+	reply2, err = btcChainAdaptorWithoutFullNode.CreateUtxoSignedTransaction(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, reply2.Code)
+
+	req.Vins = vin[:1]
+	reply2, err = btcChainAdaptorWithoutFullNode.CreateUtxoSignedTransaction(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, reply2.Code)
+}
@@ -92,7 +92,7 @@ func TestConvertAddress(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
 	genPub2Addr()
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 
 	var req proto.ConvertAddressRequest
 	req.Chain = ChainName
@@ -166,7 +166,7 @@ var illegalAddrs = []string{
 func TestValidAddress(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	genPub2Addr()
 
 	var req proto.ValidAddressRequest
@@ -876,21 +876,21 @@ func TestCreateTransactionAmountMismatch(t *testing.T) {
 func TestCreateAndSignTransactionOneTxinUnCompressed(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	client.compressed = false
 
-	expectedHash, _ := hex.DecodeString("4bc328ba8fcf517d612b0ba01e0cd005e5829a16b295e33150dc2e2afbbb56e6")
-	expectedTx, _ := hex.DecodeString("0100000001cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a8370000000000ffffffff02007d0000000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88acf40100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
+	expectedHash, _ := hex.DecodeString("5ae0e6971e11f68f65cbdabb67abc3220ad9ca3df4662b54e1acd7c3f8a6cba9")
+	expectedTx, _ := hex.DecodeString("0100000001cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a8370000000000ffffffff020c7b0000000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
 	expectedPkData, _ := hex.DecodeString("043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8")
-	expectedSig, _ := hex.DecodeString("3044022017e44e2cb5861720a8c705fdabbde74355245592e79f7b2f7802c22b26851d7b02204d47f86b671ffdf3336ecec673225c92f86928c899cf44b7e0fe6c30fc681b6b01")
-	expectedSignedTx, _ := hex.DecodeString("0100000001cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a837000000008a473044022017e44e2cb5861720a8c705fdabbde74355245592e79f7b2f7802c22b26851d7b02204d47f86b671ffdf3336ecec673225c92f86928c899cf44b7e0fe6c30fc681b6b0141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8ffffffff02007d0000000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88acf40100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
+	expectedSig, _ := hex.DecodeString("3045022100f721575bce63205955fe378b8718722a8c94bc3860962ca05dc96f967ab1b2f302207e50c6e2ac1a9f1c0d52d8035dc2760c285b2a3e3e6c6ec505dcd999f98bcd2901")
+	expectedSignedTx, _ := hex.DecodeString("0100000001cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a837000000008b483045022100f721575bce63205955fe378b8718722a8c94bc3860962ca05dc96f967ab1b2f302207e50c6e2ac1a9f1c0d52d8035dc2760c285b2a3e3e6c6ec505dcd999f98bcd290141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8ffffffff020c7b0000000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
 	vin := []*proto.Vin{
 		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: uint32(0), Amount: int64(33000), Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9"},
 	}
 
 	vout := []*proto.Vout{
-		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(32000)},
-		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(500)},
+		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(31500)},
+		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(1000)},
 	}
 
 	req1 := proto.CreateUtxoTransactionRequest{
@@ -902,10 +902,10 @@ func TestCreateAndSignTransactionOneTxinUnCompressed(t *testing.T) {
 	}
 
 	reply1, err := btcChainAdaptor.CreateUtxoTransaction(&req1)
-	assert.Nil(t, err)
+	require.Nil(t, err)
 	assert.Equal(t, expectedTx, reply1.TxData)
+	require.Equal(t, 1, len(reply1.SignHashes))
 	assert.Equal(t, expectedHash, reply1.SignHashes[0])
-	assert.Equal(t, 1, len(reply1.SignHashes))
 
 	// Sign with Prviate key
 	priWif, err := btcutil.DecodeWIF("cMqUKKvaEzKfPnooWk5fRe5c5CXGw2R1KEKefdkktTDSzSGmqfxN")
@@ -957,25 +957,25 @@ func btcecSigToBHSigBytes(sigBytes []byte) ([]byte, error) {
 func TestCreateAndSignTransactionMultiTxinUnCompressed(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	client.compressed = false
 
-	expectedHash0, _ := hex.DecodeString("08ebbd73dcefec70b66bdc185c4f7a54a0ced720ad455c259d427e94c86100ce")
-	expectedHash1, _ := hex.DecodeString("d6c5e99916f44160cc2f24667013b02bd4ca050e04ab2c9fee5df01ccb7ee7a8")
-	expectedTx, _ := hex.DecodeString("0100000002cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a8370000000000fffffffff243031c3431c19f577a32778b635a26834d6b52e552be23df3fab509340d4190100000000ffffffff02902d0100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ac2c0100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
+	expectedHash0, _ := hex.DecodeString("00b98f4f9198708a95c40750e7e57eec28684266885ec90e939b1a7810d8e212")
+	expectedHash1, _ := hex.DecodeString("01a236206d3216438d699c437ce0d1db8f47246fd720e89846d666be83010b98")
+	expectedTx, _ := hex.DecodeString("0100000002cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a8370000000000fffffffff243031c3431c19f577a32778b635a26834d6b52e552be23df3fab509340d4190100000000ffffffff02d42a0100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
 	expectedPkData, _ := hex.DecodeString("043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8")
-	expectedSig0, _ := hex.DecodeString("304402201889ee7147582c897f9d2dcc81bfdb321eddd33bf47820f72c7fa3237425a4cc022057d359d3c495824e48d7f6cc77a9998667f0ea388fb13e2dd24df32bca977a7e01")
-	expectedSig1, _ := hex.DecodeString("304402200c0221775e00b66a437cae72393c0364197bbf5925a434f4e504647bad2b739802201cde65725a699552029ef00629495542a8a0b9781ce962cb6afc0d313cd7f04001")
+	expectedSig0, _ := hex.DecodeString("304402202bf3304e06991096f832249268a674cbe9865c9215ef4468ad0a7ce44a679f7602206bb024f1c6dcb1138c5aa23317bc935ce50b9e814470e510b9093fd8d8ba96b301")
+	expectedSig1, _ := hex.DecodeString("30450221009271781d16aa297695189a72a3d2d1bacbc5641ae0310740cf8a8a6165e932eb0220536c4128a6e780f022c4b8b9a668ca2bde61439e7b5dc30537a5e1a226f86d8901")
 
-	expectedSignedTx, _ := hex.DecodeString("0100000002cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a837000000008a47304402201889ee7147582c897f9d2dcc81bfdb321eddd33bf47820f72c7fa3237425a4cc022057d359d3c495824e48d7f6cc77a9998667f0ea388fb13e2dd24df32bca977a7e0141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8fffffffff243031c3431c19f577a32778b635a26834d6b52e552be23df3fab509340d419010000008a47304402200c0221775e00b66a437cae72393c0364197bbf5925a434f4e504647bad2b739802201cde65725a699552029ef00629495542a8a0b9781ce962cb6afc0d313cd7f0400141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8ffffffff02902d0100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ac2c0100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
+	expectedSignedTx, _ := hex.DecodeString("0100000002cf1d0fc041d8b3ceb1642fdfa262e32f54227f2e6ceb74a515f5482ae090a837000000008a47304402202bf3304e06991096f832249268a674cbe9865c9215ef4468ad0a7ce44a679f7602206bb024f1c6dcb1138c5aa23317bc935ce50b9e814470e510b9093fd8d8ba96b30141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8fffffffff243031c3431c19f577a32778b635a26834d6b52e552be23df3fab509340d419010000008b4830450221009271781d16aa297695189a72a3d2d1bacbc5641ae0310740cf8a8a6165e932eb0220536c4128a6e780f022c4b8b9a668ca2bde61439e7b5dc30537a5e1a226f86d890141043cd360fecac46da64c411c6b471d8e147504ed74c2cafd9a29329c63c4eaf1603fb5a230c1ba28d93bb6834989869259d4a4156d33fd5f99075e4b968cdbe8b8ffffffff02d42a0100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000")
 	vin := []*proto.Vin{
 		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: uint32(0), Amount: int64(33000), Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9"},
 		{Hash: "19d4409350ab3fdf23be52e5526b4d83265a638b77327a579fc131341c0343f2", Index: uint32(1), Amount: int64(45000), Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9"},
 	}
 
 	vout := []*proto.Vout{
-		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(77200)},
-		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(300)},
+		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(76500)},
+		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(1000)},
 	}
 
 	req1 := proto.CreateUtxoTransactionRequest{
@@ -987,14 +987,14 @@ func TestCreateAndSignTransactionMultiTxinUnCompressed(t *testing.T) {
 	}
 
 	reply1, err := btcChainAdaptor.CreateUtxoTransaction(&req1)
-	assert.Nil(t, err)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(reply1.SignHashes))
 	// t.Logf("SignHash[0]:%x\n", reply1.SignHashes[0])
 	// t.Logf("SignHash[1]:%x\n", reply1.SignHashes[1])
 	// t.Logf("TxData:%x\n", reply1.TxData)
 	assert.Equal(t, expectedTx, reply1.TxData)
 	assert.Equal(t, expectedHash0, reply1.SignHashes[0])
 	assert.Equal(t, expectedHash1, reply1.SignHashes[1])
-	assert.Equal(t, 2, len(reply1.SignHashes))
 
 	// Sign with Prviate key
 	priWif, err := btcutil.DecodeWIF("cMqUKKvaEzKfPnooWk5fRe5c5CXGw2R1KEKefdkktTDSzSGmqfxN")
@@ -1041,7 +1041,7 @@ func TestCreateAndSignTransactionMultiTxinUnCompressed(t *testing.T) {
 func TestCreateAndSignTransactionOneTxInUnCompressed(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	client.compressed = false // mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9 is uncomprssed address
 
 	expectedHash0, _ := hex.DecodeString("cf9b979b88f7971205c6be656e09f416e3d6f76d3579e0481b870ee858d9c4bf")
@@ -1706,17 +1706,17 @@ func TestCreateSignedTransactionForCompressedAddressAndTx(t *testing.T) {
 func TestCreateAndSignedTransactioFromDifferentAddressInCompress(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	client.compressed = true
-	expectedTxData := "010000000282f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f0000000000ffffffff82f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f0100000000ffffffff02b4270100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88acf40100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000"
-	expectedHash1 := "890c6bb02e75bebf6ae1f92cb3138bb11ec7dd3035938d1224384ad861c52041"
+	expectedTxData := "010000000282f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f0000000000ffffffff82f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f0100000000ffffffff02c0250100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000"
+	expectedHash1 := "cd9694c0ad8fc424aec58a23d7843b698b1361622634a6dab14a73165bf97e47"
 	expectedPkData1 := "0395a2c1cddab943e4eef857968e2a5cc2e587c92b598e8517830869c5f3203f3b"
-	expectedSig1 := "304402206099bad8198530cf2608ccb62b580d5013381c530feff6136879f2c99221615f02203132c0a881e4e1632fba238f1724fbc69c65a30b0e7fe7ca1512a281ab0e665201"
-	expectedHash2 := "8d295b75352045f64513919470ba46c42ef3945b7513ed3dac15ae7042e4319c"
+	expectedSig1 := "3045022100857cdbede0e1a63d0d4f3418a141435260ce972a13ec0e7f0ac913a713f0544102200847d5c80797a5c28f0b8d3dc2012b46bd826f9c66a73f5c2bb2061e5cf5294001"
+	expectedHash2 := "fcd17b9f749daf89348745fde48b9d0e75ae603a2be6803c25972ed77cab1e35"
 	expectedPkData2 := "02f06a16cea42494b5dbb3701eb43d4e96e6e8bb71c5f360abaed9e3ccd55c5a1d"
-	expectedSig2 := "30440220585d7060a8810a07259f5b1291e82f640d02d2f536ddd749b9834a93d3d5b53b02201bb447ccf5f1694e308a544d7189f77378f038b6b20d26a48d425a9f041f893a01"
-	expectedHash := "4614f1fc0e21e21c78d694dd4bc2132cc49b843311cda36e023af7f2b423a549"
-	expectedSignedTx := "010000000282f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f000000006a47304402206099bad8198530cf2608ccb62b580d5013381c530feff6136879f2c99221615f02203132c0a881e4e1632fba238f1724fbc69c65a30b0e7fe7ca1512a281ab0e665201210395a2c1cddab943e4eef857968e2a5cc2e587c92b598e8517830869c5f3203f3bffffffff82f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f010000006a4730440220585d7060a8810a07259f5b1291e82f640d02d2f536ddd749b9834a93d3d5b53b02201bb447ccf5f1694e308a544d7189f77378f038b6b20d26a48d425a9f041f893a012102f06a16cea42494b5dbb3701eb43d4e96e6e8bb71c5f360abaed9e3ccd55c5a1dffffffff02b4270100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88acf40100000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000"
+	expectedSig2 := "3045022100b5d6f796f99d5ea74216575142fbe3029ae973151d0cb63e47234230149b790602205d91ace49aa00e9a19727903857ec19d99162decaefe87f814b294c382ba23b801"
+	expectedHash := "6dbd94609c31cbea50f94fdfda0328573533b1556e3f817e5847be8cd22145d9"
+	expectedSignedTx := "010000000282f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f000000006b483045022100857cdbede0e1a63d0d4f3418a141435260ce972a13ec0e7f0ac913a713f0544102200847d5c80797a5c28f0b8d3dc2012b46bd826f9c66a73f5c2bb2061e5cf5294001210395a2c1cddab943e4eef857968e2a5cc2e587c92b598e8517830869c5f3203f3bffffffff82f957d1598291a946d34a114e5391b166cfbf8228325a12fe703cf8db18343f010000006b483045022100b5d6f796f99d5ea74216575142fbe3029ae973151d0cb63e47234230149b790602205d91ace49aa00e9a19727903857ec19d99162decaefe87f814b294c382ba23b8012102f06a16cea42494b5dbb3701eb43d4e96e6e8bb71c5f360abaed9e3ccd55c5a1dffffffff02c0250100000000001976a91419064bda7eb5049f922a4bca4c24808c6aea948d88ace80300000000000017a91410080578e54a2a66efcb55e69b073100d0da47b98700000000"
 
 	vin := []*proto.Vin{
 		{Hash: "3f3418dbf83c70fe125a322882bfcf66b191534e114ad346a9918259d157f982", Index: uint32(0), Amount: int64(33700), Address: "n3HsmPMAEa2ovEzMNrXKZhSMGvUEfBpHcd"},
@@ -1724,8 +1724,8 @@ func TestCreateAndSignedTransactioFromDifferentAddressInCompress(t *testing.T) {
 	}
 
 	vout := []*proto.Vout{
-		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(75700)},
-		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(500)},
+		{Address: "mhoGjKn5xegDXL6u5LFSUQdm5ozdM6xao9", Amount: int64(75200)},
+		{Address: "2MthzQgsQ8Rw8vPMtTsrTdqc9HsWiDHM9VY", Amount: int64(1000)},
 	}
 
 	req := proto.CreateUtxoTransactionRequest{
@@ -1737,7 +1737,8 @@ func TestCreateAndSignedTransactioFromDifferentAddressInCompress(t *testing.T) {
 	}
 
 	reply1, err := btcChainAdaptor.CreateUtxoTransaction(&req)
-	assert.Nil(t, err)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(reply1.SignHashes))
 	assert.Equal(t, expectedTxData, hex.EncodeToString(reply1.TxData))
 	assert.Equal(t, expectedHash1, hex.EncodeToString(reply1.SignHashes[0]))
 	assert.Equal(t, expectedHash2, hex.EncodeToString(reply1.SignHashes[1]))
@@ -1810,7 +1811,7 @@ func TestCreateAndSignedTransactioFromDifferentAddressInCompress(t *testing.T) {
 	assert.Equal(t, expectedSignedTx, hex.EncodeToString(reply2.SignedTxData))
 
 	res, err := btcChainAdaptor.decodeTx(reply2.SignedTxData, vin, true)
-	assert.Nil(t, err)
+	require.Nil(t, err)
 	assert.Equal(t, expectedHash, res.Hash)
 
 	req3 := proto.VerifySignedTransactionRequest{
@@ -1861,7 +1862,7 @@ func TestBroadcastTransaction3(t *testing.T) {
 func TestCreateAndSingedTransaction2(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	expectedRawDataStr := "010000000145138613310ded962b679f31268faaf73ca2cdf1e6e44c61d16cd21d97be71ed0000000000ffffffff02a0bb0d00000000001976a914eed9944e930bf91b0d0636be81430ec141eae51988acd07e0100000000001976a914ac80df1fa9dd5740c6f17e03b0ca6ce8d871c9a088ac00000000"
 	expectedSignHashStr := "1e589854304006c8669ca7fd9fbef23594eb33484886a4423ca8dc62908fd0be"
 	expectedPkDataStr := "0395a2c1cddab943e4eef857968e2a5cc2e587c92b598e8517830869c5f3203f3b"
@@ -1977,7 +1978,7 @@ func TestCreateAndSingedTransaction2(t *testing.T) {
 func TestCreateAndSingedTransaction3(t *testing.T) {
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	expectedRawDataStr := "0100000001804048ce8af4a27a742993f86be619c1cb78a6fc40e5914c6d026f3b91b5997b0000000000ffffffff01b8b70d00000000001976a914f5e14e43e474738731b7f1ec64b8d090e0e1f9fe88ac00000000"
 	expectedSignHashStr := "88ff62637e4ada4d18843cf988c02c4a6e97264b18e99806e6956fc29a83009c"
 	expectedPkDataStr := "0395a2c1cddab943e4eef857968e2a5cc2e587c92b598e8517830869c5f3203f3b"
@@ -2092,7 +2093,7 @@ func TestCreateAndSingedTransaction3(t *testing.T) {
 /* func TestCreateAndSingedTransaction4(t *testing.T) { */
 // btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-// client := btcChainAdaptor.getClient()
+// client := btcChainAdaptor.getClient().(*btcClient)
 // client.compressed = false
 // // expectedRawDataStr := "010000000145138613310ded962b679f31268faaf73ca2cdf1e6e44c61d16cd21d97be71ed0000000000ffffffff02a0bb0d00000000001976a914eed9944e930bf91b0d0636be81430ec141eae51988acd07e0100000000001976a914ac80df1fa9dd5740c6f17e03b0ca6ce8d871c9a088ac00000000"
 // // expectedSignHashStr := "1e589854304006c8669ca7fd9fbef23594eb33484886a4423ca8dc62908fd0be"
@@ -2167,7 +2168,7 @@ func TestBroadcastTx(t *testing.T) {
 	t.Skip("test with prepared env")
 	btcChainAdaptor := newChainAdaptorWithConfig(conf)
 
-	client := btcChainAdaptor.getClient()
+	client := btcChainAdaptor.getClient().(*btcClient)
 	client.compressed = true
 
 	vin := []*proto.Vin{
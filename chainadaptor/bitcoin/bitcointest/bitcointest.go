@@ -0,0 +1,48 @@
+// Package bitcointest provides test helpers for the chains built on the bitcoin adaptor
+package bitcointest
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// SignUtxoTransaction signs the sign hashes of a created tx with privKey,
+// assembles the signed tx and checks that it verifies. It is the shared part of
+// the offline tests of the chains built on the bitcoin adaptor.
+func SignUtxoTransaction(t *testing.T, adaptor chainadaptor.ChainAdaptor, chain *bitcoin.Chain, createReply *proto.CreateUtxoTransactionReply, vins []*proto.Vin, privKey *btcec.PrivateKey) *proto.CreateSignedTransactionReply {
+	req := &proto.CreateUtxoSignedTransactionRequest{
+		Chain:  chain.Name,
+		Symbol: chain.Symbol,
+		TxData: createReply.TxData,
+		Vins:   vins,
+	}
+	for _, signHash := range createReply.SignHashes {
+		sig, err := privKey.Sign(signHash)
+		require.Nil(t, err)
+		rs := make([]byte, 64)
+		r, s := sig.R.Bytes(), sig.S.Bytes()
+		copy(rs[32-len(r):32], r)
+		copy(rs[64-len(s):], s)
+		req.Signatures = append(req.Signatures, rs)
+		req.PublicKeys = append(req.PublicKeys, privKey.PubKey().SerializeCompressed())
+	}
+	signedReply, err := adaptor.CreateUtxoSignedTransaction(req)
+	require.Nil(t, err)
+
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        chain.Name,
+		Symbol:       chain.Symbol,
+		SignedTxData: signedReply.SignedTxData,
+		Vins:         vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+	return signedReply
+}
@@ -0,0 +1,117 @@
+package bitcoin
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/shopspring/decimal"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// reject reasons of bitcoin core mapped by prefix, checked in order
+var rejectReasons = []struct {
+	prefix string
+	code   proto.RejectCode
+}{
+	{"min relay fee not met", proto.RejectCode_REJECT_INSUFFICIENT_FEE},
+	{"mempool min fee not met", proto.RejectCode_REJECT_INSUFFICIENT_FEE},
+	{"insufficient fee", proto.RejectCode_REJECT_INSUFFICIENT_FEE},
+	{"min-fee-not-met", proto.RejectCode_REJECT_INSUFFICIENT_FEE},
+	{"max-fee-exceeded", proto.RejectCode_REJECT_FEE_TOO_HIGH},
+	{"absurdly-high-fee", proto.RejectCode_REJECT_FEE_TOO_HIGH},
+	{"Fee exceeds maximum configured by user", proto.RejectCode_REJECT_FEE_TOO_HIGH},
+	{"missing-inputs", proto.RejectCode_REJECT_MISSING_INPUTS},
+	{"bad-txns-inputs-missingorspent", proto.RejectCode_REJECT_MISSING_INPUTS},
+	{"txn-mempool-conflict", proto.RejectCode_REJECT_ALREADY_SPENT},
+	{"txn-already-in-mempool", proto.RejectCode_REJECT_ALREADY_KNOWN},
+	{"txn-already-known", proto.RejectCode_REJECT_ALREADY_KNOWN},
+	{"Transaction already in block chain", proto.RejectCode_REJECT_ALREADY_KNOWN},
+	{"non-mandatory-script-verify-flag", proto.RejectCode_REJECT_NON_STANDARD},
+	{"bad-txns-nonstandard-inputs", proto.RejectCode_REJECT_NON_STANDARD},
+	{"non-final", proto.RejectCode_REJECT_NON_STANDARD},
+	{"non-BIP68-final", proto.RejectCode_REJECT_NON_STANDARD},
+	{"version", proto.RejectCode_REJECT_NON_STANDARD},
+	{"tx-size", proto.RejectCode_REJECT_NON_STANDARD},
+	{"scriptsig-size", proto.RejectCode_REJECT_NON_STANDARD},
+	{"scriptsig-not-pushonly", proto.RejectCode_REJECT_NON_STANDARD},
+	{"scriptpubkey", proto.RejectCode_REJECT_NON_STANDARD},
+	{"bare-multisig", proto.RejectCode_REJECT_NON_STANDARD},
+	{"dust", proto.RejectCode_REJECT_NON_STANDARD},
+	{"multi-op-return", proto.RejectCode_REJECT_NON_STANDARD},
+	{"too-long-mempool-chain", proto.RejectCode_REJECT_NON_STANDARD},
+	{"mandatory-script-verify-flag-failed", proto.RejectCode_REJECT_INVALID},
+	{"bad-", proto.RejectCode_REJECT_INVALID},
+	{"TX decode failed", proto.RejectCode_REJECT_INVALID},
+}
+
+// rejectCode classifies a reject reason of testmempoolaccept or sendrawtransaction
+func rejectCode(reason string) proto.RejectCode {
+	if reason == "" {
+		return proto.RejectCode_REJECT_NONE
+	}
+	// rpc errors are prefixed with the error code, e.g. "-26: dust"
+	if i := strings.Index(reason, ": "); i > 0 {
+		if _, err := strconv.Atoi(reason[:i]); err == nil {
+			reason = reason[i+2:]
+		}
+	}
+	for _, r := range rejectReasons {
+		if strings.HasPrefix(reason, r.prefix) {
+			return r.code
+		}
+	}
+	return proto.RejectCode_REJECT_OTHER
+}
+
+// maxFeeRateParam converts a max fee rate in sat/vB to the btc/kvB the node takes,
+// 0 takes the max fee rate of the chain policy
+func (a *ChainAdaptor) maxFeeRateParam(satPerVB uint64) string {
+	if satPerVB == 0 {
+		satPerVB = uint64(a.chain.Policy.MaxFeeRate)
+	}
+	return decimal.New(int64(satPerVB)*1000, -btcDecimals).StringFixed(btcDecimals)
+}
+
+// DryRunBroadcast checks whether the node would accept the signed tx into its
+// mempool without broadcasting it
+func (a *ChainAdaptor) DryRunBroadcast(req *proto.BroadcastTransactionRequest) (*proto.DryRunBroadcastReply, error) {
+	_, err := a.deserializeTx(req.SignedTxData)
+	if err != nil {
+		return &proto.DryRunBroadcastReply{
+			Code:       proto.ReturnCode_ERROR,
+			Msg:        err.Error(),
+			RejectCode: proto.RejectCode_REJECT_INVALID,
+		}, err
+	}
+
+	node, err := a.getNode()
+	if err != nil {
+		return &proto.DryRunBroadcastReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	result, err := node.TestMempoolAccept(req.SignedTxData, a.maxFeeRateParam(req.MaxFeeRate))
+	if err != nil {
+		log.Error("DryRunBroadcast TestMempoolAccept", "err", err)
+		return &proto.DryRunBroadcastReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	reply := &proto.DryRunBroadcastReply{
+		Code:         proto.ReturnCode_SUCCESS,
+		Allowed:      result.Allowed,
+		TxHash:       result.Txid,
+		RejectCode:   rejectCode(result.RejectReason),
+		RejectReason: result.RejectReason,
+		Vsize:        result.VSize,
+	}
+	if result.Allowed {
+		reply.Fee = btcToSatoshi(result.Fees.Base).String()
+	}
+	return reply, nil
+}
@@ -0,0 +1,131 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestRejectCodeOffline(t *testing.T) {
+	cases := map[string]proto.RejectCode{
+		"":                                 proto.RejectCode_REJECT_NONE,
+		"min relay fee not met, 100 < 141": proto.RejectCode_REJECT_INSUFFICIENT_FEE,
+		"-26: mempool min fee not met":     proto.RejectCode_REJECT_INSUFFICIENT_FEE,
+		"max-fee-exceeded":                 proto.RejectCode_REJECT_FEE_TOO_HIGH,
+		"missing-inputs":                   proto.RejectCode_REJECT_MISSING_INPUTS,
+		"-26: txn-mempool-conflict":        proto.RejectCode_REJECT_ALREADY_SPENT,
+		"txn-already-known":                proto.RejectCode_REJECT_ALREADY_KNOWN,
+		"dust":                             proto.RejectCode_REJECT_NON_STANDARD,
+		"scriptpubkey":                     proto.RejectCode_REJECT_NON_STANDARD,
+		"bad-txns-in-belowout":             proto.RejectCode_REJECT_INVALID,
+		"-22: TX decode failed":            proto.RejectCode_REJECT_INVALID,
+		"something the node came up with":  proto.RejectCode_REJECT_OTHER,
+	}
+	for reason, code := range cases {
+		assert.Equal(t, code, rejectCode(reason), reason)
+	}
+
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	assert.Equal(t, defaultMaxFeeRate, adaptor.maxFeeRateParam(0))
+	assert.Equal(t, "0.02000000", adaptor.maxFeeRateParam(2000))
+	assert.Equal(t, "0.00025000", adaptor.maxFeeRateParam(25))
+}
+
+func testSignedTx(t *testing.T) ([]byte, string) {
+	prevHash, err := chainhash.NewHashFromStr(fmt.Sprintf("%064x", 1))
+	require.Nil(t, err)
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), []byte{0x51}, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	var buf bytes.Buffer
+	require.Nil(t, msgTx.Serialize(&buf))
+	return buf.Bytes(), msgTx.TxHash().String()
+}
+
+func TestDryRunBroadcastMockNode(t *testing.T) {
+	txData, txHash := testSignedTx(t)
+	reject := ""
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"testmempoolaccept": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var maxFeeRate string
+			require.Nil(t, json.Unmarshal(params[1], &maxFeeRate))
+			assert.Equal(t, "0.00050000", maxFeeRate)
+			if reject != "" {
+				return []*TestMempoolAcceptResult{{Txid: txHash, Allowed: false, RejectReason: reject}}, nil
+			}
+			return []*TestMempoolAcceptResult{{Txid: txHash, Allowed: true, VSize: 110, Fees: TestMempoolAcceptFees{Base: 0.0000055}}}, nil
+		},
+	})
+	defer server.Close()
+
+	req := &proto.BroadcastTransactionRequest{Chain: ChainName, SignedTxData: txData, MaxFeeRate: 50}
+	reply, err := adaptor.DryRunBroadcast(req)
+	require.Nil(t, err)
+	assert.True(t, reply.Allowed)
+	assert.Equal(t, txHash, reply.TxHash)
+	assert.Equal(t, "550", reply.Fee)
+	assert.Equal(t, int64(110), reply.Vsize)
+	assert.Equal(t, proto.RejectCode_REJECT_NONE, reply.RejectCode)
+
+	reject = "txn-mempool-conflict"
+	reply, err = adaptor.DryRunBroadcast(req)
+	require.Nil(t, err)
+	assert.False(t, reply.Allowed)
+	assert.Equal(t, proto.RejectCode_REJECT_ALREADY_SPENT, reply.RejectCode)
+	assert.Equal(t, reject, reply.RejectReason)
+}
+
+func TestBroadcastTransactionCheckPolicyMockNode(t *testing.T) {
+	txData, txHash := testSignedTx(t)
+	reject := "min relay fee not met, 100 < 110"
+	sent := 0
+	var sendErr *btcjson.RPCError
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"testmempoolaccept": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return []*TestMempoolAcceptResult{{Txid: txHash, Allowed: reject == "", RejectReason: reject}}, nil
+		},
+		"getnetworkinfo": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &GetNetworkInfoResult{Version: 250000}, nil
+		},
+		"sendrawtransaction": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var maxFeeRate string
+			require.Nil(t, json.Unmarshal(params[1], &maxFeeRate))
+			assert.Equal(t, defaultMaxFeeRate, maxFeeRate)
+			sent++
+			if sendErr != nil {
+				return nil, sendErr
+			}
+			return txHash, nil
+		},
+	})
+	defer server.Close()
+
+	req := &proto.BroadcastTransactionRequest{Chain: ChainName, SignedTxData: txData, CheckPolicy: true}
+	reply, err := adaptor.BroadcastTransaction(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.RejectCode_REJECT_INSUFFICIENT_FEE, reply.RejectCode)
+	assert.Equal(t, 0, sent)
+
+	reject = ""
+	reply, err = adaptor.BroadcastTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, txHash, reply.TxHash)
+	assert.Equal(t, 1, sent)
+
+	// without the check the reason comes from the broadcast itself
+	req.CheckPolicy = false
+	sendErr = btcjson.NewRPCError(-26, "dust")
+	reply, err = adaptor.BroadcastTransaction(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.RejectCode_REJECT_NON_STANDARD, reply.RejectCode)
+}
@@ -1,7 +1,6 @@
 package bitcoin
 
 import (
-	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"strings"
@@ -19,50 +18,55 @@ import (
 
 const (
 	omniPrefix = "6f6d6e69"
+
+	// defaultMaxFeeRate is the max fee rate in btc/kvB accepted on broadcast
+	defaultMaxFeeRate = "0.02000000"
 )
 
 type btcClient struct {
 	*rpcclient.Client
 	chainConfig *chaincfg.Params
 	compressed  bool
+	// maxFeeRateVersion is the node version from which sendrawtransaction takes
+	// a max fee rate, 0 if none does
+	maxFeeRateVersion int32
+	// notifier follows the zmq notifications of the node, nil without any
+	notifier *zmqNotifier
+	// local is set for a client without a node behind it, which is never
+	// asked for node lookups
+	local bool
 }
 
-func newBtcClients(conf *config.Config) ([]*btcClient, error) {
-	chainConfig := &chaincfg.TestNet3Params
-	if conf.NetWork == "mainnet" {
-		chainConfig = &chaincfg.MainNetParams
-	} else if conf.NetWork == "regtest" {
-		chainConfig = &chaincfg.RegressionNetParams
-	}
-	log.Info("btc client setup", "network", conf.NetWork)
-
-	var clients []*btcClient
-	for _, rpc := range conf.Fullnode.Btc.RPCs {
-		client, err := rpcclient.New(&rpcclient.ConnConfig{
-			HTTPPostMode: true,
-			DisableTLS:   true,
-			Host:         rpc.RPCURL,
-			User:         rpc.RPCUser,
-			Pass:         rpc.RPCPass,
-		}, nil)
-		if err != nil {
-			log.Error("Fail to create BTC client", "err", err)
-			continue
-		}
-		clients = append(clients, &btcClient{
-			Client:      client,
-			chainConfig: chainConfig,
-			compressed:  true,
-		})
+// newBtcClient returns a client of the full node at rpc
+func newBtcClient(rpc *config.RPC, chain *Chain, chainConfig *chaincfg.Params) (*btcClient, error) {
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Host:         rpc.RPCURL,
+		User:         rpc.RPCUser,
+		Pass:         rpc.RPCPass,
+	}, nil)
+	if err != nil {
+		return nil, err
 	}
-	if len(clients) == 0 {
-		return nil, errors.New("No clients available")
+	btc := &btcClient{
+		Client:            client,
+		chainConfig:       chainConfig,
+		compressed:        true,
+		maxFeeRateVersion: chain.MaxFeeRateVersion,
 	}
-
-	return clients, nil
+	if btc.notifier = newZMQNotifier(rpc, btc); btc.notifier != nil {
+		btc.notifier.start()
+	}
+	return btc, nil
 }
 
 func newLocalBtcClient(network config.NetWorkType) *btcClient {
+	return newLocalClient(bitcoinChain, network)
+}
+
+// newLocalClient returns a client without a full node, for the offline operations
+func newLocalClient(chain *Chain, network config.NetWorkType) *btcClient {
 	client, err := rpcclient.New(&rpcclient.ConnConfig{
 		HTTPPostMode: true,
 		DisableTLS:   true,
@@ -75,21 +79,12 @@ func newLocalBtcClient(network config.NetWorkType) *btcClient {
 		panic("Fail to create BTC client")
 
 	}
-	var para *chaincfg.Params
-	switch network {
-	case config.MainNet:
-		para = &chaincfg.MainNetParams
-	case config.TestNet:
-		para = &chaincfg.TestNet3Params
-	case config.RegTest:
-		para = &chaincfg.RegressionNetParams
-	default:
-		panic("unsupported network type")
-	}
 	return &btcClient{
-		Client:      client,
-		chainConfig: para,
-		compressed:  true,
+		Client:            client,
+		chainConfig:       chain.Params(network),
+		compressed:        true,
+		maxFeeRateVersion: chain.MaxFeeRateVersion,
+		local:             true,
 	}
 }
 
@@ -111,12 +106,25 @@ type EstimateSmartFeeResult struct {
 
 // EstimateSmartFee provides an estimated fee  in bitcoins per kilobyte.
 func (btc *btcClient) EstimateSmartFee(numBlocks int64) (EstimateSmartFeeResult, error) {
+	return btc.EstimateSmartFeeWithMode(numBlocks, "")
+}
+
+// EstimateSmartFeeWithMode provides an estimated fee in bitcoins per kilobyte
+// using the given estimate mode, the node's default mode is used when empty.
+func (btc *btcClient) EstimateSmartFeeWithMode(numBlocks int64, mode string) (EstimateSmartFeeResult, error) {
 	var reply = EstimateSmartFeeResult{}
 
 	params, err := marshal(numBlocks)
 	if err != nil {
 		return reply, err
 	}
+	if mode != "" {
+		modeJSON, err := json.Marshal(mode)
+		if err != nil {
+			return reply, errors.Wrap(err, "could not marshal estimate mode")
+		}
+		params = append(params, modeJSON)
+	}
 
 	data, err := btc.RawRequest("estimatesmartfee", params)
 	if err != nil {
@@ -145,37 +153,81 @@ func marshal(numBlocks int64) ([]json.RawMessage, error) {
 	return params, nil
 }
 
-func (btc *btcClient) SendRawTransaction(tx *wire.MsgTx) (*chainhash.Hash, error) {
+// SendRawTransaction broadcasts the serialized tx, rejecting it above
+// maxFeeRateInBtcPerK or the default max fee rate if it is empty
+func (btc *btcClient) SendRawTransaction(txData []byte, maxFeeRateInBtcPerK string) (*chainhash.Hash, error) {
+	if maxFeeRateInBtcPerK == "" {
+		maxFeeRateInBtcPerK = defaultMaxFeeRate
+	}
+	if btc.maxFeeRateVersion == 0 {
+		return btc.sendRawTransaction(txData, false)
+	}
 	networkInfo, err := btc.GetNetworkInfo()
-	defaultFeeRate := "0.02000000"
 	if err != nil {
 		log.Warn("failed to get btc networkinfo, use latest api")
-		return btc.SendRawTransaction190001(tx, defaultFeeRate)
+		return btc.sendRawTransaction(txData, maxFeeRateInBtcPerK)
 	}
 
-	if networkInfo.Version >= 190001 {
-		return btc.SendRawTransaction190001(tx, defaultFeeRate)
+	if networkInfo.Version >= btc.maxFeeRateVersion {
+		return btc.sendRawTransaction(txData, maxFeeRateInBtcPerK)
 	}
-	return btc.Client.SendRawTransaction(tx, false)
+	return btc.sendRawTransaction(txData, false)
 }
 
-func (btc *btcClient) SendRawTransaction190001(tx *wire.MsgTx, maxFeeRateInBtcPerK string) (*chainhash.Hash, error) {
-	txHex := ""
-	if tx != nil {
-		// Serialize the transaction and convert to hex string.
-		buf := bytes.NewBuffer(make([]byte, 0, tx.SerializeSize()))
-		if err := tx.Serialize(buf); err != nil {
-			return nil, err
-		}
-		txHex = hex.EncodeToString(buf.Bytes())
+type TestMempoolAcceptFees struct {
+	Base float64 `json:"base"`
+}
+
+type TestMempoolAcceptResult struct {
+	Txid         string                `json:"txid"`
+	Wtxid        string                `json:"wtxid"`
+	Allowed      bool                  `json:"allowed"`
+	VSize        int64                 `json:"vsize"`
+	Fees         TestMempoolAcceptFees `json:"fees"`
+	RejectReason string                `json:"reject-reason"`
+}
+
+// TestMempoolAccept runs the mempool acceptance checks on the serialized tx
+// without broadcasting it
+func (btc *btcClient) TestMempoolAccept(txData []byte, maxFeeRateInBtcPerK string) (*TestMempoolAcceptResult, error) {
+	if maxFeeRateInBtcPerK == "" {
+		maxFeeRateInBtcPerK = defaultMaxFeeRate
+	}
+	rawTxsJSON, err := json.Marshal([]string{hex.EncodeToString(txData)})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal raw txs")
+	}
+	maxFeeRateJSON, err := json.Marshal(maxFeeRateInBtcPerK)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal max fee rate")
+	}
+
+	data, err := btc.RawRequest("testmempoolaccept", []json.RawMessage{rawTxsJSON, maxFeeRateJSON})
+	if err != nil {
+		return nil, err
 	}
 
+	var result []*TestMempoolAcceptResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 1 {
+		return nil, errors.New("unexpected testmempoolaccept result")
+	}
+
+	return result[0], nil
+}
+
+// sendRawTransaction sends the serialized tx with the fee check argument of the
+// node's api, allowhighfees before 0.19.0.1 and a max fee rate since
+func (btc *btcClient) sendRawTransaction(txData []byte, feeCheck interface{}) (*chainhash.Hash, error) {
 	var params []json.RawMessage
-	maxFeeRateJSON, err := json.Marshal(maxFeeRateInBtcPerK)
+	maxFeeRateJSON, err := json.Marshal(feeCheck)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal numBlocks")
 	}
-	txHexJSON, err := json.Marshal(txHex)
+	txHexJSON, err := json.Marshal(hex.EncodeToString(txData))
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal numBlocks")
 	}
@@ -215,34 +267,89 @@ type GetBlockVerboseResult struct {
 }
 
 func (btc *btcClient) GetBlockWithRawTransactionVerbose(blockHash *chainhash.Hash) (*GetBlockVerboseResult, error) {
-	hash := ""
-	if blockHash != nil {
-		hash = blockHash.String()
-	}
-	hashJSON, err := json.Marshal(hash)
+	data, err := btc.getBlock(blockHash, 2)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not marshal hash")
+		return nil, err
 	}
 
-	verboseJSON, err := json.Marshal(2)
+	var result GetBlockVerboseResult
+	err = json.Unmarshal(data, &result)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not marshal verbose")
+		return nil, err
 	}
 
-	params := []json.RawMessage{hashJSON, verboseJSON}
+	return &result, nil
+}
+
+type VinPrevout struct {
+	Generated    bool    `json:"generated"`
+	Height       int64   `json:"height"`
+	Value        float64 `json:"value"`
+	ScriptPubKey struct {
+		Hex string `json:"hex"`
+	} `json:"scriptPubKey"`
+}
 
-	data, err := btc.RawRequest("getblock", params)
+type blockPrevoutsResult struct {
+	Tx []struct {
+		Vin []struct {
+			Prevout *VinPrevout `json:"prevout"`
+		} `json:"vin"`
+	} `json:"tx"`
+}
+
+// GetBlockWithPrevouts gets the block at verbosity 3, which adds the spent output to
+// every input since bitcoin core 23. The prevouts are indexed by tx and input and
+// are nil if the node does not provide them.
+func (btc *btcClient) GetBlockWithPrevouts(blockHash *chainhash.Hash) (*GetBlockVerboseResult, [][]*VinPrevout, error) {
+	data, err := btc.getBlock(blockHash, 3)
+	if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCInvalidParameter {
+		// nodes rejecting the verbosity do not know about prevouts
+		data, err = btc.getBlock(blockHash, 2)
+	}
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var result GetBlockVerboseResult
 	err = json.Unmarshal(data, &result)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+	var prevoutsResult blockPrevoutsResult
+	err = json.Unmarshal(data, &prevoutsResult)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &result, nil
+	prevouts := make([][]*VinPrevout, len(prevoutsResult.Tx))
+	for i, tx := range prevoutsResult.Tx {
+		prevouts[i] = make([]*VinPrevout, len(tx.Vin))
+		for j, vin := range tx.Vin {
+			prevouts[i][j] = vin.Prevout
+		}
+	}
+	return &result, prevouts, nil
+}
+
+func (btc *btcClient) getBlock(blockHash *chainhash.Hash, verbosity int) (json.RawMessage, error) {
+	hash := ""
+	if blockHash != nil {
+		hash = blockHash.String()
+	}
+	hashJSON, err := json.Marshal(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal hash")
+	}
+
+	verboseJSON, err := json.Marshal(verbosity)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal verbose")
+	}
+
+	params := []json.RawMessage{hashJSON, verboseJSON}
+
+	return btc.RawRequest("getblock", params)
 }
 
 type GetNetworkInfoResult struct {
@@ -276,6 +383,194 @@ func (btc *btcClient) GetNetworkInfo() (*GetNetworkInfoResult, error) {
 	return &result, nil
 }
 
+type GetMempoolInfoResult struct {
+	Loaded        bool    `json:"loaded"`
+	Size          int64   `json:"size"`
+	Bytes         int64   `json:"bytes"`
+	Usage         int64   `json:"usage"`
+	MaxMempool    int64   `json:"maxmempool"`
+	MempoolMinFee float64 `json:"mempoolminfee"`
+	MinRelayTxFee float64 `json:"minrelaytxfee"`
+}
+
+func (btc *btcClient) GetMempoolInfo() (*GetMempoolInfoResult, error) {
+	data, err := btc.RawRequest("getmempoolinfo", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GetMempoolInfoResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type MempoolEntryFees struct {
+	Base       float64 `json:"base"`
+	Modified   float64 `json:"modified"`
+	Ancestor   float64 `json:"ancestor"`
+	Descendant float64 `json:"descendant"`
+}
+
+type MempoolEntryResult struct {
+	VSize             int64            `json:"vsize"`
+	Weight            int64            `json:"weight"`
+	Time              int64            `json:"time"`
+	Height            int64            `json:"height"`
+	DescendantCount   int64            `json:"descendantcount"`
+	DescendantSize    int64            `json:"descendantsize"`
+	AncestorCount     int64            `json:"ancestorcount"`
+	AncestorSize      int64            `json:"ancestorsize"`
+	Fees              MempoolEntryFees `json:"fees"`
+	Depends           []string         `json:"depends"`
+	SpentBy           []string         `json:"spentby"`
+	BIP125Replaceable bool             `json:"bip125-replaceable"`
+}
+
+// GetRawMempoolVerbose returns the mempool entries keyed by txid
+func (btc *btcClient) GetRawMempoolVerbose() (map[string]*MempoolEntryResult, error) {
+	verboseJSON, err := json.Marshal(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal verbose")
+	}
+
+	data, err := btc.RawRequest("getrawmempool", []json.RawMessage{verboseJSON})
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]*MempoolEntryResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetMempoolEntry returns the mempool entry of a transaction
+func (btc *btcClient) GetMempoolEntry(txHash string) (*MempoolEntryResult, error) {
+	txHashJSON, err := json.Marshal(txHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal tx hash")
+	}
+
+	data, err := btc.RawRequest("getmempoolentry", []json.RawMessage{txHashJSON})
+	if err != nil {
+		return nil, err
+	}
+
+	var result MempoolEntryResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type TxSpendingPrevoutResult struct {
+	Txid         string `json:"txid"`
+	Vout         uint32 `json:"vout"`
+	SpendingTxid string `json:"spendingtxid"`
+}
+
+// GetTxSpendingPrevout returns the mempool txs spending the outpoints, it is available
+// since bitcoin core 24
+func (btc *btcClient) GetTxSpendingPrevout(outpoints []wire.OutPoint) ([]TxSpendingPrevoutResult, error) {
+	type prevout struct {
+		Txid string `json:"txid"`
+		Vout uint32 `json:"vout"`
+	}
+	prevouts := make([]prevout, len(outpoints))
+	for i, outpoint := range outpoints {
+		prevouts[i] = prevout{Txid: outpoint.Hash.String(), Vout: outpoint.Index}
+	}
+	prevoutsJSON, err := json.Marshal(prevouts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal outpoints")
+	}
+
+	data, err := btc.RawRequest("gettxspendingprevout", []json.RawMessage{prevoutsJSON})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []TxSpendingPrevoutResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetLatestBlockHeight returns the head announced over zmq, or polls the node
+// while the notifications are silent
 func (btc *btcClient) GetLatestBlockHeight() (int64, error) {
+	if height, ok := btc.notifier.head(); ok {
+		return height, nil
+	}
 	return btc.Client.GetBlockCount()
 }
+
+type ScanTxOutSetUnspent struct {
+	Txid         string  `json:"txid"`
+	Vout         uint32  `json:"vout"`
+	ScriptPubKey string  `json:"scriptPubKey"`
+	Desc         string  `json:"desc"`
+	Amount       float64 `json:"amount"`
+	Height       int64   `json:"height"`
+}
+
+type ScanTxOutSetResult struct {
+	Success     bool                  `json:"success"`
+	TxOuts      int64                 `json:"txouts"`
+	Height      int64                 `json:"height"`
+	BestBlock   string                `json:"bestblock"`
+	Unspents    []ScanTxOutSetUnspent `json:"unspents"`
+	TotalAmount float64               `json:"total_amount"`
+}
+
+// ListUnspent scans the utxo set of the node, which holds confirmed outputs only
+func (btc *btcClient) ListUnspent(addresses []string) (*ScanTxOutSetResult, error) {
+	return btc.ScanTxOutSet(addresses)
+}
+
+// ScanTxOutSet scans the node's UTXO set for outputs paying to the given addresses.
+func (btc *btcClient) ScanTxOutSet(addresses []string) (*ScanTxOutSetResult, error) {
+	descriptors := make([]string, len(addresses))
+	for i, address := range addresses {
+		descriptors[i] = "addr(" + address + ")"
+	}
+
+	actionJSON, err := json.Marshal("start")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal action")
+	}
+	descriptorsJSON, err := json.Marshal(descriptors)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal descriptors")
+	}
+	params := []json.RawMessage{actionJSON, descriptorsJSON}
+
+	data, err := btc.RawRequest("scantxoutset", params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ScanTxOutSetResult
+	err = json.Unmarshal(data, &result)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal scantxoutset result")
+	}
+
+	if !result.Success {
+		return nil, errors.New("scantxoutset aborted")
+	}
+
+	return &result, nil
+}
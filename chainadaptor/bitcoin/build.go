@@ -0,0 +1,282 @@
+package bitcoin
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// BuildUtxoTransaction selects inputs for the requested outputs at the given fee
+// rate, adds change when it is worth it and returns the unsigned transaction
+func (a *ChainAdaptor) BuildUtxoTransaction(req *proto.BuildUtxoTransactionRequest) (*proto.BuildUtxoTransactionReply, error) {
+	reply, err := a.buildUtxoTransaction(req)
+	if err != nil {
+		log.Error("BuildUtxoTransaction", "err", err)
+		return &proto.BuildUtxoTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return reply, nil
+}
+
+func (a *ChainAdaptor) buildUtxoTransaction(req *proto.BuildUtxoTransactionRequest) (*proto.BuildUtxoTransactionReply, error) {
+	if len(req.Vouts) == 0 {
+		return nil, fmt.Errorf("no Vout in req:%v", req)
+	}
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+
+	changePkScript, err := a.addressPkScript(req.ChangeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change address: %v", err)
+	}
+
+	params := &coinSelectionParams{
+		feeRate:         int64(req.FeeRate),
+		outPkScripts:    make([][]byte, 0, len(req.Vouts)),
+		changeAddress:   req.ChangeAddress,
+		changePkScript:  changePkScript,
+		inputSize:       a.vinInputSize,
+		changeInputSize: a.pkScriptInputSize(changePkScript, nil),
+		minChange:       a.dustThreshold(changePkScript),
+	}
+	for i, out := range req.Vouts {
+		if out.Omni != nil && out.Amount != 0 {
+			return nil, fmt.Errorf("omni vout %d can not carry an amount", i)
+		}
+		if out.Omni == nil && out.Amount <= 0 {
+			return nil, fmt.Errorf("invalid amount of vout %d", i)
+		}
+		pkScript, err := a.voutPkScript(out)
+		if err != nil {
+			return nil, err
+		}
+		params.outputsValue += out.Amount
+		params.outPkScripts = append(params.outPkScripts, pkScript)
+	}
+
+	utxos, err := a.candidateUtxos(req.Utxos, req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	vins, err := selectCoins(utxos, params)
+	if err != nil {
+		return nil, err
+	}
+
+	vouts, fee, changeIndex, err := addChange(vins, req.Vouts, params)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, signHashes, err := a.buildUnsignedTx(vins, vouts, req.Replaceable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.BuildUtxoTransactionReply{
+		Code:        proto.ReturnCode_SUCCESS,
+		TxData:      txData,
+		SignHashes:  signHashes,
+		Vins:        vins,
+		Vouts:       vouts,
+		Fee:         strconv.FormatInt(fee, 10),
+		ChangeIndex: int32(changeIndex),
+	}, nil
+}
+
+// candidateUtxos returns utxos, or the confirmed utxos of addresses when none are
+// given. Only utxos the sign hashes of the adaptor cover are candidates, listed
+// ones of other script types are left out.
+func (a *ChainAdaptor) candidateUtxos(utxos []*proto.Vin, addresses []string) ([]*proto.Vin, error) {
+	if len(utxos) > 0 {
+		for _, utxo := range utxos {
+			if err := a.checkSignable(utxo); err != nil {
+				return nil, fmt.Errorf("utxo %s:%d: %v", utxo.Hash, utxo.Index, err)
+			}
+		}
+		return utxos, nil
+	}
+	if len(addresses) == 0 {
+		return nil, errors.New("neither utxos nor addresses in req")
+	}
+	listed, _, err := a.listUnspent(addresses, confirms)
+	if err != nil {
+		return nil, err
+	}
+	utxos = make([]*proto.Vin, 0, len(listed))
+	for _, utxo := range listed {
+		vin := &proto.Vin{
+			Hash:    utxo.Hash,
+			Index:   utxo.Index,
+			Amount:  utxo.Amount,
+			Address: utxo.Address,
+		}
+		if err := a.checkSignable(vin); err != nil {
+			log.Warn("candidateUtxos skip", "hash", utxo.Hash, "index", utxo.Index, "err", err)
+			continue
+		}
+		utxos = append(utxos, vin)
+	}
+	return utxos, nil
+}
+
+// checkSignable returns an error unless the sign hash of the input spending vin
+// can be computed: the legacy sign hash of a p2pkh output, or the BIP143 one of
+// a p2wsh output with its witness script. Other segwit outputs need the BIP143
+// sign hash of their own script, which is not built.
+func (a *ChainAdaptor) checkSignable(vin *proto.Vin) error {
+	pkScript, err := a.addressPkScript(vin.Address)
+	if err != nil {
+		return err
+	}
+	switch scriptType := pkScriptType(pkScript); {
+	case scriptType == scriptTypeP2PKH:
+	case scriptType == scriptTypeP2WSH && len(vin.WitnessScript) > 0:
+	default:
+		return fmt.Errorf("%s inputs can not be signed", scriptType)
+	}
+	return nil
+}
+
+// addChange appends a change output to the change address of params if the
+// inputs leave more than dust after paying the outputs and the fee. It returns
+// the outputs, the exact fee and the index of the change output or -1.
+// With an omni payload the change goes first, as omni takes the last output
+// as the reference recipient.
+func addChange(vins []*proto.Vin, vouts []*proto.Vout, params *coinSelectionParams) ([]*proto.Vout, int64, int, error) {
+	var totalAmountIn, totalAmountOut int64
+	for _, in := range vins {
+		totalAmountIn += in.Amount
+	}
+	changeIndex := len(vouts)
+	outs := make([]*proto.Vout, 0, len(vouts)+1)
+	for _, out := range vouts {
+		totalAmountOut += out.Amount
+		if out.Omni != nil {
+			changeIndex = 0
+		}
+		outs = append(outs, &proto.Vout{
+			Address: out.Address,
+			Amount:  out.Amount,
+			Omni:    out.Omni,
+		})
+	}
+
+	pkScripts := append(params.outPkScripts[:len(params.outPkScripts):len(params.outPkScripts)], params.changePkScript)
+	ins := make([]inputSize, len(vins))
+	for i, in := range vins {
+		ins[i] = params.inputSize(in)
+	}
+	feeWithChange := params.feeRate * estimateTxSize(ins, pkScripts)
+	change := totalAmountIn - totalAmountOut - feeWithChange
+	if change >= params.minChange {
+		outs = append(outs, nil)
+		copy(outs[changeIndex+1:], outs[changeIndex:])
+		outs[changeIndex] = &proto.Vout{
+			Address: params.changeAddress,
+			Amount:  change,
+		}
+		indexVouts(outs)
+		return outs, feeWithChange, changeIndex, nil
+	}
+	indexVouts(outs)
+
+	fee := params.feeRate * estimateTxSize(ins, params.outPkScripts)
+	if totalAmountIn-totalAmountOut < fee {
+		return nil, 0, 0, errInsufficientFunds
+	}
+	// the remainder is too small for a change output and goes to the fee
+	return outs, totalAmountIn - totalAmountOut, -1, nil
+}
+
+func indexVouts(vouts []*proto.Vout) {
+	for i, out := range vouts {
+		out.Index = uint32(i)
+	}
+}
+
+// buildUnsignedTx serializes the unsigned transaction and computes the sign hash of each input
+func (a *ChainAdaptor) buildUnsignedTx(vins []*proto.Vin, vouts []*proto.Vout, replaceable bool) ([]byte, [][]byte, error) {
+	rawTx, err := a.createRawTx(vins, vouts, replaceable)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txData, err := a.serializeTx(rawTx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	signHashes, err := a.calcSignHashes(rawTx, vins)
+	if err != nil {
+		return nil, nil, err
+	}
+	return txData, signHashes, nil
+}
+
+// serializeTx encodes tx in the transaction format of the chain
+func (a *ChainAdaptor) serializeTx(tx *wire.MsgTx) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := a.chain.TxCodec.Serialize(&buf, tx); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// deserializeTx decodes a transaction of the chain
+func (a *ChainAdaptor) deserializeTx(txData []byte) (*wire.MsgTx, error) {
+	return a.chain.TxCodec.Deserialize(bytes.NewReader(txData))
+}
+
+// txHash returns the id of tx on the chain
+func (a *ChainAdaptor) txHash(tx *wire.MsgTx) chainhash.Hash {
+	return a.chain.TxCodec.TxHash(tx)
+}
+
+// dustThreshold returns the amount below which an output to pkScript is dust on the chain
+func (a *ChainAdaptor) dustThreshold(pkScript []byte) int64 {
+	return a.chain.Policy.dustThreshold(pkScript)
+}
+
+// decodeAddress decodes an address of the chain on the network of the client
+func (a *ChainAdaptor) decodeAddress(address string) (btcutil.Address, error) {
+	return a.chain.Addresses.DecodeAddress(address, a.getClient().GetNetwork())
+}
+
+// encodeAddress returns the address string of the chain, or empty if it has none
+func (a *ChainAdaptor) encodeAddress(address btcutil.Address) string {
+	return a.chain.Addresses.EncodeAddress(address, a.getClient().GetNetwork())
+}
+
+func (a *ChainAdaptor) addressPkScript(address string) ([]byte, error) {
+	addr, err := a.decodeAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if !addr.IsForNet(a.getClient().GetNetwork()) {
+		return nil, errors.New("address is not valid for this network")
+	}
+	return txscript.PayToAddrScript(addr)
+}
+
+// pkScriptAddress returns the address a standard pkScript pays to, or empty
+func (a *ChainAdaptor) pkScriptAddress(pkScript []byte) string {
+	_, addrs, _, err := txscript.ExtractPkScriptAddrs(pkScript, a.getClient().GetNetwork())
+	if err != nil || len(addrs) == 0 {
+		return ""
+	}
+	return a.encodeAddress(addrs[0])
+}
@@ -0,0 +1,153 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+type testKey struct {
+	privKey *btcec.PrivateKey
+	address string
+}
+
+func newTestKey(seed string) *testKey {
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte(seed))
+	address, err := btcutil.NewAddressPubKey(pubKey.SerializeCompressed(), &chaincfg.TestNet3Params)
+	if err != nil {
+		panic(err)
+	}
+	return &testKey{privKey: privKey, address: address.EncodeAddress()}
+}
+
+// signTx puts a p2pkh signature script made from signHashes into every input of txData
+func signTx(t *testing.T, txData []byte, signHashes [][]byte, keys []*testKey) []byte {
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(txData)))
+	require.Equal(t, len(msgTx.TxIn), len(signHashes))
+
+	for i := range msgTx.TxIn {
+		pkData, sig := signOneVin(keys[i].privKey, signHashes[i], true)
+		sigScript, err := txscript.NewScriptBuilder().AddData(sig).AddData(pkData).Script()
+		require.Nil(t, err)
+		msgTx.TxIn[i].SignatureScript = sigScript
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, msgTx.SerializeSize()))
+	require.Nil(t, msgTx.Serialize(buf))
+	return buf.Bytes()
+}
+
+// rsSignature signs signHash and returns the signature as r and s, as CreateUtxoSignedTransaction takes it
+func rsSignature(t *testing.T, privKey *btcec.PrivateKey, signHash []byte) []byte {
+	sig, err := privKey.Sign(signHash)
+	require.Nil(t, err)
+	rs := make([]byte, 64)
+	r, s := sig.R.Bytes(), sig.S.Bytes()
+	copy(rs[32-len(r):32], r)
+	copy(rs[64-len(s):], s)
+	return rs
+}
+
+func TestBuildUtxoTransactionOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("build key")
+	to := newTestKey("build to")
+
+	utxos := make([]*proto.Vin, 0, 5)
+	for i, amount := range []int64{20000, 50000, 120000, 300, 1000000} {
+		utxos = append(utxos, &proto.Vin{
+			Hash:    fmt.Sprintf("%064x", i+1),
+			Index:   uint32(i),
+			Amount:  amount,
+			Address: from.address,
+		})
+	}
+
+	req := &proto.BuildUtxoTransactionRequest{
+		Chain:         ChainName,
+		Utxos:         utxos,
+		Vouts:         []*proto.Vout{{Address: to.address, Amount: 150000}},
+		FeeRate:       10,
+		ChangeAddress: from.address,
+	}
+	reply, err := adaptor.BuildUtxoTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+
+	var totalAmountIn, totalAmountOut int64
+	for _, in := range reply.Vins {
+		assert.NotEqual(t, int64(300), in.Amount)
+		totalAmountIn += in.Amount
+	}
+	for _, out := range reply.Vouts {
+		totalAmountOut += out.Amount
+	}
+	fee, err := strconv.ParseInt(reply.Fee, 10, 64)
+	require.Nil(t, err)
+	assert.Equal(t, totalAmountIn-totalAmountOut, fee)
+
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(reply.TxData)))
+	assert.Equal(t, len(reply.Vins), len(msgTx.TxIn))
+	assert.Equal(t, len(reply.Vouts), len(msgTx.TxOut))
+	if reply.ChangeIndex >= 0 {
+		assert.Equal(t, from.address, reply.Vouts[reply.ChangeIndex].Address)
+	}
+	// the fee pays for at least the estimated size at the requested rate
+	estimated := estimateTxSize(p2pkhInputs(len(msgTx.TxIn)), pkScriptsOf(&msgTx))
+	assert.True(t, fee >= estimated*10)
+
+	keys := make([]*testKey, len(reply.Vins))
+	for i := range keys {
+		keys[i] = from
+	}
+	signed := signTx(t, reply.TxData, reply.SignHashes, keys)
+	assert.True(t, int64(len(signed)) <= estimated)
+
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: signed,
+		Vins:         reply.Vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	// an exact match makes change unnecessary
+	exact := &proto.BuildUtxoTransactionRequest{
+		Chain:         ChainName,
+		Utxos:         utxos[:1],
+		Vouts:         []*proto.Vout{{Address: to.address, Amount: 20000 - 10*estimateTxSize(p2pkhInputs(1), pkScriptsOf(&msgTx)[:1])}},
+		FeeRate:       10,
+		ChangeAddress: from.address,
+	}
+	reply, err = adaptor.BuildUtxoTransaction(exact)
+	require.Nil(t, err)
+	assert.Equal(t, int32(-1), reply.ChangeIndex)
+	assert.Equal(t, 1, len(reply.Vouts))
+
+	req.Vouts[0].Amount = 2000000
+	reply, err = adaptor.BuildUtxoTransaction(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, errInsufficientFunds.Error(), reply.Msg)
+}
+
+func pkScriptsOf(msgTx *wire.MsgTx) [][]byte {
+	pkScripts := make([][]byte, len(msgTx.TxOut))
+	for i, out := range msgTx.TxOut {
+		pkScripts[i] = out.PkScript
+	}
+	return pkScripts
+}
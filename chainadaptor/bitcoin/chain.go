@@ -0,0 +1,291 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/bech32"
+	"golang.org/x/crypto/ripemd160"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+// AddressCodec converts between the address strings of a chain and btcutil addresses
+type AddressCodec interface {
+	DecodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error)
+	// EncodeAddress returns the address string, or empty if the chain has no
+	// encoding for the address type
+	EncodeAddress(address btcutil.Address, params *chaincfg.Params) string
+}
+
+// SigHasher computes the hashes the inputs of a chain sign and verifies their signatures
+type SigHasher interface {
+	// HashType returns the sighash type a signature of the requested hashType
+	// commits to and carries, including the flags the chain requires
+	HashType(hashType txscript.SigHashType) txscript.SigHashType
+	// SignHash returns the hash signed by input idx of tx, whose inputs spend prevOuts
+	SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType) ([]byte, error)
+	// VerifySignature checks the signature script of input idx of tx against the
+	// output it spends, prevOuts are the outputs spent by all inputs
+	VerifySignature(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) error
+}
+
+// TxCodec is the transaction format of a chain
+type TxCodec interface {
+	// NewTx returns an empty transaction of the version the chain creates
+	NewTx() *wire.MsgTx
+	Serialize(w io.Writer, tx *wire.MsgTx) error
+	Deserialize(r io.Reader) (*wire.MsgTx, error)
+	// TxHash returns the transaction id
+	TxHash(tx *wire.MsgTx) chainhash.Hash
+}
+
+// Chain parameterizes the adaptor for chains derived from bitcoin, which share
+// its transaction format and rpc interface
+type Chain struct {
+	Name   string
+	Symbol string
+	// Params returns the network parameters of the chain
+	Params func(network config.NetWorkType) *chaincfg.Params
+	// Node returns the full node config of the chain
+	Node      func(conf *config.Config) config.Node
+	Addresses AddressCodec
+	SigHasher SigHasher
+	TxCodec   TxCodec
+	Policy    Policy
+	// SegWit tells whether the chain has segregated witness
+	SegWit bool
+	// PowHash returns the hash of a block header checked against its target,
+	// the block hash if nil
+	PowHash func(header *wire.BlockHeader) (chainhash.Hash, error)
+	// MaxFeeRateVersion is the node version from which sendrawtransaction takes a
+	// max fee rate instead of allowhighfees, 0 if no version does
+	MaxFeeRateVersion int32
+}
+
+var bitcoinChain = &Chain{
+	Name:   ChainName,
+	Symbol: Symbol,
+	Params: BitcoinParams,
+	Node: func(conf *config.Config) config.Node {
+		return conf.Fullnode.Btc
+	},
+	Addresses:         Base58Addresses{},
+	SigHasher:         BitcoinSigHasher{},
+	TxCodec:           WireTxCodec{},
+	Policy:            BitcoinPolicy,
+	SegWit:            true,
+	MaxFeeRateVersion: 190001,
+}
+
+// BitcoinParams returns the bitcoin network parameters
+func BitcoinParams(network config.NetWorkType) *chaincfg.Params {
+	switch network {
+	case config.MainNet:
+		return &chaincfg.MainNetParams
+	case config.TestNet:
+		return &chaincfg.TestNet3Params
+	case config.RegTest:
+		return &chaincfg.RegressionNetParams
+	}
+	panic("unsupported network type")
+}
+
+// networkType parses the network of the config, which defaults to testnet
+func networkType(network string) config.NetWorkType {
+	switch network {
+	case "mainnet":
+		return config.MainNet
+	case "regtest":
+		return config.RegTest
+	}
+	return config.TestNet
+}
+
+// Base58Addresses is the address codec of bitcoin: base58check and bech32 for segwit
+type Base58Addresses struct{}
+
+func (Base58Addresses) DecodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	return btcutil.DecodeAddress(address, params)
+}
+
+func (Base58Addresses) EncodeAddress(address btcutil.Address, _ *chaincfg.Params) string {
+	return address.EncodeAddress()
+}
+
+// ParamsAddresses is the base58check and bech32 codec of a chain whose params are
+// not registered with chaincfg, it decodes against the given params only
+type ParamsAddresses struct{}
+
+func (ParamsAddresses) DecodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	if params.Bech32HRPSegwit != "" && strings.HasPrefix(strings.ToLower(address), params.Bech32HRPSegwit+"1") {
+		_, data, err := bech32.Decode(address)
+		if err != nil {
+			return nil, err
+		}
+		if len(data) == 0 || data[0] != 0 {
+			return nil, errors.New("only witness version 0 addresses are supported")
+		}
+		program, err := bech32.ConvertBits(data[1:], 5, 8, false)
+		if err != nil {
+			return nil, err
+		}
+		switch len(program) {
+		case ripemd160.Size:
+			return btcutil.NewAddressWitnessPubKeyHash(program, params)
+		case sha256.Size:
+			return btcutil.NewAddressWitnessScriptHash(program, params)
+		}
+		return nil, fmt.Errorf("invalid witness program of %d bytes", len(program))
+	}
+
+	decoded, netID, err := base58.CheckDecode(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) != ripemd160.Size {
+		return nil, errors.New("decoded address is of unknown size")
+	}
+	switch netID {
+	case params.PubKeyHashAddrID:
+		return btcutil.NewAddressPubKeyHash(decoded, params)
+	case params.ScriptHashAddrID:
+		return btcutil.NewAddressScriptHashFromHash(decoded, params)
+	}
+	return nil, btcutil.ErrUnknownAddressType
+}
+
+func (ParamsAddresses) EncodeAddress(address btcutil.Address, _ *chaincfg.Params) string {
+	return address.EncodeAddress()
+}
+
+// BitcoinSigHasher hashes legacy inputs as the original bitcoin protocol does
+type BitcoinSigHasher struct{}
+
+func (BitcoinSigHasher) HashType(hashType txscript.SigHashType) txscript.SigHashType {
+	return hashType
+}
+
+func (BitcoinSigHasher) SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType) ([]byte, error) {
+	if err := checkPrevOuts(tx, idx, prevOuts); err != nil {
+		return nil, err
+	}
+	return txscript.CalcSignatureHash(prevOuts[idx].PkScript, hashType, tx, idx)
+}
+
+func (BitcoinSigHasher) VerifySignature(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) error {
+	if err := checkPrevOuts(tx, idx, prevOuts); err != nil {
+		return err
+	}
+	vm, err := txscript.NewEngine(prevOuts[idx].PkScript, tx, idx, txscript.StandardVerifyFlags, nil, nil, prevOuts[idx].Value)
+	if err != nil {
+		return err
+	}
+	return vm.Execute()
+}
+
+// checkPrevOuts checks that idx is an input of tx and prevOuts has an output for each input
+func checkPrevOuts(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) error {
+	if idx < 0 || idx >= len(tx.TxIn) {
+		return fmt.Errorf("idx %d but %d txins", idx, len(tx.TxIn))
+	}
+	if len(prevOuts) != len(tx.TxIn) {
+		return fmt.Errorf("%d prevouts for %d txins", len(prevOuts), len(tx.TxIn))
+	}
+	return nil
+}
+
+// VerifyP2PKHSignature checks the p2pkh spend of input idx of tx for chains whose
+// sighash the txscript engine does not know. signHash returns the hash signed
+// with the hash type of the signature.
+func VerifyP2PKHSignature(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, signHash func(hashType txscript.SigHashType) ([]byte, error)) error {
+	if err := checkPrevOuts(tx, idx, prevOuts); err != nil {
+		return err
+	}
+	pkScript := prevOuts[idx].PkScript
+	if txscript.GetScriptClass(pkScript) != txscript.PubKeyHashTy {
+		return errors.New("only p2pkh inputs can be verified")
+	}
+	sigScript := tx.TxIn[idx].SignatureScript
+	pushes, err := txscript.PushedData(sigScript)
+	if err != nil {
+		return err
+	}
+	if !txscript.IsPushOnlyScript(sigScript) || len(pushes) != 2 || len(pushes[0]) == 0 {
+		return errors.New("signature script must push a signature and a public key")
+	}
+	sigData, pubKeyData := pushes[0], pushes[1]
+
+	// OP_DUP OP_HASH160 <20 byte hash> OP_EQUALVERIFY OP_CHECKSIG
+	if !bytes.Equal(btcutil.Hash160(pubKeyData), pkScript[3:23]) {
+		return errors.New("public key does not match the script")
+	}
+
+	signature, err := btcec.ParseDERSignature(sigData[:len(sigData)-1], btcec.S256())
+	if err != nil {
+		return err
+	}
+	pubKey, err := btcec.ParsePubKey(pubKeyData, btcec.S256())
+	if err != nil {
+		return err
+	}
+	hash, err := signHash(txscript.SigHashType(sigData[len(sigData)-1]))
+	if err != nil {
+		return err
+	}
+	if !signature.Verify(hash, pubKey) {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+// WireTxCodec is the transaction format of bitcoin
+type WireTxCodec struct{}
+
+func (WireTxCodec) NewTx() *wire.MsgTx {
+	return wire.NewMsgTx(wire.TxVersion)
+}
+
+func (WireTxCodec) Serialize(w io.Writer, tx *wire.MsgTx) error {
+	return tx.Serialize(w)
+}
+
+func (WireTxCodec) Deserialize(r io.Reader) (*wire.MsgTx, error) {
+	var tx wire.MsgTx
+	if err := tx.Deserialize(r); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+func (WireTxCodec) TxHash(tx *wire.MsgTx) chainhash.Hash {
+	return tx.TxHash()
+}
+
+// ScryptPowHash is the proof of work hash of litecoin and its descendants, the
+// scrypt hash of the header with N=1024, r=1 and p=1
+func ScryptPowHash(header *wire.BlockHeader) (chainhash.Hash, error) {
+	var buf bytes.Buffer
+	if err := header.Serialize(&buf); err != nil {
+		return chainhash.Hash{}, err
+	}
+	key, err := scrypt.Key(buf.Bytes(), buf.Bytes(), 1024, 1, 1, chainhash.HashSize)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	var hash chainhash.Hash
+	copy(hash[:], key)
+	return hash, nil
+}
@@ -0,0 +1,247 @@
+package bitcoin
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	bnbTotalTries         = 100000
+	knapsackSubsetRepeats = 1000
+)
+
+var errInsufficientFunds = errors.New("insufficient funds")
+
+// coin is a selection candidate, valued by what it adds to the transaction
+// once the fee for spending it is paid
+type coin struct {
+	vin      *proto.Vin
+	effValue int64
+}
+
+// coinSelectionParams describes the transaction the coins are selected for
+type coinSelectionParams struct {
+	feeRate        int64 // sat/vB
+	outputsValue   int64
+	outPkScripts   [][]byte
+	changeAddress  string
+	changePkScript []byte
+	// inputSize sizes the input spending a utxo, changeInputSize the one spending the change
+	inputSize       func(utxo *proto.Vin) inputSize
+	changeInputSize inputSize
+	// minChange is the dust threshold of the change output
+	minChange int64
+}
+
+// selectCoins picks a subset of utxos paying the outputs and their fee. It
+// tries branch-and-bound first to find a changeless solution and falls back to
+// the knapsack solver, whose result is expected to carry a change output.
+func selectCoins(utxos []*proto.Vin, params *coinSelectionParams) ([]*proto.Vin, error) {
+	coins := make([]*coin, 0, len(utxos))
+	for _, utxo := range utxos {
+		// skip utxos which cost more to spend than they are worth
+		inputFee := params.feeRate * params.inputSize(utxo).vsize()
+		if utxo.Amount-inputFee > 0 {
+			coins = append(coins, &coin{vin: utxo, effValue: utxo.Amount - inputFee})
+		}
+	}
+
+	// the fee of everything but the inputs, which is paid through effective values
+	fixedFee := params.feeRate * estimateTxSize(nil, params.outPkScripts)
+	target := params.outputsValue + fixedFee
+	changeOutputFee := params.feeRate * txOutSize(params.changePkScript)
+	costOfChange := changeOutputFee + params.feeRate*params.changeInputSize.vsize()
+
+	if selected, ok := selectCoinsBnB(coins, target, costOfChange); ok {
+		return coinsToVins(selected), nil
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if selected, ok := selectCoinsKnapsack(coins, target+changeOutputFee, params.minChange, rng); ok {
+		return coinsToVins(selected), nil
+	}
+	return nil, errInsufficientFunds
+}
+
+// selectCoinsBnB is the branch-and-bound search of Bitcoin Core: a depth first
+// search over the coins sorted by descending value for a selection within
+// [target, target+costOfChange], which needs no change output.
+func selectCoinsBnB(coins []*coin, target, costOfChange int64) ([]*coin, bool) {
+	sorted := make([]*coin, len(coins))
+	copy(sorted, coins)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].effValue > sorted[j].effValue })
+
+	var currValue, currAvailable int64
+	for _, c := range sorted {
+		currAvailable += c.effValue
+	}
+	if currAvailable < target {
+		return nil, false
+	}
+
+	var (
+		currSelection []bool
+		bestSelection []bool
+		bestWaste     int64 = math.MaxInt64
+	)
+	for i := 0; i < bnbTotalTries; i++ {
+		backtrack := false
+		if currValue+currAvailable < target || currValue > target+costOfChange {
+			backtrack = true
+		} else if currValue >= target {
+			// the excess over the target is given up to the fee
+			if waste := currValue - target; waste <= bestWaste {
+				bestSelection = append(bestSelection[:0], currSelection...)
+				bestWaste = waste
+			}
+			backtrack = true
+		}
+
+		if backtrack {
+			// walk back to the last included coin and try omitting it
+			for len(currSelection) > 0 && !currSelection[len(currSelection)-1] {
+				currSelection = currSelection[:len(currSelection)-1]
+				currAvailable += sorted[len(currSelection)].effValue
+			}
+			if len(currSelection) == 0 {
+				break
+			}
+			currSelection[len(currSelection)-1] = false
+			currValue -= sorted[len(currSelection)-1].effValue
+		} else {
+			c := sorted[len(currSelection)]
+			currAvailable -= c.effValue
+			// omitting a coin equal to the previously omitted one leads to a duplicate branch
+			if len(currSelection) > 0 && !currSelection[len(currSelection)-1] && c.effValue == sorted[len(currSelection)-1].effValue {
+				currSelection = append(currSelection, false)
+			} else {
+				currSelection = append(currSelection, true)
+				currValue += c.effValue
+			}
+		}
+	}
+
+	if bestSelection == nil {
+		return nil, false
+	}
+	var selected []*coin
+	for i, included := range bestSelection {
+		if included {
+			selected = append(selected, sorted[i])
+		}
+	}
+	return selected, true
+}
+
+// selectCoinsKnapsack is the knapsack solver of Bitcoin Core. It prefers an
+// exact match, then the best subset of the smaller coins leaving at least
+// minChange, and otherwise the smallest coin larger than the target.
+func selectCoinsKnapsack(coins []*coin, target, minChange int64, rng *rand.Rand) ([]*coin, bool) {
+	shuffled := make([]*coin, len(coins))
+	copy(shuffled, coins)
+	rng.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+	var (
+		lowestLarger *coin
+		applicable   []*coin
+		total        int64
+	)
+	for _, c := range shuffled {
+		if c.effValue == target {
+			return []*coin{c}, true
+		} else if c.effValue < target+minChange {
+			applicable = append(applicable, c)
+			total += c.effValue
+		} else if lowestLarger == nil || c.effValue < lowestLarger.effValue {
+			lowestLarger = c
+		}
+	}
+
+	if total == target {
+		return applicable, true
+	}
+	if total < target {
+		if lowestLarger == nil {
+			return nil, false
+		}
+		return []*coin{lowestLarger}, true
+	}
+
+	sort.SliceStable(applicable, func(i, j int) bool { return applicable[i].effValue > applicable[j].effValue })
+	best, bestValue := approximateBestSubset(applicable, total, target, rng)
+	if bestValue != target && total >= target+minChange {
+		best, bestValue = approximateBestSubset(applicable, total, target+minChange, rng)
+	}
+
+	// prefer the single larger coin if the subset would leave too little change
+	// or is no smaller than that coin
+	if lowestLarger != nil && ((bestValue != target && bestValue < target+minChange) || lowestLarger.effValue <= bestValue) {
+		return []*coin{lowestLarger}, true
+	}
+
+	var selected []*coin
+	for i, included := range best {
+		if included {
+			selected = append(selected, applicable[i])
+		}
+	}
+	return selected, true
+}
+
+// approximateBestSubset runs randomized passes over coins to find the subset
+// with the smallest total not below target
+func approximateBestSubset(coins []*coin, total, target int64, rng *rand.Rand) ([]bool, int64) {
+	best := make([]bool, len(coins))
+	for i := range best {
+		best[i] = true
+	}
+	bestValue := total
+
+	included := make([]bool, len(coins))
+	for rep := 0; rep < knapsackSubsetRepeats && bestValue != target; rep++ {
+		for i := range included {
+			included[i] = false
+		}
+		var sum int64
+		reachedTarget := false
+		for pass := 0; pass < 2 && !reachedTarget; pass++ {
+			for i, c := range coins {
+				// the first pass picks coins randomly, the second one fills up with the rest
+				var pick bool
+				if pass == 0 {
+					pick = rng.Intn(2) == 1
+				} else {
+					pick = !included[i]
+				}
+				if !pick {
+					continue
+				}
+				sum += c.effValue
+				included[i] = true
+				if sum >= target {
+					reachedTarget = true
+					if sum < bestValue {
+						bestValue = sum
+						copy(best, included)
+					}
+					sum -= c.effValue
+					included[i] = false
+				}
+			}
+		}
+	}
+	return best, bestValue
+}
+
+func coinsToVins(coins []*coin) []*proto.Vin {
+	vins := make([]*proto.Vin, len(coins))
+	for i, c := range coins {
+		vins[i] = c.vin
+	}
+	return vins
+}
@@ -0,0 +1,105 @@
+package bitcoin
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func newCoins(values ...int64) []*coin {
+	coins := make([]*coin, len(values))
+	for i, v := range values {
+		coins[i] = &coin{vin: &proto.Vin{Index: uint32(i), Amount: v}, effValue: v}
+	}
+	return coins
+}
+
+func coinsSum(coins []*coin) int64 {
+	var sum int64
+	for _, c := range coins {
+		sum += c.effValue
+	}
+	return sum
+}
+
+func TestSelectCoinsBnB(t *testing.T) {
+	coins := newCoins(1000, 2000, 3000, 4000, 10000)
+
+	selected, ok := selectCoinsBnB(coins, 5000, 0)
+	require.True(t, ok)
+	assert.Equal(t, int64(5000), coinsSum(selected))
+
+	// the window above the target allows a small excess
+	selected, ok = selectCoinsBnB(coins, 9950, 100)
+	require.True(t, ok)
+	assert.Equal(t, int64(10000), coinsSum(selected))
+
+	_, ok = selectCoinsBnB(coins, 20001, 0)
+	assert.False(t, ok)
+
+	// no combination in [10500, 10600]
+	_, ok = selectCoinsBnB(newCoins(10000, 20000), 10500, 100)
+	assert.False(t, ok)
+}
+
+func TestSelectCoinsKnapsack(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	selected, ok := selectCoinsKnapsack(newCoins(1000, 5000, 8000), 5000, 500, rng)
+	require.True(t, ok)
+	require.Equal(t, 1, len(selected))
+	assert.Equal(t, int64(5000), selected[0].effValue)
+
+	// the smallest larger coin beats a subset leaving too little change
+	selected, ok = selectCoinsKnapsack(newCoins(3000, 3000, 20000), 5800, 500, rng)
+	require.True(t, ok)
+	require.Equal(t, 1, len(selected))
+	assert.Equal(t, int64(20000), selected[0].effValue)
+
+	selected, ok = selectCoinsKnapsack(newCoins(3000, 3000, 1000, 20000), 5000, 500, rng)
+	require.True(t, ok)
+	assert.True(t, coinsSum(selected) >= 5500)
+	assert.True(t, coinsSum(selected) < 20000)
+
+	_, ok = selectCoinsKnapsack(newCoins(1000, 2000), 5000, 500, rng)
+	assert.False(t, ok)
+}
+
+func TestSelectCoinsSizesInputsByScript(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	key := newTestKey("coinselect sizes input scripts..")
+	pubKeyHash := btcutil.Hash160(key.privKey.PubKey().SerializeCompressed())
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(pubKeyHash, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+
+	p2pkhSize := adaptor.vinInputSize(&proto.Vin{Address: key.address})
+	p2wpkhSize := adaptor.vinInputSize(&proto.Vin{Address: p2wpkh.EncodeAddress()})
+	assert.Equal(t, inputSizes[scriptTypeP2PKH], p2pkhSize)
+	assert.Equal(t, inputSizes[scriptTypeP2WPKH], p2wpkhSize)
+
+	// at 10 sat/vB the p2wpkh utxo pays for its spend, the p2pkh one of the same amount does not
+	amount := 10*p2wpkhSize.vsize() + 500
+	changePkScript, err := adaptor.addressPkScript(key.address)
+	require.Nil(t, err)
+	params := &coinSelectionParams{
+		feeRate:         10,
+		outputsValue:    1,
+		changePkScript:  changePkScript,
+		inputSize:       adaptor.vinInputSize,
+		changeInputSize: adaptor.pkScriptInputSize(changePkScript, nil),
+	}
+	_, err = selectCoins([]*proto.Vin{{Amount: amount, Address: key.address}}, params)
+	assert.Equal(t, errInsufficientFunds, err)
+
+	utxo := &proto.Vin{Amount: amount, Address: p2wpkh.EncodeAddress()}
+	selected, err := selectCoins([]*proto.Vin{utxo}, params)
+	require.Nil(t, err)
+	assert.Equal(t, []*proto.Vin{utxo}, selected)
+}
@@ -0,0 +1,113 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// BuildCpfpTransaction builds a child spending an output of an unconfirmed
+// parent with a fee that lifts parent and child to the target fee rate
+func (a *ChainAdaptor) BuildCpfpTransaction(req *proto.BuildCpfpTransactionRequest) (*proto.BuildCpfpTransactionReply, error) {
+	reply, err := a.buildCpfpTransaction(req)
+	if err != nil {
+		log.Error("BuildCpfpTransaction", "err", err)
+		return &proto.BuildCpfpTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return reply, nil
+}
+
+func (a *ChainAdaptor) buildCpfpTransaction(req *proto.BuildCpfpTransactionRequest) (*proto.BuildCpfpTransactionReply, error) {
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+
+	parentHash, err := chainhash.NewHashFromStr(req.ParentTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	// the parent's unconfirmed ancestors are mined along with it
+	node, err := a.getNode()
+	if err != nil {
+		return nil, err
+	}
+	entry, err := node.GetMempoolEntry(req.ParentTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("parent is not in the mempool: %v", err)
+	}
+	packageFee := btcToSatoshi(entry.Fees.Ancestor).Int64()
+	packageVSize := entry.AncestorSize
+
+	txOut, err := node.GetTxOut(parentHash, req.Index, true)
+	if err != nil {
+		return nil, err
+	}
+	if txOut == nil {
+		return nil, errors.New("parent output is spent or does not exist")
+	}
+	pkScript, err := hex.DecodeString(txOut.ScriptPubKey.Hex)
+	if err != nil {
+		return nil, err
+	}
+	address := a.pkScriptAddress(pkScript)
+	if address == "" {
+		return nil, errors.New("parent output has no address")
+	}
+
+	vin := &proto.Vin{
+		Hash:    req.ParentTxHash,
+		Index:   req.Index,
+		Amount:  btcToSatoshi(txOut.Value).Int64(),
+		Address: address,
+	}
+	if err := a.checkSignable(vin); err != nil {
+		return nil, fmt.Errorf("parent output: %v", err)
+	}
+	toAddress := req.ToAddress
+	if toAddress == "" {
+		toAddress = vin.Address
+	}
+	toPkScript, err := a.addressPkScript(toAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid to address: %v", err)
+	}
+
+	vsize := estimateTxSize([]inputSize{a.pkScriptInputSize(pkScript, nil)}, [][]byte{toPkScript})
+	fee := int64(req.FeeRate)*(packageVSize+vsize) - packageFee
+	// the child has to be relayable on its own
+	if minFee := a.incrementalRelayFeeRate() * vsize; fee < minFee {
+		fee = minFee
+	}
+	amount := vin.Amount - fee
+	if amount < a.dustThreshold(toPkScript) {
+		return nil, fmt.Errorf("parent output of %d can not pay the child fee of %d", vin.Amount, fee)
+	}
+
+	vins := []*proto.Vin{vin}
+	vouts := []*proto.Vout{{Address: toAddress, Amount: amount, Index: 0}}
+	txData, signHashes, err := a.buildUnsignedTx(vins, vouts, req.Replaceable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.BuildCpfpTransactionReply{
+		Code:        proto.ReturnCode_SUCCESS,
+		TxData:      txData,
+		SignHashes:  signHashes,
+		Vins:        vins,
+		Vouts:       vouts,
+		Fee:         strconv.FormatInt(fee, 10),
+		ParentFee:   strconv.FormatInt(packageFee, 10),
+		ParentVsize: packageVSize,
+	}, nil
+}
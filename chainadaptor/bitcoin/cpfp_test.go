@@ -0,0 +1,105 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestBuildCpfpTransactionMockNode(t *testing.T) {
+	owner := newTestKey("cpfp key")
+	dest := newTestKey("cpfp dest")
+	parentHash := fmt.Sprintf("%064x", 7)
+	local := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	ownerPkScript, err := local.addressPkScript(owner.address)
+	require.Nil(t, err)
+	parentPkScript := ownerPkScript
+
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getmempoolentry": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			if json.Unmarshal(params[0], &txid) != nil || txid != parentHash {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey, "Transaction not in mempool")
+			}
+			return &MempoolEntryResult{
+				VSize:        200,
+				AncestorSize: 200,
+				Fees:         MempoolEntryFees{Base: 0.000002, Modified: 0.000002, Ancestor: 0.000002},
+			}, nil
+		},
+		"gettxout": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var index uint32
+			if json.Unmarshal(params[1], &index) != nil || index != 1 {
+				return nil, nil
+			}
+			return &btcjson.GetTxOutResult{
+				Value:        0.001,
+				ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(parentPkScript)},
+			}, nil
+		},
+	})
+	defer server.Close()
+
+	req := &proto.BuildCpfpTransactionRequest{
+		Chain:        ChainName,
+		ParentTxHash: parentHash,
+		Index:        1,
+		FeeRate:      10,
+		ToAddress:    dest.address,
+	}
+	reply, err := adaptor.BuildCpfpTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, "200", reply.ParentFee)
+	assert.Equal(t, int64(200), reply.ParentVsize)
+	// (200 + 192) vB at 10 sat/vB less the 200 sat paid by the parent
+	assert.Equal(t, "3720", reply.Fee)
+	require.Equal(t, 1, len(reply.Vins))
+	assert.Equal(t, owner.address, reply.Vins[0].Address)
+	require.Equal(t, 1, len(reply.Vouts))
+	assert.Equal(t, int64(96280), reply.Vouts[0].Amount)
+
+	signed := signTx(t, reply.TxData, reply.SignHashes, []*testKey{owner})
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: signed,
+		Vins:         reply.Vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	// the child can not pay more than the output is worth
+	req.FeeRate = 1000
+	_, err = adaptor.BuildCpfpTransaction(req)
+	assert.NotNil(t, err)
+
+	req.FeeRate = 10
+	req.Index = 0
+	_, err = adaptor.BuildCpfpTransaction(req)
+	assert.NotNil(t, err)
+
+	req.ParentTxHash = fmt.Sprintf("%064x", 8)
+	_, err = adaptor.BuildCpfpTransaction(req)
+	assert.NotNil(t, err)
+
+	// a p2wpkh parent output needs a sign hash the adaptor does not build
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(owner.privKey.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	parentPkScript, err = txscript.PayToAddrScript(p2wpkh)
+	require.Nil(t, err)
+	req.ParentTxHash, req.Index = parentHash, 1
+	_, err = adaptor.BuildCpfpTransaction(req)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "p2wpkh inputs can not be signed")
+}
@@ -0,0 +1,480 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/shopspring/decimal"
+
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+const (
+	esploraTimeout = 30 * time.Second
+	// esploraBlockTxsPageSize is the number of txs a page of /block/:hash/txs holds
+	esploraBlockTxsPageSize = 25
+)
+
+// esploraClient is a backend on the Esplora REST API, which indexes addresses
+// and transactions so that it needs no txindex nor wallet
+type esploraClient struct {
+	url        string
+	user, pass string
+	httpClient *http.Client
+	params     *chaincfg.Params
+	addresses  AddressCodec
+}
+
+func newEsploraClient(rpc *config.RPC, chain *Chain, params *chaincfg.Params) *esploraClient {
+	return &esploraClient{
+		url:        strings.TrimSuffix(rpc.RPCURL, "/"),
+		user:       rpc.RPCUser,
+		pass:       rpc.RPCPass,
+		httpClient: &http.Client{Timeout: esploraTimeout},
+		params:     params,
+		addresses:  chain.Addresses,
+	}
+}
+
+type esploraStatus struct {
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight int64  `json:"block_height"`
+	BlockHash   string `json:"block_hash"`
+	BlockTime   int64  `json:"block_time"`
+}
+
+type esploraVout struct {
+	ScriptPubKey        string `json:"scriptpubkey"`
+	ScriptPubKeyAsm     string `json:"scriptpubkey_asm"`
+	ScriptPubKeyType    string `json:"scriptpubkey_type"`
+	ScriptPubKeyAddress string `json:"scriptpubkey_address"`
+	Value               int64  `json:"value"`
+}
+
+type esploraVin struct {
+	Txid         string   `json:"txid"`
+	Vout         uint32   `json:"vout"`
+	ScriptSig    string   `json:"scriptsig"`
+	ScriptSigAsm string   `json:"scriptsig_asm"`
+	Witness      []string `json:"witness"`
+	IsCoinbase   bool     `json:"is_coinbase"`
+	Sequence     uint32   `json:"sequence"`
+	// Prevout is the output the input spends, nil for a coinbase
+	Prevout *esploraVout `json:"prevout"`
+}
+
+type esploraTx struct {
+	Txid     string        `json:"txid"`
+	Version  int32         `json:"version"`
+	Locktime uint32        `json:"locktime"`
+	Vin      []esploraVin  `json:"vin"`
+	Vout     []esploraVout `json:"vout"`
+	Size     int32         `json:"size"`
+	Weight   int32         `json:"weight"`
+	Status   esploraStatus `json:"status"`
+}
+
+type esploraBlock struct {
+	ID                string  `json:"id"`
+	Height            int64   `json:"height"`
+	Version           int32   `json:"version"`
+	Timestamp         int64   `json:"timestamp"`
+	TxCount           int     `json:"tx_count"`
+	Size              int32   `json:"size"`
+	Weight            int32   `json:"weight"`
+	MerkleRoot        string  `json:"merkle_root"`
+	PreviousBlockHash string  `json:"previousblockhash"`
+	Nonce             uint32  `json:"nonce"`
+	Bits              uint32  `json:"bits"`
+	Difficulty        float64 `json:"difficulty"`
+}
+
+type esploraUtxo struct {
+	Txid   string        `json:"txid"`
+	Vout   uint32        `json:"vout"`
+	Value  int64         `json:"value"`
+	Status esploraStatus `json:"status"`
+}
+
+type esploraOutspend struct {
+	Spent bool `json:"spent"`
+}
+
+// esploraError is a response of the api with an error status
+type esploraError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *esploraError) Error() string {
+	return fmt.Sprintf("esplora status %d: %s", e.StatusCode, e.Message)
+}
+
+// request sends a request to path and returns the body of a successful response
+func (c *esploraClient) request(method, path, body string) ([]byte, error) {
+	req, err := http.NewRequest(method, c.url+path, strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if c.user != "" {
+		req.SetBasicAuth(c.user, c.pass)
+	}
+	if body != "" {
+		req.Header.Set("Content-Type", "text/plain")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &esploraError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+	return data, nil
+}
+
+func (c *esploraClient) get(path string, result interface{}) error {
+	data, err := c.request(http.MethodGet, path, "")
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, result)
+}
+
+func (c *esploraClient) getText(path string) (string, error) {
+	data, err := c.request(http.MethodGet, path, "")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// GetNetwork get the current network
+func (c *esploraClient) GetNetwork() *chaincfg.Params {
+	return c.params
+}
+
+func (c *esploraClient) GetLatestBlockHeight() (int64, error) {
+	height, err := c.getText("/blocks/tip/height")
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(height, 10, 64)
+}
+
+func (c *esploraClient) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	hash, err := c.getText(fmt.Sprintf("/block-height/%d", height))
+	if err != nil {
+		return nil, err
+	}
+	return chainhash.NewHashFromStr(hash)
+}
+
+func (c *esploraClient) GetBlockVerbose(blockHash *chainhash.Hash) (*btcjson.GetBlockVerboseResult, error) {
+	block, tip, err := c.getBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	return &btcjson.GetBlockVerboseResult{
+		Hash:          block.ID,
+		Confirmations: tip - block.Height + 1,
+		Size:          block.Size,
+		Weight:        block.Weight,
+		Height:        block.Height,
+		Version:       block.Version,
+		MerkleRoot:    block.MerkleRoot,
+		Time:          block.Timestamp,
+		Nonce:         block.Nonce,
+		Bits:          strconv.FormatUint(uint64(block.Bits), 16),
+		Difficulty:    block.Difficulty,
+		PreviousHash:  block.PreviousBlockHash,
+	}, nil
+}
+
+// getBlock returns the block of blockHash and the height of the tip
+func (c *esploraClient) getBlock(blockHash *chainhash.Hash) (*esploraBlock, int64, error) {
+	var block esploraBlock
+	if err := c.get("/block/"+blockHash.String(), &block); err != nil {
+		return nil, 0, err
+	}
+	tip, err := c.GetLatestBlockHeight()
+	if err != nil {
+		return nil, 0, err
+	}
+	return &block, tip, nil
+}
+
+// GetBlockWithPrevouts returns the block with its txs, which the api pages, and
+// the outputs spent by their inputs, which it adds to every input. The txs have
+// no hex.
+func (c *esploraClient) GetBlockWithPrevouts(blockHash *chainhash.Hash) (*GetBlockVerboseResult, [][]*VinPrevout, error) {
+	block, tip, err := c.getBlock(blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	result := &GetBlockVerboseResult{
+		Hash:          block.ID,
+		Confirmations: tip - block.Height + 1,
+		Size:          block.Size,
+		Weight:        block.Weight,
+		Height:        block.Height,
+		Version:       block.Version,
+		MerkleRoot:    block.MerkleRoot,
+		Tx:            make([]*btcjson.TxRawResult, 0, block.TxCount),
+		Time:          block.Timestamp,
+		Nonce:         block.Nonce,
+		Bits:          strconv.FormatUint(uint64(block.Bits), 16),
+		Difficulty:    block.Difficulty,
+		PreviousHash:  block.PreviousBlockHash,
+	}
+	prevouts := make([][]*VinPrevout, 0, block.TxCount)
+	for start := 0; start < block.TxCount; start += esploraBlockTxsPageSize {
+		var txs []esploraTx
+		if err := c.get(fmt.Sprintf("/block/%s/txs/%d", blockHash, start), &txs); err != nil {
+			return nil, nil, err
+		}
+		if len(txs) == 0 {
+			return nil, nil, fmt.Errorf("block %s has no txs from %d", blockHash, start)
+		}
+		for _, tx := range txs {
+			txResult := esploraTxResult(&tx)
+			txResult.BlockHash = block.ID
+			txResult.Confirmations = uint64(result.Confirmations)
+			txResult.Time = block.Timestamp
+			txResult.Blocktime = block.Timestamp
+			result.Tx = append(result.Tx, txResult)
+
+			txPrevouts := make([]*VinPrevout, len(tx.Vin))
+			for i, in := range tx.Vin {
+				if in.Prevout == nil {
+					continue
+				}
+				prevout := &VinPrevout{Value: satoshiToBtc(in.Prevout.Value)}
+				prevout.ScriptPubKey.Hex = in.Prevout.ScriptPubKey
+				txPrevouts[i] = prevout
+			}
+			prevouts = append(prevouts, txPrevouts)
+		}
+	}
+	return result, prevouts, nil
+}
+
+// GetRawTransactionVerbose returns the tx in the format of getrawtransaction,
+// an unknown tx fails with the error code of bitcoind
+func (c *esploraClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	var tx esploraTx
+	if err := c.get("/tx/"+txHash.String(), &tx); err != nil {
+		if e, ok := err.(*esploraError); ok && e.StatusCode == http.StatusNotFound {
+			return nil, &btcjson.RPCError{Code: btcjson.ErrRPCNoTxInfo, Message: e.Message}
+		}
+		return nil, err
+	}
+	txHex, err := c.getText("/tx/" + txHash.String() + "/hex")
+	if err != nil {
+		return nil, err
+	}
+
+	result := esploraTxResult(&tx)
+	result.Hex = txHex
+	if tx.Status.Confirmed {
+		tip, err := c.GetLatestBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		result.BlockHash = tx.Status.BlockHash
+		result.Confirmations = uint64(tip - tx.Status.BlockHeight + 1)
+		result.Time = tx.Status.BlockTime
+		result.Blocktime = tx.Status.BlockTime
+	}
+	return result, nil
+}
+
+// esploraTxResult returns tx in the format of getrawtransaction, without its hex
+// and block
+func esploraTxResult(tx *esploraTx) *btcjson.TxRawResult {
+	result := &btcjson.TxRawResult{
+		Txid:     tx.Txid,
+		Hash:     tx.Txid,
+		Size:     tx.Size,
+		Vsize:    (tx.Weight + witnessScaleFactor - 1) / witnessScaleFactor,
+		Weight:   tx.Weight,
+		Version:  tx.Version,
+		LockTime: tx.Locktime,
+		Vin:      make([]btcjson.Vin, len(tx.Vin)),
+		Vout:     make([]btcjson.Vout, len(tx.Vout)),
+	}
+	for i, in := range tx.Vin {
+		vin := btcjson.Vin{Sequence: in.Sequence, Witness: in.Witness}
+		if in.IsCoinbase {
+			vin.Coinbase = in.ScriptSig
+		} else {
+			vin.Txid = in.Txid
+			vin.Vout = in.Vout
+			vin.ScriptSig = &btcjson.ScriptSig{Asm: in.ScriptSigAsm, Hex: in.ScriptSig}
+		}
+		result.Vin[i] = vin
+	}
+	for i, out := range tx.Vout {
+		result.Vout[i] = btcjson.Vout{
+			Value:        satoshiToBtc(out.Value),
+			N:            uint32(i),
+			ScriptPubKey: esploraScriptPubKey(out),
+		}
+	}
+	return result
+}
+
+func esploraScriptPubKey(out esploraVout) btcjson.ScriptPubKeyResult {
+	result := btcjson.ScriptPubKeyResult{
+		Asm:  out.ScriptPubKeyAsm,
+		Hex:  out.ScriptPubKey,
+		Type: out.ScriptPubKeyType,
+	}
+	if out.ScriptPubKeyAddress != "" {
+		result.Addresses = []string{out.ScriptPubKeyAddress}
+	}
+	return result
+}
+
+func (c *esploraClient) GetTxOut(txHash *chainhash.Hash, index uint32, mempool bool) (*btcjson.GetTxOutResult, error) {
+	var tx esploraTx
+	if err := c.get("/tx/"+txHash.String(), &tx); err != nil {
+		if e, ok := err.(*esploraError); ok && e.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if int(index) >= len(tx.Vout) || (!mempool && !tx.Status.Confirmed) {
+		return nil, nil
+	}
+	var outspend esploraOutspend
+	if err := c.get(fmt.Sprintf("/tx/%s/outspend/%d", txHash, index), &outspend); err != nil {
+		return nil, err
+	}
+	if outspend.Spent {
+		return nil, nil
+	}
+
+	tipHash, err := c.getText("/blocks/tip/hash")
+	if err != nil {
+		return nil, err
+	}
+	result := &btcjson.GetTxOutResult{
+		BestBlock:    tipHash,
+		Value:        satoshiToBtc(tx.Vout[index].Value),
+		ScriptPubKey: esploraScriptPubKey(tx.Vout[index]),
+		Coinbase:     len(tx.Vin) > 0 && tx.Vin[0].IsCoinbase,
+	}
+	if tx.Status.Confirmed {
+		tip, err := c.GetLatestBlockHeight()
+		if err != nil {
+			return nil, err
+		}
+		result.Confirmations = tip - tx.Status.BlockHeight + 1
+	}
+	return result, nil
+}
+
+// EstimateSmartFee returns the estimate of the smallest target the api has for
+// numBlocks, or of its largest target
+func (c *esploraClient) EstimateSmartFee(numBlocks int64) (EstimateSmartFeeResult, error) {
+	var estimates map[string]float64
+	if err := c.get("/fee-estimates", &estimates); err != nil {
+		return EstimateSmartFeeResult{}, err
+	}
+	targets := make([]int, 0, len(estimates))
+	for target := range estimates {
+		if blocks, err := strconv.Atoi(target); err == nil {
+			targets = append(targets, blocks)
+		}
+	}
+	if len(targets) == 0 {
+		return EstimateSmartFeeResult{Errors: []string{"Insufficient data or no feerate found"}}, fmt.Errorf("no fee estimate for %d blocks", numBlocks)
+	}
+	sort.Ints(targets)
+	target := targets[len(targets)-1]
+	for _, blocks := range targets {
+		if int64(blocks) >= numBlocks {
+			target = blocks
+			break
+		}
+	}
+
+	// sat/vB to btc/kvB
+	satPerVB := decimal.NewFromFloat(estimates[strconv.Itoa(target)])
+	feeRate, _ := satPerVB.Shift(3 - btcDecimals).Float64()
+	return EstimateSmartFeeResult{Feerate: feeRate, Blocks: target}, nil
+}
+
+// SendRawTransaction broadcasts the serialized tx, Esplora checks no max fee rate
+func (c *esploraClient) SendRawTransaction(txData []byte, _ string) (*chainhash.Hash, error) {
+	data, err := c.request(http.MethodPost, "/tx", hex.EncodeToString(txData))
+	if err != nil {
+		return nil, err
+	}
+	return chainhash.NewHashFromStr(strings.TrimSpace(string(data)))
+}
+
+// ListUnspent looks the confirmed unspent outputs of each address up in the index
+func (c *esploraClient) ListUnspent(addresses []string) (*ScanTxOutSetResult, error) {
+	tip, err := c.GetLatestBlockHeight()
+	if err != nil {
+		return nil, err
+	}
+	result := &ScanTxOutSetResult{Success: true, Height: tip}
+	var total int64
+	for _, address := range addresses {
+		addr, err := c.addresses.DecodeAddress(address, c.params)
+		if err != nil {
+			return nil, err
+		}
+		pkScript, err := txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		var utxos []esploraUtxo
+		if err := c.get("/address/"+address+"/utxo", &utxos); err != nil {
+			return nil, err
+		}
+		for _, utxo := range utxos {
+			// the utxo set of a node holds no mempool outputs either
+			if !utxo.Status.Confirmed || utxo.Status.BlockHeight > tip {
+				continue
+			}
+			result.Unspents = append(result.Unspents, ScanTxOutSetUnspent{
+				Txid:         utxo.Txid,
+				Vout:         utxo.Vout,
+				ScriptPubKey: hex.EncodeToString(pkScript),
+				Desc:         "addr(" + address + ")",
+				Amount:       satoshiToBtc(utxo.Value),
+				Height:       utxo.Status.BlockHeight,
+			})
+			total += utxo.Value
+		}
+	}
+	result.TxOuts = int64(len(result.Unspents))
+	result.TotalAmount = satoshiToBtc(total)
+	return result, nil
+}
+
+func satoshiToBtc(satoshi int64) float64 {
+	btc, _ := decimal.New(satoshi, -btcDecimals).Float64()
+	return btc
+}
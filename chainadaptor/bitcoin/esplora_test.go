@@ -0,0 +1,273 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// newMockEsploraAdaptor serves the responses of routes, keyed by method and path,
+// as an Esplora api and returns an adaptor on it
+func newMockEsploraAdaptor(t *testing.T, routes map[string]string) (*ChainAdaptor, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			http.Error(w, "Transaction not found", http.StatusNotFound)
+			return
+		}
+		if r.Method == http.MethodPost {
+			data, _ := ioutil.ReadAll(r.Body)
+			if want := routes["BODY "+r.URL.Path]; want != string(data) {
+				http.Error(w, "unexpected body", http.StatusBadRequest)
+				return
+			}
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+
+	rpc := &config.RPC{RPCURL: server.URL + "/", Backend: backendEsplora}
+	client := newEsploraClient(rpc, bitcoinChain, &chaincfg.TestNet3Params)
+	return newChainAdaptorWithBackends(bitcoinChain, []backend{client}), server
+}
+
+func TestListUtxosEsplora(t *testing.T) {
+	address := testnetAddrs[0]
+	adaptor, server := newMockEsploraAdaptor(t, map[string]string{
+		"GET /blocks/tip/height": "100",
+		"GET /address/" + address + "/utxo": `[
+			{"txid":"c2247fb66cf44652f27552b052a7d359d48a1c8e90a50651f6104a441041963f","vout":0,"value":10000,
+			 "status":{"confirmed":true,"block_height":90}},
+			{"txid":"d4b9d1f4cd8ebb6e25ca3fd7fc4d4a45ea1d1e72ad9aa49396a3c5b5fdd9d5bd","vout":2,"value":50000000,
+			 "status":{"confirmed":true,"block_height":100}},
+			{"txid":"e1a2f1b1c1d1e1f1a1b1c1d1e1f1a1b1c1d1e1f1a1b1c1d1e1f1a1b1c1d1e1f1","vout":1,"value":7000,
+			 "status":{"confirmed":false}}
+		]`,
+	})
+	defer server.Close()
+
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.Nil(t, err)
+
+	reply, err := adaptor.ListUtxos(&proto.ListUtxosRequest{
+		Chain:     ChainName,
+		Addresses: []string{address},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, uint64(100), reply.BlockHeight)
+	require.Equal(t, 2, len(reply.Utxos))
+	assert.Equal(t, int64(10000), reply.Utxos[0].Amount)
+	assert.Equal(t, address, reply.Utxos[0].Address)
+	assert.Equal(t, hex.EncodeToString(pkScript), reply.Utxos[0].ScriptPubKey)
+	assert.Equal(t, uint64(11), reply.Utxos[0].Confirmations)
+	assert.Equal(t, uint32(2), reply.Utxos[1].Index)
+	assert.Equal(t, uint64(1), reply.Utxos[1].Confirmations)
+
+	balance, err := adaptor.QueryBalance(&proto.QueryBalanceRequest{
+		Chain:   ChainName,
+		Address: address,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "50010000", balance.Balance)
+}
+
+func TestQueryUtxoTransactionEsplora(t *testing.T) {
+	txData, txHash := testSignedTx(t)
+	prevHash := fmt.Sprintf("%064x", 1)
+	blockHash := fmt.Sprintf("%064x", 2)
+	address := testnetAddrs[0]
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.Nil(t, err)
+	adaptor, server := newMockEsploraAdaptor(t, map[string]string{
+		"GET /blocks/tip/height": "100",
+		"GET /tx/" + txHash: fmt.Sprintf(`{"txid":"%s","version":1,"locktime":0,"size":60,"weight":240,
+			"vin":[{"txid":"%s","vout":0,"scriptsig":"51","sequence":4294967295}],
+			"vout":[{"scriptpubkey":"51","scriptpubkey_type":"nonstandard","value":1000}],
+			"status":{"confirmed":true,"block_height":95,"block_hash":"%s","block_time":1600000000}}`,
+			txHash, prevHash, blockHash),
+		"GET /tx/" + txHash + "/hex": hex.EncodeToString(txData),
+		"GET /tx/" + prevHash: fmt.Sprintf(`{"txid":"%s","version":1,"locktime":0,
+			"vin":[{"is_coinbase":true,"scriptsig":"00","sequence":4294967295}],
+			"vout":[{"scriptpubkey":"%s","scriptpubkey_type":"p2pkh","scriptpubkey_address":"%s","value":1500}],
+			"status":{"confirmed":true,"block_height":90}}`, prevHash, hex.EncodeToString(pkScript), address),
+		"GET /tx/" + prevHash + "/hex": "00",
+		"GET /block/" + blockHash:      fmt.Sprintf(`{"id":"%s","height":95,"timestamp":1600000000}`, blockHash),
+	})
+	defer server.Close()
+
+	reply, err := adaptor.QueryUtxoTransaction(&proto.QueryTransactionRequest{
+		Chain:  ChainName,
+		TxHash: txHash,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.TxStatus_Success, reply.TxStatus)
+	assert.Equal(t, uint64(95), reply.BlockHeight)
+	assert.Equal(t, uint64(1600000000), reply.BlockTime)
+	require.Equal(t, 1, len(reply.Vins))
+	assert.Equal(t, address, reply.Vins[0].Address)
+	assert.Equal(t, int64(1500), reply.Vins[0].Amount)
+	require.Equal(t, 1, len(reply.Vouts))
+	assert.Equal(t, int64(1000), reply.Vouts[0].Amount)
+	assert.Equal(t, "500", reply.CostFee)
+
+	reply, err = adaptor.QueryUtxoTransaction(&proto.QueryTransactionRequest{
+		Chain:  ChainName,
+		TxHash: fmt.Sprintf("%064x", 3),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.TxStatus_NotFound, reply.TxStatus)
+}
+
+func TestQueryGasPriceEsplora(t *testing.T) {
+	adaptor, server := newMockEsploraAdaptor(t, map[string]string{
+		"GET /fee-estimates": `{"1":20.5,"2":15.0,"4":10.2,"144":1.0}`,
+	})
+	defer server.Close()
+
+	// no estimate for the 3 blocks of the policy, the one for 4 blocks is used
+	reply, err := adaptor.QueryGasPrice(&proto.QueryGasPriceRequest{Chain: ChainName})
+	require.Nil(t, err)
+	assert.Equal(t, "10200", reply.GasPrice)
+
+	client := adaptor.getClient()
+	result, err := client.EstimateSmartFee(1008)
+	require.Nil(t, err)
+	assert.Equal(t, 144, result.Blocks)
+	assert.Equal(t, 0.00001, result.Feerate)
+}
+
+func TestBroadcastTransactionEsplora(t *testing.T) {
+	txData, txHash := testSignedTx(t)
+	adaptor, server := newMockEsploraAdaptor(t, map[string]string{
+		"POST /tx": txHash,
+		"BODY /tx": hex.EncodeToString(txData),
+	})
+	defer server.Close()
+
+	reply, err := adaptor.BroadcastTransaction(&proto.BroadcastTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: txData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, txHash, reply.TxHash)
+
+	// the mempool policy is only known to a full node
+	dryRun, err := adaptor.DryRunBroadcast(&proto.BroadcastTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: txData,
+	})
+	assert.Equal(t, errNoFullNode, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, dryRun.Code)
+}
+
+func TestNewBackends(t *testing.T) {
+	conf := &config.Config{NetWork: "testnet"}
+	conf.Fullnode.Btc.RPCs = []*config.RPC{
+		{RPCURL: "http://127.0.0.1:3002", Backend: backendEsplora},
+	}
+	backends, err := newBackends(conf, bitcoinChain)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(backends))
+	assert.Equal(t, &chaincfg.TestNet3Params, backends[0].GetNetwork())
+
+	conf.Fullnode.Btc.RPCs[0].Backend = "electrum"
+	_, err = newBackends(conf, bitcoinChain)
+	assert.NotNil(t, err)
+}
+
+func TestGetUtxoTransactionByHeightEsplora(t *testing.T) {
+	blockHash := fmt.Sprintf("%064x", 2)
+	coinbaseHash := fmt.Sprintf("%064x", 3)
+	txHash := fmt.Sprintf("%064x", 4)
+	prevHash := fmt.Sprintf("%064x", 1)
+	address := testnetAddrs[0]
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	script, err := txscript.PayToAddrScript(addr)
+	require.Nil(t, err)
+	pkScript := hex.EncodeToString(script)
+	adaptor, server := newMockEsploraAdaptor(t, map[string]string{
+		"GET /blocks/tip/height": "100",
+		"GET /block-height/95":   blockHash,
+		"GET /block/" + blockHash: fmt.Sprintf(`{"id":"%s","height":95,"timestamp":1600000000,"tx_count":2}`,
+			blockHash),
+		"GET /block/" + blockHash + "/txs/0": fmt.Sprintf(`[
+			{"txid":"%s","version":1,"locktime":0,
+			 "vin":[{"is_coinbase":true,"scriptsig":"00","sequence":4294967295}],
+			 "vout":[{"scriptpubkey":"51","scriptpubkey_type":"nonstandard","value":5000}]},
+			{"txid":"%s","version":1,"locktime":0,
+			 "vin":[{"txid":"%s","vout":0,"scriptsig":"51","sequence":4294967295,
+			   "prevout":{"scriptpubkey":"%s","scriptpubkey_type":"p2pkh","scriptpubkey_address":"%s","value":1500}}],
+			 "vout":[{"scriptpubkey":"%s","scriptpubkey_type":"p2pkh","scriptpubkey_address":"%s","value":1000}]}
+		]`, coinbaseHash, txHash, prevHash, pkScript, address, pkScript, address),
+	})
+	defer server.Close()
+
+	replyCh := make(chan *proto.QueryUtxoTransactionReply, 2)
+	errCh := make(chan error, 1)
+	adaptor.GetUtxoTransactionByHeight(95, replyCh, errCh)
+	require.Equal(t, 0, len(errCh))
+	require.Equal(t, 2, len(replyCh))
+
+	coinbase := <-replyCh
+	assert.Equal(t, coinbaseHash, coinbase.TxHash)
+	assert.Equal(t, "0", coinbase.CostFee)
+	reply := <-replyCh
+	assert.Equal(t, txHash, reply.TxHash)
+	assert.Equal(t, uint64(95), reply.BlockHeight)
+	assert.Equal(t, uint64(1600000000), reply.BlockTime)
+	require.Equal(t, 1, len(reply.Vins))
+	assert.Equal(t, address, reply.Vins[0].Address)
+	assert.Equal(t, int64(1500), reply.Vins[0].Amount)
+	require.Equal(t, 1, len(reply.Vouts))
+	assert.Equal(t, address, reply.Vouts[0].Address)
+	assert.Equal(t, "500", reply.CostFee)
+}
+
+func TestGetNodeMixedBackends(t *testing.T) {
+	esplora, server := newMockEsploraAdaptor(t, map[string]string{
+		"GET /blocks/tip/height": "200",
+	})
+	defer server.Close()
+	node, nodeServer := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getblockcount": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return 100, nil
+		},
+	})
+	defer nodeServer.Close()
+	btc, err := node.getNode()
+	require.Nil(t, err)
+
+	adaptor := newChainAdaptorWithBackends(bitcoinChain, []backend{btc, esplora.getClient()})
+	assert.Eventually(t, func() bool {
+		_, ok := adaptor.getClient().(*esploraClient)
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+	// the indexer is ahead, but the node still serves the rpc of a full node
+	client, err := adaptor.getNode()
+	require.Nil(t, err)
+	assert.Equal(t, btc, client)
+
+	_, err = esplora.getNode()
+	assert.Equal(t, errNoFullNode, err)
+}
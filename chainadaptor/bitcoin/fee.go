@@ -0,0 +1,141 @@
+package bitcoin
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	// maxBlockVSize is the virtual size a block has room for
+	maxBlockVSize = 1000000
+)
+
+var estimateModes = map[string]bool{
+	"":             true,
+	"UNSET":        true,
+	"ECONOMICAL":   true,
+	"CONSERVATIVE": true,
+}
+
+// EstimateUtxoFee estimates the fee rate in sat/vB for the transaction to be
+// confirmed within conf_target blocks, and the fee of a transaction with the
+// given input and output types at that rate
+func (a *ChainAdaptor) EstimateUtxoFee(req *proto.EstimateUtxoFeeRequest) (*proto.EstimateUtxoFeeReply, error) {
+	confTarget := int64(req.ConfTarget)
+	if confTarget == 0 {
+		confTarget = a.chain.Policy.FeeBlocks
+	}
+	mode := strings.ToUpper(req.EstimateMode)
+	if !estimateModes[mode] {
+		err := fmt.Errorf("invalid estimate mode %s", req.EstimateMode)
+		return &proto.EstimateUtxoFeeReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	feeRate, blocks, fallback, err := a.estimateFeeRate(confTarget, mode)
+	if err != nil {
+		log.Error("EstimateUtxoFee estimateFeeRate", "err", err)
+		return &proto.EstimateUtxoFeeReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	reply := &proto.EstimateUtxoFeeReply{
+		Code:            proto.ReturnCode_SUCCESS,
+		FeeRate:         feeRate,
+		Blocks:          uint32(blocks),
+		MempoolFallback: fallback,
+	}
+	if len(req.InputTypes) > 0 || len(req.OutputTypes) > 0 {
+		vsize, err := estimateVSize(req.InputTypes, req.OutputTypes)
+		if err != nil {
+			return &proto.EstimateUtxoFeeReply{
+				Code: proto.ReturnCode_ERROR,
+				Msg:  err.Error(),
+			}, err
+		}
+		reply.Vsize = vsize
+		reply.Fee = strconv.FormatInt(vsize*int64(feeRate), 10)
+	}
+	return reply, nil
+}
+
+// estimateFeeRate returns the fee rate in sat/vB from estimatesmartfee, and
+// estimates it from the mempool when the node has not enough data to do so
+func (a *ChainAdaptor) estimateFeeRate(confTarget int64, mode string) (uint64, int64, bool, error) {
+	node, err := a.getNode()
+	if err != nil {
+		// indexers estimate without modes and from their own data only
+		reply, err := a.getClient().EstimateSmartFee(confTarget)
+		if err != nil {
+			return 0, 0, false, err
+		}
+		return btcPerKvBToSatPerVB(reply.Feerate), int64(reply.Blocks), false, nil
+	}
+	reply, err := node.EstimateSmartFeeWithMode(confTarget, mode)
+	if err == nil && reply.Feerate > 0 {
+		return btcPerKvBToSatPerVB(reply.Feerate), int64(reply.Blocks), false, nil
+	}
+	if err != nil && len(reply.Errors) == 0 {
+		return 0, 0, false, err
+	}
+	log.Warn("estimatesmartfee has no estimate, fall back to mempool", "errors", reply.Errors)
+
+	info, err := node.GetMempoolInfo()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	entries, err := node.GetRawMempoolVerbose()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	floor := btcPerKvBToSatPerVB(math.Max(info.MempoolMinFee, info.MinRelayTxFee))
+	return mempoolFeeRate(entries, confTarget, floor), confTarget, true, nil
+}
+
+// mempoolFeeRate returns the fee rate of the transaction which would be the last
+// one mined in confTarget blocks if the mempool was mined by fee rate, or floor
+// if the mempool fits into those blocks
+func mempoolFeeRate(entries map[string]*MempoolEntryResult, confTarget int64, floor uint64) uint64 {
+	type entryRate struct {
+		rate  float64
+		vsize int64
+	}
+	rates := make([]entryRate, 0, len(entries))
+	for _, entry := range entries {
+		if entry.VSize <= 0 {
+			continue
+		}
+		fee := btcToSatoshi(entry.Fees.Modified).Int64()
+		rates = append(rates, entryRate{rate: float64(fee) / float64(entry.VSize), vsize: entry.VSize})
+	}
+	sort.Slice(rates, func(i, j int) bool { return rates[i].rate > rates[j].rate })
+
+	capacity := confTarget * maxBlockVSize
+	var total int64
+	for _, r := range rates {
+		total += r.vsize
+		if total >= capacity {
+			if rate := uint64(math.Ceil(r.rate)); rate > floor {
+				return rate
+			}
+			break
+		}
+	}
+	return floor
+}
+
+func btcPerKvBToSatPerVB(feeRate float64) uint64 {
+	satPerKvB := btcToSatoshi(feeRate).Int64()
+	return uint64((satPerKvB + 999) / 1000)
+}
@@ -0,0 +1,106 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestEstimateVSizeOffline(t *testing.T) {
+	vsize, err := estimateVSize([]string{"p2pkh"}, []string{"p2pkh", "p2pkh"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(226), vsize)
+
+	vsize, err = estimateVSize([]string{"p2wpkh"}, []string{"p2wpkh", "p2wpkh"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(141), vsize)
+
+	vsize, err = estimateVSize([]string{"p2tr"}, []string{"p2tr"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(111), vsize)
+
+	// mixing legacy and witness inputs
+	vsize, err = estimateVSize([]string{"p2pkh", "p2sh-p2wpkh"}, []string{"p2wsh"})
+	require.Nil(t, err)
+	assert.Equal(t, int64(293), vsize)
+
+	_, err = estimateVSize([]string{"p2pk"}, nil)
+	assert.NotNil(t, err)
+}
+
+func TestEstimateUtxoFeeMockNode(t *testing.T) {
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"estimatesmartfee": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var mode string
+			if len(params) != 2 || json.Unmarshal(params[1], &mode) != nil || mode != "CONSERVATIVE" {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter, "unexpected mode")
+			}
+			return &EstimateSmartFeeResult{Feerate: 0.00012345, Blocks: 2}, nil
+		},
+	})
+	defer server.Close()
+
+	reply, err := adaptor.EstimateUtxoFee(&proto.EstimateUtxoFeeRequest{
+		Chain:        ChainName,
+		ConfTarget:   2,
+		EstimateMode: "conservative",
+		InputTypes:   []string{"p2wpkh"},
+		OutputTypes:  []string{"p2wpkh", "p2wpkh"},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	// 12345 sat/kvB rounded up
+	assert.Equal(t, uint64(13), reply.FeeRate)
+	assert.Equal(t, uint32(2), reply.Blocks)
+	assert.False(t, reply.MempoolFallback)
+	assert.Equal(t, int64(141), reply.Vsize)
+	assert.Equal(t, "1833", reply.Fee)
+
+	reply, err = adaptor.EstimateUtxoFee(&proto.EstimateUtxoFeeRequest{
+		Chain:        ChainName,
+		EstimateMode: "fast",
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, reply.Code)
+}
+
+func TestEstimateUtxoFeeMempoolFallbackMockNode(t *testing.T) {
+	entries := make(map[string]*MempoolEntryResult)
+	for i := 0; i < 30; i++ {
+		// 100000 vB each at 1..30 sat/vB
+		entries[fmt.Sprintf("%064x", i)] = &MempoolEntryResult{
+			VSize: 100000,
+			Fees:  MempoolEntryFees{Base: float64(i+1) / 1000, Modified: float64(i+1) / 1000},
+		}
+	}
+	adaptor, server := newMockChainAdaptor(t, config.RegTest, map[string]mockHandler{
+		"estimatesmartfee": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &EstimateSmartFeeResult{Errors: []string{"Insufficient data or no feerate found"}, Blocks: 0}, nil
+		},
+		"getmempoolinfo": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &GetMempoolInfoResult{MempoolMinFee: 0.00001, MinRelayTxFee: 0.00001}, nil
+		},
+		"getrawmempool": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return entries, nil
+		},
+	})
+	defer server.Close()
+
+	reply, err := adaptor.EstimateUtxoFee(&proto.EstimateUtxoFeeRequest{Chain: ChainName, ConfTarget: 1})
+	require.Nil(t, err)
+	assert.True(t, reply.MempoolFallback)
+	// the first block takes the 10 best paying entries
+	assert.Equal(t, uint64(21), reply.FeeRate)
+
+	reply, err = adaptor.EstimateUtxoFee(&proto.EstimateUtxoFeeRequest{Chain: ChainName, ConfTarget: 6})
+	require.Nil(t, err)
+	assert.True(t, reply.MempoolFallback)
+	assert.Equal(t, uint64(1), reply.FeeRate)
+}
@@ -0,0 +1,178 @@
+package bitcoin
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func mempoolEntry(entry *MempoolEntryResult) *proto.MempoolEntry {
+	fee := btcToSatoshi(entry.Fees.Base).Int64()
+	var feeRate uint64
+	if entry.VSize > 0 {
+		feeRate = uint64(fee / entry.VSize)
+	}
+	return &proto.MempoolEntry{
+		Time:            entry.Time,
+		Fee:             strconv.FormatInt(fee, 10),
+		FeeRate:         feeRate,
+		Vsize:           entry.VSize,
+		Replaceable:     entry.BIP125Replaceable,
+		AncestorCount:   entry.AncestorCount,
+		DescendantCount: entry.DescendantCount,
+	}
+}
+
+// CheckDoubleSpend reports the vins already spent by a tx other than req.TxHash,
+// either in the chain or in the mempool, and the vins whose spender or existence
+// the node can not tell
+func (a *ChainAdaptor) CheckDoubleSpend(req *proto.CheckDoubleSpendRequest) (*proto.CheckDoubleSpendReply, error) {
+	spends, err := a.checkDoubleSpend(req)
+	if err != nil {
+		log.Error("CheckDoubleSpend", "err", err)
+		return &proto.CheckDoubleSpendReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	doubleSpent := false
+	for _, spend := range spends {
+		if spend.Status == proto.SpendStatus_SPENT {
+			doubleSpent = true
+		}
+	}
+	return &proto.CheckDoubleSpendReply{
+		Code:         proto.ReturnCode_SUCCESS,
+		DoubleSpent:  doubleSpent,
+		DoubleSpends: spends,
+	}, nil
+}
+
+func (a *ChainAdaptor) checkDoubleSpend(req *proto.CheckDoubleSpendRequest) ([]*proto.DoubleSpend, error) {
+	if len(req.Vins) == 0 {
+		return nil, errors.New("no vin in req")
+	}
+	client, err := a.getNode()
+	if err != nil {
+		return nil, err
+	}
+
+	// the outpoints spent by the expected tx, wherever it is. A confirmed one is
+	// only found with -txindex.
+	expected := make(map[wire.OutPoint]bool)
+	expectedKnown := req.TxHash == ""
+	if req.TxHash != "" {
+		txHash, err := chainhash.NewHashFromStr(req.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := client.GetRawTransactionVerbose(txHash)
+		if err == nil {
+			expectedKnown = true
+			for _, in := range tx.Vin {
+				if hash, err := chainhash.NewHashFromStr(in.Txid); err == nil && !in.IsCoinBase() {
+					expected[*wire.NewOutPoint(hash, in.Vout)] = true
+				}
+			}
+		}
+	}
+
+	var spends []*proto.DoubleSpend
+	var unspent []wire.OutPoint
+	for _, vin := range req.Vins {
+		hash, err := chainhash.NewHashFromStr(vin.Hash)
+		if err != nil {
+			return nil, err
+		}
+		outpoint := wire.NewOutPoint(hash, vin.Index)
+
+		out, err := client.GetTxOut(hash, vin.Index, false)
+		if err != nil {
+			return nil, err
+		}
+		if out != nil {
+			unspent = append(unspent, *outpoint)
+			continue
+		}
+		// the outputs of a parent still in the mempool are not in the utxo set of
+		// the chain, they can only be spent in the mempool
+		if _, err := client.GetMempoolEntry(vin.Hash); err == nil {
+			unspent = append(unspent, *outpoint)
+			continue
+		} else if rpcErr, ok := err.(*btcjson.RPCError); !ok || rpcErr.Code != btcjson.ErrRPCInvalidAddressOrKey {
+			return nil, err
+		}
+		if expected[*outpoint] {
+			continue
+		}
+		// gettxout can not tell a spent output from one that never existed, the
+		// parent tells whether it did
+		exists, err := outputExists(client, hash, vin.Index)
+		if err != nil {
+			return nil, err
+		}
+		spend := &proto.DoubleSpend{Hash: vin.Hash, Index: vin.Index}
+		switch {
+		case !exists:
+			spend.Status = proto.SpendStatus_UNKNOWN_OUTPOINT
+		case !expectedKnown:
+			spend.Confirmed = true
+			spend.Status = proto.SpendStatus_SPENDER_UNKNOWN
+		default:
+			spend.Confirmed = true
+		}
+		spends = append(spends, spend)
+	}
+	if len(unspent) == 0 {
+		return spends, nil
+	}
+
+	results, err := client.GetTxSpendingPrevout(unspent)
+	if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCMethodNotFound.Code {
+		// older nodes only tell that an output is spent in the mempool
+		for _, outpoint := range unspent {
+			out, err := client.GetTxOut(&outpoint.Hash, outpoint.Index, true)
+			if err != nil {
+				return nil, err
+			}
+			if out == nil && !expected[outpoint] {
+				spends = append(spends, &proto.DoubleSpend{Hash: outpoint.Hash.String(), Index: outpoint.Index})
+			}
+		}
+		return spends, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.SpendingTxid == "" || result.SpendingTxid == req.TxHash {
+			continue
+		}
+		spends = append(spends, &proto.DoubleSpend{
+			Hash:    result.Txid,
+			Index:   result.Vout,
+			SpentBy: result.SpendingTxid,
+		})
+	}
+	return spends, nil
+}
+
+// outputExists reports whether the tx hash, which the node finds in the mempool or
+// with -txindex, has an output at index. An unknown tx counts as no output.
+func outputExists(client *btcClient, hash *chainhash.Hash, index uint32) (bool, error) {
+	tx, err := client.GetRawTransactionVerbose(hash)
+	if rpcErr, ok := err.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCNoTxInfo {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return int(index) < len(tx.Vout), nil
+}
@@ -0,0 +1,157 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestQueryUtxoTransactionMempoolMockNode(t *testing.T) {
+	txid := fmt.Sprintf("%064x", 0x3000)
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getrawtransaction": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return map[string]interface{}{"txid": txid, "confirmations": 0}, nil
+		},
+		"getmempoolentry": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &MempoolEntryResult{
+				VSize:             141,
+				Time:              1600000000,
+				AncestorCount:     2,
+				DescendantCount:   1,
+				Fees:              MempoolEntryFees{Base: 0.00002820},
+				BIP125Replaceable: true,
+			}, nil
+		},
+	})
+	defer server.Close()
+
+	reply, err := adaptor.QueryUtxoTransaction(&proto.QueryTransactionRequest{Chain: ChainName, Symbol: Symbol, TxHash: txid})
+	require.Nil(t, err)
+	assert.Equal(t, proto.TxStatus_Pending, reply.TxStatus)
+	require.NotNil(t, reply.Mempool)
+	assert.Equal(t, int64(1600000000), reply.Mempool.Time)
+	assert.Equal(t, "2820", reply.Mempool.Fee)
+	assert.Equal(t, uint64(20), reply.Mempool.FeeRate)
+	assert.True(t, reply.Mempool.Replaceable)
+	assert.Equal(t, int64(2), reply.Mempool.AncestorCount)
+	assert.Equal(t, int64(1), reply.Mempool.DescendantCount)
+}
+
+// doubleSpendHandlers serves seven outpoints: unspent, spent in the mempool by another
+// tx, spent in the mempool by the expected tx, spent in the chain by another tx,
+// spent in the chain by the expected tx, the output of a mempool parent spent
+// in the mempool by another tx and one that does not exist.
+func doubleSpendHandlers(t *testing.T, outpoints []string, expected, other string, spendingPrevout bool) map[string]mockHandler {
+	handlers := map[string]mockHandler{
+		"getrawtransaction": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			require.Nil(t, json.Unmarshal(params[0], &txid))
+			switch txid {
+			case expected:
+				return map[string]interface{}{
+					"txid": expected,
+					"vin":  []interface{}{map[string]interface{}{"txid": outpoints[2], "vout": 0}, map[string]interface{}{"txid": outpoints[4], "vout": 0}},
+				}, nil
+			case outpoints[3], outpoints[4]:
+				return map[string]interface{}{"txid": txid, "vout": []interface{}{map[string]interface{}{"value": 0.001, "n": 0}}}, nil
+			}
+			return nil, &btcjson.RPCError{Code: btcjson.ErrRPCNoTxInfo, Message: "No such mempool or blockchain transaction"}
+		},
+		"gettxout": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			var includeMempool bool
+			require.Nil(t, json.Unmarshal(params[0], &txid))
+			require.Nil(t, json.Unmarshal(params[2], &includeMempool))
+			spent := txid == outpoints[3] || txid == outpoints[4] || txid == outpoints[5] || txid == outpoints[6]
+			if includeMempool {
+				spent = spent || txid == outpoints[1] || txid == outpoints[2]
+			}
+			if spent {
+				return nil, nil
+			}
+			return &btcjson.GetTxOutResult{Value: 0.001}, nil
+		},
+		"getmempoolentry": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			require.Nil(t, json.Unmarshal(params[0], &txid))
+			if txid != outpoints[5] {
+				return nil, &btcjson.RPCError{Code: btcjson.ErrRPCInvalidAddressOrKey, Message: "Transaction not in mempool"}
+			}
+			return &MempoolEntryResult{VSize: 141}, nil
+		},
+	}
+	if spendingPrevout {
+		handlers["gettxspendingprevout"] = func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var prevouts []TxSpendingPrevoutResult
+			require.Nil(t, json.Unmarshal(params[0], &prevouts))
+			for i, prevout := range prevouts {
+				switch prevout.Txid {
+				case outpoints[1], outpoints[5]:
+					prevouts[i].SpendingTxid = other
+				case outpoints[2]:
+					prevouts[i].SpendingTxid = expected
+				}
+			}
+			return prevouts, nil
+		}
+	}
+	return handlers
+}
+
+func TestCheckDoubleSpendMockNode(t *testing.T) {
+	var outpoints []string
+	var vins []*proto.Vin
+	for i := 0; i < 7; i++ {
+		outpoints = append(outpoints, fmt.Sprintf("%064x", 0x4000+i))
+		vins = append(vins, &proto.Vin{Hash: outpoints[i]})
+	}
+	expected := fmt.Sprintf("%064x", 0x4100)
+	other := fmt.Sprintf("%064x", 0x4101)
+
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, doubleSpendHandlers(t, outpoints, expected, other, true))
+	defer server.Close()
+
+	reply, err := adaptor.CheckDoubleSpend(&proto.CheckDoubleSpendRequest{Chain: ChainName, Vins: vins, TxHash: expected})
+	require.Nil(t, err)
+	assert.True(t, reply.DoubleSpent)
+	require.Equal(t, 4, len(reply.DoubleSpends))
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[3], Confirmed: true}, reply.DoubleSpends[0])
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[6], Status: proto.SpendStatus_UNKNOWN_OUTPOINT}, reply.DoubleSpends[1])
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[1], SpentBy: other}, reply.DoubleSpends[2])
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[5], SpentBy: other}, reply.DoubleSpends[3])
+
+	reply, err = adaptor.CheckDoubleSpend(&proto.CheckDoubleSpendRequest{Chain: ChainName, Vins: vins[:1], TxHash: expected})
+	require.Nil(t, err)
+	assert.False(t, reply.DoubleSpent)
+
+	// an output that never existed is no double spend
+	reply, err = adaptor.CheckDoubleSpend(&proto.CheckDoubleSpendRequest{Chain: ChainName, Vins: vins[6:], TxHash: expected})
+	require.Nil(t, err)
+	assert.False(t, reply.DoubleSpent)
+	require.Equal(t, 1, len(reply.DoubleSpends))
+
+	// without the expected tx, say confirmed on a node without -txindex, the
+	// spender of a confirmed spend is undecided
+	reply, err = adaptor.CheckDoubleSpend(&proto.CheckDoubleSpendRequest{Chain: ChainName, Vins: []*proto.Vin{vins[0], vins[3]}, TxHash: fmt.Sprintf("%064x", 0x4102)})
+	require.Nil(t, err)
+	assert.False(t, reply.DoubleSpent)
+	require.Equal(t, 1, len(reply.DoubleSpends))
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[3], Confirmed: true, Status: proto.SpendStatus_SPENDER_UNKNOWN}, reply.DoubleSpends[0])
+
+	// nodes before bitcoin core 24 can not name the spending mempool tx
+	adaptor, server = newMockChainAdaptor(t, config.TestNet, doubleSpendHandlers(t, outpoints, expected, other, false))
+	defer server.Close()
+
+	reply, err = adaptor.CheckDoubleSpend(&proto.CheckDoubleSpendRequest{Chain: ChainName, Vins: vins, TxHash: expected})
+	require.Nil(t, err)
+	require.Equal(t, 4, len(reply.DoubleSpends))
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[1]}, reply.DoubleSpends[2])
+	assert.Equal(t, &proto.DoubleSpend{Hash: outpoints[5]}, reply.DoubleSpends[3])
+}
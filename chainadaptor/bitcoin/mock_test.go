@@ -0,0 +1,58 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/rpcclient"
+
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+type mockHandler func(params []json.RawMessage) (interface{}, *btcjson.RPCError)
+
+// newMockChainAdaptor returns a chain adaptor backed by a local json-rpc server that
+// answers the given methods, so that node dependent paths can be tested offline.
+func newMockChainAdaptor(t *testing.T, network config.NetWorkType, handlers map[string]mockHandler) (*ChainAdaptor, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     interface{}       `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp := map[string]interface{}{"id": req.ID}
+		handler, ok := handlers[req.Method]
+		if !ok {
+			resp["error"] = &btcjson.RPCError{Code: btcjson.ErrRPCMethodNotFound.Code, Message: "Method not found: " + req.Method}
+		} else if result, rpcErr := handler(req.Params); rpcErr != nil {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = result
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+
+	client, err := rpcclient.New(&rpcclient.ConnConfig{
+		HTTPPostMode: true,
+		DisableTLS:   true,
+		Host:         strings.TrimPrefix(server.URL, "http://"),
+	}, nil)
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+
+	btc := newLocalBtcClient(network)
+	btc.Client = client
+	btc.local = false
+	return newChainAdaptorWithClients([]*btcClient{btc}), server
+}
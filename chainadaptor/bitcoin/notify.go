@@ -0,0 +1,324 @@
+package bitcoin
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/ethereum/go-ethereum/log"
+	lru "github.com/hashicorp/golang-lru"
+	"go.uber.org/atomic"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	zmqTopicHashBlock = "hashblock"
+	zmqTopicRawTx     = "rawtx"
+
+	// zmqSilenceBlocks is how many block intervals the head of the notifications
+	// is trusted without hearing from the node, the height is polled again after
+	// it. Blocks often take a few intervals, a dead connection is found sooner by
+	// the tcp keep-alive.
+	zmqSilenceBlocks = 3
+	// defaultBlockInterval is the block interval of the chains whose params have none
+	defaultBlockInterval = 10 * time.Minute
+	// zmqRetryInterval is the wait before connecting again to a lost endpoint
+	zmqRetryInterval = 5 * time.Second
+	// zmqTxBacklog is the raw transactions queued for a subscriber, and the
+	// txids remembered to announce each one once
+	zmqTxBacklog = 1000
+)
+
+// errNoZMQ is returned when mempool transactions are watched without a node
+// publishing them
+var errNoZMQ = errors.New("no node publishes raw transactions over zmq")
+
+// zmqTx is a raw transaction announced by a node
+type zmqTx struct {
+	node  *btcClient
+	rawTx []byte
+}
+
+// zmqNotifier follows the zmq notifications of a node: it keeps the chain head
+// from hashblock and passes rawtx on to the subscribers
+type zmqNotifier struct {
+	node *btcClient
+	// endpoints are the topics to subscribe to at each endpoint
+	endpoints map[string][]string
+
+	height atomic.Int64
+	// heard is the unix nano time of the last notification
+	heard atomic.Int64
+	// silence is how long the head is trusted without a notification
+	silence time.Duration
+
+	lock   sync.Mutex
+	txSubs map[chan zmqTx]struct{}
+}
+
+// newZMQNotifier returns the notifier of the endpoints of rpc, nil if it has none
+func newZMQNotifier(rpc *config.RPC, node *btcClient) *zmqNotifier {
+	endpoints := make(map[string][]string)
+	if rpc.ZMQHashBlock != "" {
+		endpoints[rpc.ZMQHashBlock] = append(endpoints[rpc.ZMQHashBlock], zmqTopicHashBlock)
+	}
+	if rpc.ZMQRawTx != "" {
+		endpoints[rpc.ZMQRawTx] = append(endpoints[rpc.ZMQRawTx], zmqTopicRawTx)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+	interval := node.GetNetwork().TargetTimePerBlock
+	if interval <= 0 {
+		interval = defaultBlockInterval
+	}
+	return &zmqNotifier{
+		node:      node,
+		endpoints: endpoints,
+		silence:   zmqSilenceBlocks * interval,
+		txSubs:    make(map[chan zmqTx]struct{}),
+	}
+}
+
+func (n *zmqNotifier) start() {
+	for endpoint, topics := range n.endpoints {
+		go n.run(endpoint, topics)
+	}
+}
+
+// run listens to the endpoint for good, connecting again whenever it is lost
+func (n *zmqNotifier) run(endpoint string, topics []string) {
+	for {
+		if err := n.listen(endpoint, topics); err != nil {
+			log.Warn("zmq notifications lost, polling the node", "endpoint", endpoint, "err", err)
+		}
+		time.Sleep(zmqRetryInterval)
+	}
+}
+
+func (n *zmqNotifier) listen(endpoint string, topics []string) error {
+	sub, err := dialZMQ(endpoint, topics)
+	if err != nil {
+		return err
+	}
+	defer sub.close()
+
+	if n.hasTopic(endpoint, zmqTopicHashBlock) {
+		// the head is polled while no block is announced
+		defer n.height.Store(0)
+		height, err := n.node.Client.GetBlockCount()
+		if err != nil {
+			return err
+		}
+		n.setHead(height)
+	}
+	log.Info("zmq notifications subscribed", "endpoint", endpoint, "topics", topics)
+
+	sequences := make(map[string]uint32)
+	for {
+		msg, err := sub.receive()
+		if err != nil {
+			return err
+		}
+		if len(msg) != 3 || len(msg[2]) != 4 {
+			log.Warn("unexpected zmq notification", "endpoint", endpoint, "frames", len(msg))
+			continue
+		}
+
+		topic := string(msg[0])
+		sequence := uint32(msg[2][0]) | uint32(msg[2][1])<<8 | uint32(msg[2][2])<<16 | uint32(msg[2][3])<<24
+		if last, ok := sequences[topic]; ok && sequence != last+1 {
+			log.Warn("zmq notifications missed", "topic", topic, "count", sequence-last-1)
+		}
+		sequences[topic] = sequence
+
+		switch topic {
+		case zmqTopicHashBlock:
+			if err := n.onBlock(msg[1]); err != nil {
+				log.Error("zmq hashblock", "err", err)
+			}
+		case zmqTopicRawTx:
+			n.heard.Store(time.Now().UnixNano())
+			n.onTx(msg[1])
+		}
+	}
+}
+
+func (n *zmqNotifier) hasTopic(endpoint, topic string) bool {
+	for _, t := range n.endpoints[endpoint] {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// onBlock moves the head to the block, announced in the byte order of the rpc
+func (n *zmqNotifier) onBlock(data []byte) error {
+	if len(data) != chainhash.HashSize {
+		return errors.New("invalid block hash")
+	}
+	var hash chainhash.Hash
+	for i := range data {
+		hash[i] = data[len(data)-1-i]
+	}
+	header, err := n.node.GetBlockHeaderVerbose(&hash)
+	if err != nil {
+		return err
+	}
+	n.setHead(int64(header.Height))
+	return nil
+}
+
+func (n *zmqNotifier) setHead(height int64) {
+	n.height.Store(height)
+	n.heard.Store(time.Now().UnixNano())
+}
+
+// head returns the height of the last block announced, false if it is unknown
+// or the node has been silent for too long
+func (n *zmqNotifier) head() (int64, bool) {
+	if n == nil {
+		return 0, false
+	}
+	height := n.height.Load()
+	if height == 0 || time.Since(time.Unix(0, n.heard.Load())) > n.silence {
+		return 0, false
+	}
+	return height, true
+}
+
+func (n *zmqNotifier) publishesTxs() bool {
+	for endpoint := range n.endpoints {
+		if n.hasTopic(endpoint, zmqTopicRawTx) {
+			return true
+		}
+	}
+	return false
+}
+
+// onTx passes the raw tx on to the subscribers, dropping it for those behind
+// instead of holding the notifications up
+func (n *zmqNotifier) onTx(rawTx []byte) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	for ch := range n.txSubs {
+		select {
+		case ch <- zmqTx{node: n.node, rawTx: rawTx}:
+		default:
+			log.Warn("zmq rawtx dropped, the subscriber is behind")
+		}
+	}
+}
+
+func (n *zmqNotifier) subscribeTxs(ch chan zmqTx) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.txSubs[ch] = struct{}{}
+}
+
+func (n *zmqNotifier) unsubscribeTxs(ch chan zmqTx) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	delete(n.txSubs, ch)
+}
+
+// SubscribeUtxoMempool sends the transactions entering the mempool which pay any
+// of the addresses as soon as the nodes announce them over zmq, until quit is
+// closed. Transactions confirmed without passing the mempool are left to the
+// blocks.
+func (a *ChainAdaptor) SubscribeUtxoMempool(addresses []string, quit <-chan struct{}, replyCh chan *proto.QueryUtxoTransactionReply, errCh chan error) {
+	watched := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		pkScript, err := a.addressPkScript(address)
+		if err != nil {
+			errCh <- err
+			return
+		}
+		watched[string(pkScript)] = true
+	}
+
+	txCh := make(chan zmqTx, zmqTxBacklog)
+	subscribed := 0
+	for _, notifier := range a.notifiers {
+		if notifier.publishesTxs() {
+			notifier.subscribeTxs(txCh)
+			defer notifier.unsubscribeTxs(txCh)
+			subscribed++
+		}
+	}
+	if subscribed == 0 {
+		errCh <- errNoZMQ
+		return
+	}
+
+	// every node announces the tx, and again once it is in a block
+	seen, _ := lru.New(zmqTxBacklog)
+	for {
+		var tx zmqTx
+		select {
+		case tx = <-txCh:
+		case <-quit:
+			return
+		}
+
+		reply, err := a.mempoolTransaction(tx, watched, seen)
+		if err != nil {
+			log.Error("SubscribeUtxoMempool", "err", err)
+			continue
+		}
+		if reply == nil {
+			continue
+		}
+		select {
+		case replyCh <- reply:
+		case <-quit:
+			return
+		}
+	}
+}
+
+// mempoolTransaction returns the reply of a tx paying a watched pkScript, nil
+// if it pays none, was seen before or is not in the mempool of the node
+func (a *ChainAdaptor) mempoolTransaction(tx zmqTx, watched map[string]bool, seen *lru.Cache) (*proto.QueryUtxoTransactionReply, error) {
+	msgTx, err := a.deserializeTx(tx.rawTx)
+	if err != nil {
+		return nil, err
+	}
+	pays := false
+	for _, out := range msgTx.TxOut {
+		if watched[string(out.PkScript)] {
+			pays = true
+			break
+		}
+	}
+	txHash := a.txHash(msgTx).String()
+	if !pays || seen.Contains(txHash) {
+		return nil, nil
+	}
+
+	entry, err := tx.node.GetMempoolEntry(txHash)
+	if err != nil {
+		log.Debug("SubscribeUtxoMempool tx is not in the mempool", "hash", txHash, "err", err)
+		return nil, nil
+	}
+	seen.Add(txHash, struct{}{})
+
+	res, err := a.decodeTx(tx.rawTx, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.QueryUtxoTransactionReply{
+		Code:         proto.ReturnCode_SUCCESS,
+		TxHash:       txHash,
+		TxStatus:     proto.TxStatus_Pending,
+		Vins:         res.Vins,
+		Vouts:        res.Vouts,
+		CostFee:      res.CostFee.String(),
+		OmniTransfer: res.Omni,
+		Mempool:      mempoolEntry(entry),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/btcsuite/btcd/txscript"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	omniSimpleSendType = 0
+	// marker, version, type, property id and amount
+	omniSimpleSendSize = 4 + 2 + 2 + 4 + 8
+)
+
+var omniMarker = []byte("omni")
+
+// buildOmniSimpleSendScript returns the class C OP_RETURN script of an omni simple send
+func buildOmniSimpleSendScript(send *proto.OmniSimpleSend) ([]byte, error) {
+	if send.PropertyId == 0 {
+		return nil, errors.New("invalid omni property id")
+	}
+	if send.Amount <= 0 {
+		return nil, errors.New("invalid omni amount")
+	}
+
+	payload := make([]byte, omniSimpleSendSize)
+	copy(payload, omniMarker)
+	binary.BigEndian.PutUint16(payload[4:], 0)
+	binary.BigEndian.PutUint16(payload[6:], omniSimpleSendType)
+	binary.BigEndian.PutUint32(payload[8:], send.PropertyId)
+	binary.BigEndian.PutUint64(payload[12:], uint64(send.Amount))
+	return txscript.NewScriptBuilder().AddOp(txscript.OP_RETURN).AddData(payload).Script()
+}
+
+// parseOmniSimpleSend decodes the simple send carried by an OP_RETURN pkScript,
+// it returns nil for any other script or omni transaction type
+func parseOmniSimpleSend(pkScript []byte) *proto.OmniSimpleSend {
+	if txscript.GetScriptClass(pkScript) != txscript.NullDataTy {
+		return nil
+	}
+	pushes, err := txscript.PushedData(pkScript)
+	if err != nil || len(pushes) != 1 {
+		return nil
+	}
+
+	payload := pushes[0]
+	if len(payload) < omniSimpleSendSize || !bytes.Equal(payload[:4], omniMarker) {
+		return nil
+	}
+	if binary.BigEndian.Uint16(payload[4:]) != 0 || binary.BigEndian.Uint16(payload[6:]) != omniSimpleSendType {
+		return nil
+	}
+	amount := int64(binary.BigEndian.Uint64(payload[12:]))
+	if amount <= 0 {
+		return nil
+	}
+	return &proto.OmniSimpleSend{
+		PropertyId: binary.BigEndian.Uint32(payload[8:]),
+		Amount:     amount,
+	}
+}
+
+// omniTransfer resolves the omni simple send of a decoded transaction. The sender is
+// the address contributing the most input value and the reference recipient is the
+// last output not paying back to the sender.
+func omniTransfer(ins []*proto.Vin, outs []*proto.Vout) *proto.OmniTransfer {
+	var send *proto.OmniSimpleSend
+	for _, out := range outs {
+		if out.Omni != nil {
+			send = out.Omni
+			break
+		}
+	}
+	if send == nil {
+		return nil
+	}
+
+	var sender string
+	contributed := make(map[string]int64)
+	for _, in := range ins {
+		if in.Address == "" {
+			continue
+		}
+		contributed[in.Address] += in.Amount
+		if sender == "" || contributed[in.Address] > contributed[sender] {
+			sender = in.Address
+		}
+	}
+
+	var reference string
+	for _, out := range outs {
+		if out.Omni != nil || out.Address == "" {
+			continue
+		}
+		if out.Address != sender || reference == "" {
+			reference = out.Address
+		}
+	}
+
+	return &proto.OmniTransfer{
+		PropertyId: send.PropertyId,
+		Amount:     send.Amount,
+		Sender:     sender,
+		Reference:  reference,
+	}
+}
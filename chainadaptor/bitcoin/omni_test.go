@@ -0,0 +1,130 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestOmniSimpleSendScriptOffline(t *testing.T) {
+	// 1 USDT of property 31
+	script, err := buildOmniSimpleSendScript(&proto.OmniSimpleSend{PropertyId: 31, Amount: 100000000})
+	require.Nil(t, err)
+	assert.Equal(t, "6a146f6d6e69000000000000001f0000000005f5e100", hex.EncodeToString(script))
+
+	send := parseOmniSimpleSend(script)
+	require.NotNil(t, send)
+	assert.Equal(t, uint32(31), send.PropertyId)
+	assert.Equal(t, int64(100000000), send.Amount)
+
+	memo, err := txscript.NullDataScript([]byte("hello omni layer!!!!"))
+	require.Nil(t, err)
+	assert.Nil(t, parseOmniSimpleSend(memo))
+
+	_, err = buildOmniSimpleSendScript(&proto.OmniSimpleSend{PropertyId: 31})
+	assert.NotNil(t, err)
+}
+
+func TestBuildOmniTransactionOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("omni key")
+	other := newTestKey("omni other")
+	to := newTestKey("omni to")
+
+	utxos := []*proto.Vin{
+		{Hash: fmt.Sprintf("%064x", 1), Index: 0, Amount: 100000, Address: from.address},
+		{Hash: fmt.Sprintf("%064x", 2), Index: 0, Amount: 1000, Address: other.address},
+	}
+	reply, err := adaptor.BuildUtxoTransaction(&proto.BuildUtxoTransactionRequest{
+		Chain: ChainName,
+		Utxos: utxos,
+		Vouts: []*proto.Vout{
+			{Omni: &proto.OmniSimpleSend{PropertyId: 31, Amount: 5000000}},
+			{Address: to.address, Amount: 546},
+		},
+		FeeRate:       5,
+		ChangeAddress: from.address,
+	})
+	require.Nil(t, err)
+	// change goes first to keep the recipient the last output
+	require.Equal(t, int32(0), reply.ChangeIndex)
+	require.Equal(t, 3, len(reply.Vouts))
+	assert.Equal(t, from.address, reply.Vouts[0].Address)
+	assert.Equal(t, to.address, reply.Vouts[2].Address)
+
+	decoded, err := adaptor.QueryUtxoTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		RawData: reply.TxData,
+		Vins:    reply.Vins,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(decoded.Vouts))
+	assert.Equal(t, "", decoded.Vouts[1].Address)
+	assert.Equal(t, uint32(1), decoded.Vouts[1].Index)
+	require.NotNil(t, decoded.Vouts[1].Omni)
+	require.NotNil(t, decoded.OmniTransfer)
+	assert.Equal(t, uint32(31), decoded.OmniTransfer.PropertyId)
+	assert.Equal(t, int64(5000000), decoded.OmniTransfer.Amount)
+	assert.Equal(t, from.address, decoded.OmniTransfer.Sender)
+	assert.Equal(t, to.address, decoded.OmniTransfer.Reference)
+
+	_, err = adaptor.BuildUtxoTransaction(&proto.BuildUtxoTransactionRequest{
+		Chain:         ChainName,
+		Utxos:         utxos,
+		Vouts:         []*proto.Vout{{Omni: &proto.OmniSimpleSend{PropertyId: 31, Amount: 1}, Amount: 1000}},
+		FeeRate:       5,
+		ChangeAddress: from.address,
+	})
+	assert.NotNil(t, err)
+}
+
+func TestAssembleOmniTransactionOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("omni key")
+	to := newTestKey("omni to")
+
+	script, err := buildOmniSimpleSendScript(&proto.OmniSimpleSend{PropertyId: 2, Amount: 7})
+	require.Nil(t, err)
+	fromPkScript, err := adaptor.addressPkScript(from.address)
+	require.Nil(t, err)
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxOut(wire.NewTxOut(0, script))
+	msgTx.AddTxOut(wire.NewTxOut(546, fromPkScript))
+	var buf bytes.Buffer
+	require.Nil(t, msgTx.Serialize(&buf))
+
+	tx := &btcjson.TxRawResult{
+		Txid: fmt.Sprintf("%064x", 9),
+		Vin:  []btcjson.Vin{{Txid: fmt.Sprintf("%064x", 1), Vout: 0}},
+		Vout: []btcjson.Vout{
+			{Value: 0, N: 0, ScriptPubKey: btcjson.ScriptPubKeyResult{Hex: hex.EncodeToString(script), Type: "nulldata"}},
+			{Value: 0.00000546, N: 1, ScriptPubKey: btcjson.ScriptPubKeyResult{Addresses: []string{to.address}}},
+		},
+	}
+	reply, err := adaptor.assembleUtxoTransactionReply(tx, 100, 0, func(string, uint32) (int64, string, error) {
+		return 10000, from.address, nil
+	})
+	require.Nil(t, err)
+	require.NotNil(t, reply.OmniTransfer)
+	assert.Equal(t, uint32(2), reply.OmniTransfer.PropertyId)
+	assert.Equal(t, int64(7), reply.OmniTransfer.Amount)
+	assert.Equal(t, from.address, reply.OmniTransfer.Sender)
+	assert.Equal(t, to.address, reply.OmniTransfer.Reference)
+
+	// an OP_RETURN output decodes without an address
+	outs, _, err := adaptor.decodeVouts(*msgTx)
+	require.Nil(t, err)
+	assert.Equal(t, "", outs[0].Address)
+	assert.NotNil(t, outs[0].Omni)
+	assert.Equal(t, from.address, outs[1].Address)
+}
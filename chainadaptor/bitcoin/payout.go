@@ -0,0 +1,167 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// BuildPayoutBatch pays out the requested payouts in as few transactions as
+// max_outputs allows, selecting inputs and placing change for each of them, and
+// tells where each client reference got paid
+func (a *ChainAdaptor) BuildPayoutBatch(req *proto.BuildPayoutBatchRequest) (*proto.BuildPayoutBatchReply, error) {
+	reply, err := a.buildPayoutBatch(req)
+	if err != nil {
+		log.Error("BuildPayoutBatch", "err", err)
+		return &proto.BuildPayoutBatchReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return reply, nil
+}
+
+func (a *ChainAdaptor) buildPayoutBatch(req *proto.BuildPayoutBatchRequest) (*proto.BuildPayoutBatchReply, error) {
+	if len(req.Payouts) == 0 {
+		return nil, errors.New("no payout in req")
+	}
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+	changePkScript, err := a.addressPkScript(req.ChangeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change address: %v", err)
+	}
+
+	references := make(map[string]bool, len(req.Payouts))
+	pkScripts := make([][]byte, len(req.Payouts))
+	for i, payout := range req.Payouts {
+		if payout.Reference == "" || references[payout.Reference] {
+			return nil, fmt.Errorf("payout %d: reference must be unique and not empty", i)
+		}
+		references[payout.Reference] = true
+		pkScript, err := a.addressPkScript(payout.Address)
+		if err != nil {
+			return nil, fmt.Errorf("payout %s: invalid address: %v", payout.Reference, err)
+		}
+		if threshold := a.dustThreshold(pkScript); payout.Amount < threshold {
+			return nil, fmt.Errorf("payout %s: amount %d is dust, below %d", payout.Reference, payout.Amount, threshold)
+		}
+		pkScripts[i] = pkScript
+	}
+
+	utxos, err := a.candidateUtxos(req.Utxos, req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	maxOutputs := len(req.Payouts)
+	if req.MaxOutputs > 0 && int(req.MaxOutputs) < maxOutputs {
+		maxOutputs = int(req.MaxOutputs)
+	}
+
+	reply := &proto.BuildPayoutBatchReply{Code: proto.ReturnCode_SUCCESS}
+	for start := 0; start < len(req.Payouts); start += maxOutputs {
+		end := start + maxOutputs
+		if end > len(req.Payouts) {
+			end = len(req.Payouts)
+		}
+		txIndex := len(reply.Txs)
+
+		params := &coinSelectionParams{
+			feeRate:         int64(req.FeeRate),
+			outPkScripts:    pkScripts[start:end],
+			changeAddress:   req.ChangeAddress,
+			changePkScript:  changePkScript,
+			inputSize:       a.vinInputSize,
+			changeInputSize: a.pkScriptInputSize(changePkScript, nil),
+			minChange:       a.dustThreshold(changePkScript),
+		}
+		vouts := make([]*proto.Vout, 0, end-start)
+		for _, payout := range req.Payouts[start:end] {
+			params.outputsValue += payout.Amount
+			vouts = append(vouts, &proto.Vout{Address: payout.Address, Amount: payout.Amount})
+		}
+
+		vins, err := selectCoins(utxos, params)
+		if err != nil {
+			return nil, fmt.Errorf("tx %d: %v", txIndex, err)
+		}
+		vouts, fee, changeIndex, err := addChange(vins, vouts, params)
+		if err != nil {
+			return nil, fmt.Errorf("tx %d: %v", txIndex, err)
+		}
+		outPkScripts := params.outPkScripts
+		if changeIndex >= 0 {
+			outPkScripts = append(outPkScripts[:len(outPkScripts):len(outPkScripts)], changePkScript)
+		}
+		if vsize := estimateTxSize(a.vinInputSizes(vins), outPkScripts); vsize*witnessScaleFactor > maxStandardTxWeight {
+			return nil, fmt.Errorf("tx %d: weight of %d inputs and %d outputs exceeds the standard weight, lower max_outputs", txIndex, len(vins), len(outPkScripts))
+		}
+
+		txData, signHashes, err := a.buildUnsignedTx(vins, vouts, req.Replaceable)
+		if err != nil {
+			return nil, err
+		}
+		txHash, err := a.finalTxHash(txData, vins)
+		if err != nil {
+			return nil, err
+		}
+		reply.Txs = append(reply.Txs, &proto.PayoutTransaction{
+			TxData:      txData,
+			SignHashes:  signHashes,
+			Vins:        vins,
+			Vouts:       vouts,
+			Fee:         strconv.FormatInt(fee, 10),
+			ChangeIndex: int32(changeIndex),
+			TxHash:      txHash,
+		})
+		// addChange keeps the payouts in order and appends the change
+		for i, payout := range req.Payouts[start:end] {
+			reply.Receipts = append(reply.Receipts, &proto.PayoutReceipt{
+				Reference: payout.Reference,
+				TxIndex:   uint32(txIndex),
+				Index:     uint32(i),
+				TxHash:    txHash,
+			})
+		}
+
+		utxos = unspentUtxos(utxos, vins)
+	}
+	return reply, nil
+}
+
+// finalTxHash returns the txid of the unsigned txData if signing vins leaves it
+// as is, which holds if they are all segwit, and empty otherwise
+func (a *ChainAdaptor) finalTxHash(txData []byte, vins []*proto.Vin) (string, error) {
+	for _, in := range vins {
+		if a.vinInputSize(in).witness == 0 {
+			return "", nil
+		}
+	}
+	msgTx, err := a.deserializeTx(txData)
+	if err != nil {
+		return "", err
+	}
+	hash := a.txHash(msgTx)
+	return hash.String(), nil
+}
+
+// unspentUtxos returns the utxos not spent by vins
+func unspentUtxos(utxos, vins []*proto.Vin) []*proto.Vin {
+	spent := make(map[string]bool, len(vins))
+	for _, in := range vins {
+		spent[fmt.Sprintf("%s:%d", in.Hash, in.Index)] = true
+	}
+	left := make([]*proto.Vin, 0, len(utxos)-len(vins))
+	for _, utxo := range utxos {
+		if !spent[fmt.Sprintf("%s:%d", utxo.Hash, utxo.Index)] {
+			left = append(left, utxo)
+		}
+	}
+	return left
+}
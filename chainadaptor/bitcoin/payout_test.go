@@ -0,0 +1,136 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestBuildPayoutBatchOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	hot := newTestKey("payout hot wallet")
+
+	var utxos []*proto.Vin
+	for i := 0; i < 8; i++ {
+		utxos = append(utxos, &proto.Vin{Hash: fmt.Sprintf("%064x", i+1), Amount: 300000, Address: hot.address})
+	}
+	var payouts []*proto.Payout
+	for i := 0; i < 5; i++ {
+		payouts = append(payouts, &proto.Payout{
+			Address:   newTestKey(fmt.Sprintf("payout customer %d", i)).address,
+			Amount:    int64(100000 * (i + 1)),
+			Reference: "withdrawal-" + strconv.Itoa(i),
+		})
+	}
+	req := &proto.BuildPayoutBatchRequest{
+		Chain:         ChainName,
+		Payouts:       payouts,
+		Utxos:         utxos,
+		FeeRate:       5,
+		ChangeAddress: hot.address,
+		MaxOutputs:    2,
+	}
+	reply, err := adaptor.BuildPayoutBatch(req)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(reply.Txs))
+	require.Equal(t, len(payouts), len(reply.Receipts))
+
+	for i, receipt := range reply.Receipts {
+		assert.Equal(t, payouts[i].Reference, receipt.Reference)
+		assert.Equal(t, uint32(i/2), receipt.TxIndex)
+		vout := reply.Txs[receipt.TxIndex].Vouts[receipt.Index]
+		assert.Equal(t, payouts[i].Address, vout.Address)
+		assert.Equal(t, payouts[i].Amount, vout.Amount)
+	}
+
+	spent := make(map[string]bool)
+	for _, tx := range reply.Txs {
+		var in, out int64
+		for _, vin := range tx.Vins {
+			assert.False(t, spent[vin.Hash], "utxo spent twice")
+			spent[vin.Hash] = true
+			in += vin.Amount
+		}
+		for _, vout := range tx.Vouts {
+			out += vout.Amount
+		}
+		assert.Equal(t, strconv.FormatInt(in-out, 10), tx.Fee)
+		// signing p2pkh inputs changes the txid
+		assert.Equal(t, "", tx.TxHash)
+		if tx.ChangeIndex >= 0 {
+			assert.Equal(t, hot.address, tx.Vouts[tx.ChangeIndex].Address)
+		}
+
+		decoded, err := adaptor.QueryUtxoTransactionFromData(&proto.QueryTransactionFromDataRequest{
+			Chain:   ChainName,
+			RawData: tx.TxData,
+			Vins:    tx.Vins,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, tx.Fee, decoded.CostFee)
+	}
+
+	req.Payouts = append(payouts, &proto.Payout{Address: hot.address, Amount: 1000, Reference: "withdrawal-0"})
+	_, err = adaptor.BuildPayoutBatch(req)
+	assert.NotNil(t, err)
+
+	// the third tx finds too little left to pay 500000
+	req.Payouts = payouts
+	req.Utxos = utxos[:6]
+	_, err = adaptor.BuildPayoutBatch(req)
+	require.NotNil(t, err)
+	assert.Equal(t, "tx 2: insufficient funds", err.Error())
+}
+
+func TestBuildPayoutBatchSegwitTxHashOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	key := newTestKey("payout segwit wallet")
+	witnessScript, err := txscript.NewScriptBuilder().AddData(key.privKey.PubKey().SerializeCompressed()).
+		AddOp(txscript.OP_CHECKSIG).Script()
+	require.Nil(t, err)
+	address := p2wshAddress(t, witnessScript)
+
+	var utxos []*proto.Vin
+	for i := 0; i < 3; i++ {
+		utxos = append(utxos, &proto.Vin{Hash: fmt.Sprintf("%064x", i+1), Amount: 300000, Address: address, WitnessScript: witnessScript})
+	}
+	reply, err := adaptor.BuildPayoutBatch(&proto.BuildPayoutBatchRequest{
+		Chain: ChainName,
+		Payouts: []*proto.Payout{
+			{Address: newTestKey("payout customer 0").address, Amount: 400000, Reference: "withdrawal-0"},
+		},
+		Utxos:         utxos,
+		FeeRate:       5,
+		ChangeAddress: address,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reply.Txs))
+	require.Equal(t, 1, len(reply.Receipts))
+	tx := reply.Txs[0]
+	require.NotEqual(t, "", tx.TxHash)
+	assert.Equal(t, tx.TxHash, reply.Receipts[0].TxHash)
+
+	signReq := &proto.CreateUtxoSignedTransactionRequest{
+		Chain:  ChainName,
+		TxData: tx.TxData,
+		Vins:   tx.Vins,
+	}
+	for _, signHash := range tx.SignHashes {
+		signReq.Signatures = append(signReq.Signatures, rsSignature(t, key.privKey, signHash))
+		signReq.PublicKeys = append(signReq.PublicKeys, key.privKey.PubKey().SerializeCompressed())
+	}
+	signed, err := adaptor.CreateUtxoSignedTransaction(signReq)
+	require.Nil(t, err)
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(signed.SignedTxData)))
+	assert.Equal(t, tx.TxHash, msgTx.TxHash().String())
+}
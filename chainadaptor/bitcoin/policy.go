@@ -0,0 +1,167 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	// maxStandardTxWeight is Bitcoin Core's MAX_STANDARD_TX_WEIGHT
+	maxStandardTxWeight = 400000
+	// minStandardTxNonWitnessSize is Bitcoin Core's MIN_STANDARD_TX_NONWITNESS_SIZE
+	minStandardTxNonWitnessSize = 65
+	// maxStandardP2WSHScriptSize is Bitcoin Core's MAX_STANDARD_P2WSH_SCRIPT_SIZE
+	maxStandardP2WSHScriptSize = 3600
+
+	// outpoint + script length + sequence of an input before it is signed
+	unsignedInputSize = 32 + 4 + 1 + 4
+	// witness item count, <sig> and <compressed pubkey> of a p2wsh spend besides the script
+	p2wshWitnessSize = 1 + 1 + 72 + 1 + 33
+)
+
+// Policy holds the relay policy defaults of the nodes of a chain
+type Policy struct {
+	// MinRelayFeeRate is the default -minrelaytxfee in sat/kvB
+	MinRelayFeeRate int64
+	// DustRelayFeeRate is the default -dustrelayfee in sat/vB
+	DustRelayFeeRate int64
+	// DustLimit replaces the size based dust threshold of spendable outputs if set
+	DustLimit int64
+	// MaxFeeRate in sat/vB is the fee rate above which a tx is absurd, the
+	// broadcast is limited to it by default
+	MaxFeeRate int64
+	// FeeBlocks is the default confirmation target of fee estimates
+	FeeBlocks int64
+	// ActionFee is the marginal fee per logical action of a ZIP-317 conventional
+	// fee, which the tx must pay at least if set
+	ActionFee int64
+}
+
+// zip317GraceActions is the number of actions a ZIP-317 conventional fee pays for at least
+const zip317GraceActions = 2
+
+// conventionalFee returns the ZIP-317 fee of rawTx, 0 if the chain has no action fee.
+// The logical actions of a transparent tx are its inputs or its outputs, whichever
+// are more, counting every input as p2pkh.
+func (p *Policy) conventionalFee(rawTx *wire.MsgTx) int64 {
+	actions := len(rawTx.TxIn)
+	if len(rawTx.TxOut) > actions {
+		actions = len(rawTx.TxOut)
+	}
+	if actions < zip317GraceActions {
+		actions = zip317GraceActions
+	}
+	return p.ActionFee * int64(actions)
+}
+
+// BitcoinPolicy holds the defaults of Bitcoin Core
+var BitcoinPolicy = Policy{
+	MinRelayFeeRate:  1000,
+	DustRelayFeeRate: 3,
+	MaxFeeRate:       2000,
+	FeeBlocks:        3,
+}
+
+// estimateSignedWeight estimates the weight and non-witness size of rawTx once its
+// inputs are signed, sizing each input by the script of the output it spends
+func (a *ChainAdaptor) estimateSignedWeight(rawTx *wire.MsgTx, vins []*proto.Vin) (int64, int64) {
+	size := int64(rawTx.SerializeSizeStripped())
+	var witness int64
+	var witnessInputs int
+	for i, in := range vins {
+		inSize := a.vinInputSize(in)
+		// the unsigned input already counts its outpoint, sequence and script
+		size += inSize.base - unsignedInputSize - int64(len(rawTx.TxIn[i].SignatureScript))
+		if inSize.witness > 0 {
+			witness += inSize.witness
+			witnessInputs++
+		}
+	}
+	if witnessInputs > 0 {
+		// segwit marker and flag, plus an empty witness for every non-witness input
+		witness += 2 + int64(len(vins)-witnessInputs)
+	}
+	return size*witnessScaleFactor + witness, size
+}
+
+// checkStandardness runs the relay policy checks of Bitcoin Core's IsStandardTx and
+// mempool acceptance against the unsigned rawTx paying fee. Violations that make
+// nodes reject the tx are returned as an error naming the offending input or output,
+// lesser concerns as warnings.
+func (a *ChainAdaptor) checkStandardness(rawTx *wire.MsgTx, vins []*proto.Vin, fee int64) ([]string, error) {
+	var warnings []string
+
+	for i, in := range vins {
+		if len(in.WitnessScript) > maxStandardP2WSHScriptSize {
+			return nil, fmt.Errorf("input %d: witness script of %d bytes exceeds the standard size of %d", i, len(in.WitnessScript), maxStandardP2WSHScriptSize)
+		}
+	}
+
+	nullDataIndex := -1
+	for i, out := range rawTx.TxOut {
+		if !a.chain.SegWit && txscript.IsWitnessProgram(out.PkScript) {
+			return nil, fmt.Errorf("output %d: %s has no segwit", i, a.chain.Name)
+		}
+		switch pkScriptType(out.PkScript) {
+		case scriptTypeNonStandard:
+			// witness programs of future versions are standard to relay
+			if !txscript.IsWitnessProgram(out.PkScript) {
+				return nil, fmt.Errorf("output %d: nonstandard script", i)
+			}
+		case scriptTypeNullData:
+			if nullDataIndex >= 0 {
+				return nil, fmt.Errorf("output %d: only one OP_RETURN output is standard, output %d is one too", i, nullDataIndex)
+			}
+			nullDataIndex = i
+		case scriptTypeMultiSig:
+			warnings = append(warnings, fmt.Sprintf("output %d: bare multisig is not relayed by nodes with -permitbaremultisig=0", i))
+		}
+		if threshold := a.dustThreshold(out.PkScript); out.Value < threshold {
+			return nil, fmt.Errorf("output %d: amount %d is dust, below %d", i, out.Value, threshold)
+		}
+	}
+
+	weight, nonWitnessSize := a.estimateSignedWeight(rawTx, vins)
+	if weight > maxStandardTxWeight {
+		return nil, fmt.Errorf("tx weight %d exceeds the standard weight of %d", weight, maxStandardTxWeight)
+	}
+	if nonWitnessSize < minStandardTxNonWitnessSize {
+		return nil, fmt.Errorf("tx non-witness size %d is below the standard size of %d", nonWitnessSize, minStandardTxNonWitnessSize)
+	}
+
+	vsize := (weight + witnessScaleFactor - 1) / witnessScaleFactor
+	if minFee := (a.minRelayFeeRate()*vsize + 999) / 1000; fee < minFee {
+		return nil, fmt.Errorf("fee %d is below the min relay fee of %d for %d vbytes", fee, minFee, vsize)
+	}
+	if minFee := a.chain.Policy.conventionalFee(rawTx); fee < minFee {
+		return nil, fmt.Errorf("fee %d is below the conventional fee of %d for %d inputs and %d outputs", fee, minFee, len(rawTx.TxIn), len(rawTx.TxOut))
+	}
+	if maxFeeRate := a.chain.Policy.MaxFeeRate; fee > maxFeeRate*vsize {
+		return nil, fmt.Errorf("fee %d exceeds the max fee rate of %d sat/vB for %d vbytes", fee, maxFeeRate, vsize)
+	}
+	if node, err := a.getNode(); err != nil {
+		warnings = append(warnings, "the mempool min fee is not checked without a full node")
+	} else if info, err := node.GetMempoolInfo(); err == nil && info.MempoolMinFee > 0 {
+		if minFee := (btcToSatoshi(info.MempoolMinFee).Int64()*vsize + 999) / 1000; fee < minFee {
+			warnings = append(warnings, fmt.Sprintf("fee %d is below the current mempool min fee of %d", fee, minFee))
+		}
+	}
+	return warnings, nil
+}
+
+// minRelayFeeRate returns the node's min relay fee in sat/kvB
+func (a *ChainAdaptor) minRelayFeeRate() int64 {
+	node, err := a.getNode()
+	if err != nil {
+		return a.chain.Policy.MinRelayFeeRate
+	}
+	networkInfo, err := node.GetNetworkInfo()
+	if err != nil || networkInfo.RelayFee <= 0 {
+		return a.chain.Policy.MinRelayFeeRate
+	}
+	return btcToSatoshi(networkInfo.RelayFee).Int64()
+}
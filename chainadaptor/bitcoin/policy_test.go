@@ -0,0 +1,133 @@
+package bitcoin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestCreateUtxoTransactionPolicyOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("policy key")
+	to := newTestKey("policy to")
+
+	// one p2pkh input and output take 192 vbytes once signed
+	create := func(fee int64, vouts ...*proto.Vout) (*proto.CreateUtxoTransactionReply, error) {
+		amount := fee
+		for _, out := range vouts {
+			amount += out.Amount
+		}
+		return adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+			Chain: ChainName,
+			Vins:  []*proto.Vin{{Hash: fmt.Sprintf("%064x", 1), Amount: amount, Address: from.address}},
+			Vouts: vouts,
+			Fee:   strconv.FormatInt(fee, 10),
+		})
+	}
+
+	reply, err := create(192, &proto.Vout{Address: to.address, Amount: 100000})
+	require.Nil(t, err)
+	assert.Equal(t, []string{"the mempool min fee is not checked without a full node"}, reply.Warnings)
+
+	_, err = create(191, &proto.Vout{Address: to.address, Amount: 100000})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "min relay fee of 192")
+
+	_, err = create(192*BitcoinPolicy.MaxFeeRate+1, &proto.Vout{Address: to.address, Amount: 100000})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "max fee rate")
+
+	// a p2wpkh input is sized by its witness, to 113 vbytes with the output
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(from.privKey.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	createWitness := func(fee int64) error {
+		_, err := adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+			Chain: ChainName,
+			Vins:  []*proto.Vin{{Hash: fmt.Sprintf("%064x", 1), Amount: 100000 + fee, Address: p2wpkh.EncodeAddress()}},
+			Vouts: []*proto.Vout{{Address: to.address, Amount: 100000}},
+			Fee:   strconv.FormatInt(fee, 10),
+		})
+		return err
+	}
+	assert.Nil(t, createWitness(113))
+	require.NotNil(t, createWitness(112))
+	assert.Contains(t, createWitness(112).Error(), "min relay fee of 113")
+
+	// a p2pkh output is dust below (34 + 148) * 3
+	_, err = create(1000, &proto.Vout{Address: to.address, Amount: 100000}, &proto.Vout{Address: from.address, Amount: 546})
+	assert.Nil(t, err)
+	_, err = create(1000, &proto.Vout{Address: to.address, Amount: 100000}, &proto.Vout{Address: from.address, Amount: 545})
+	require.NotNil(t, err)
+	assert.Equal(t, "output 1: amount 545 is dust, below 546", err.Error())
+
+	// an omni payload and a memo need two OP_RETURN outputs
+	_, err = adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  []*proto.Vin{{Hash: fmt.Sprintf("%064x", 1), Amount: 101000, Address: from.address}},
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 100000}, {Omni: &proto.OmniSimpleSend{PropertyId: 31, Amount: 1}}},
+		Fee:   "1000",
+		Memo:  "invoice 42",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "output 2: only one OP_RETURN output is standard")
+
+	_, err = adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins: []*proto.Vin{{
+			Hash:          fmt.Sprintf("%064x", 1),
+			Amount:        101000,
+			Address:       p2wshAddress(t, make([]byte, maxStandardP2WSHScriptSize+1)),
+			WitnessScript: make([]byte, maxStandardP2WSHScriptSize+1),
+		}},
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 100000}},
+		Fee:   "1000",
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "input 0: witness script")
+}
+
+func TestCreateUtxoTransactionPolicyMockNode(t *testing.T) {
+	from := newTestKey("policy key")
+	to := newTestKey("policy to")
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getnetworkinfo": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &GetNetworkInfoResult{Version: 250000, RelayFee: 0.00002}, nil
+		},
+		"getmempoolinfo": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &GetMempoolInfoResult{Loaded: true, MempoolMinFee: 0.00005, MinRelayTxFee: 0.00002}, nil
+		},
+	})
+	defer server.Close()
+
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  []*proto.Vin{{Hash: fmt.Sprintf("%064x", 1), Amount: 100383, Address: from.address}},
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 100000}},
+		Fee:   "383",
+	}
+	_, err := adaptor.CreateUtxoTransaction(req)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "min relay fee of 384")
+
+	// relayable, but the mempool is full enough to evict it
+	req.Vins[0].Amount, req.Fee = 100384, "384"
+	reply, err := adaptor.CreateUtxoTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reply.Warnings))
+	assert.Contains(t, reply.Warnings[0], "mempool min fee of 960")
+
+	req.Vins[0].Amount, req.Fee = 100960, "960"
+	reply, err = adaptor.CreateUtxoTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(reply.Warnings))
+}
@@ -0,0 +1,199 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/cache"
+)
+
+// prevTxBatchSize is the number of previous transactions requested at once
+const prevTxBatchSize = 100
+
+// errPrevoutUnresolvable is returned for a prevout the backend has no lookup for,
+// as a pruned node or one without -txindex has not
+var errPrevoutUnresolvable = errors.New("prevout can not be looked up")
+
+type prevout struct {
+	amount  int64
+	address string
+}
+
+// prevoutResolver resolves the outputs spent by the inputs of transactions. The
+// outputs are taken from the block itself, the shared cache or the backend in
+// that order.
+type prevoutResolver struct {
+	a        *ChainAdaptor
+	prevouts map[string]*prevout
+	// unresolvable are the previous transactions the backend does not know
+	unresolvable map[string]bool
+}
+
+func (a *ChainAdaptor) newPrevoutResolver() *prevoutResolver {
+	return &prevoutResolver{
+		a:            a,
+		prevouts:     make(map[string]*prevout),
+		unresolvable: make(map[string]bool),
+	}
+}
+
+func (r *prevoutResolver) key(txid string, index uint32) string {
+	return strings.Join([]string{r.a.chain.Name, txid, fmt.Sprint(index)}, ":")
+}
+
+func (r *prevoutResolver) add(txid string, index uint32, p *prevout) {
+	key := r.key(txid, index)
+	r.prevouts[key] = p
+	// a txid commits to its outputs, so they never go stale
+	cache.GetPrevoutCache().Add(key, p)
+}
+
+// addTx indexes the outputs of tx, so spending them later in the same block needs no lookup
+func (r *prevoutResolver) addTx(tx *btcjson.TxRawResult) {
+	for _, out := range tx.Vout {
+		r.prevouts[r.key(tx.Txid, out.N)] = &prevout{
+			amount:  btcToSatoshi(out.Value).Int64(),
+			address: r.a.scriptPubKeyAddress(out.ScriptPubKey),
+		}
+	}
+}
+
+// addVinPrevout records the prevout getblock returned for an input at verbosity 3
+func (r *prevoutResolver) addVinPrevout(in btcjson.Vin, p *VinPrevout) {
+	if p == nil || in.IsCoinBase() {
+		return
+	}
+	address := ""
+	if pkScript, err := hex.DecodeString(p.ScriptPubKey.Hex); err == nil {
+		address = r.a.pkScriptAddress(pkScript)
+	}
+	r.add(in.Txid, in.Vout, &prevout{
+		amount:  btcToSatoshi(p.Value).Int64(),
+		address: address,
+	})
+}
+
+// resolve looks up the prevouts of all inputs of txs that are not known yet,
+// fetching the previous transactions from the full node in batches, or one by
+// one from an indexer. A previous transaction the backend does not know leaves
+// its prevouts unresolvable, any other failure is returned.
+func (r *prevoutResolver) resolve(txs []*btcjson.TxRawResult) error {
+	var txids []string
+	missing := make(map[string][]uint32)
+	for _, tx := range txs {
+		for _, in := range tx.Vin {
+			if in.IsCoinBase() {
+				continue
+			}
+			key := r.key(in.Txid, in.Vout)
+			if _, ok := r.prevouts[key]; ok {
+				continue
+			}
+			if cached, ok := cache.GetPrevoutCache().Get(key); ok {
+				r.prevouts[key] = cached.(*prevout)
+				continue
+			}
+			if _, ok := missing[in.Txid]; !ok {
+				txids = append(txids, in.Txid)
+			}
+			missing[in.Txid] = append(missing[in.Txid], in.Vout)
+		}
+	}
+
+	client, err := r.a.getNode()
+	if err != nil {
+		for _, txid := range txids {
+			hash, err := chainhash.NewHashFromStr(txid)
+			if err != nil {
+				return err
+			}
+			preTx, err := r.a.getClient().GetRawTransactionVerbose(hash)
+			if err = r.addPrevTx(txid, preTx, err, missing[txid]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for start := 0; start < len(txids); start += prevTxBatchSize {
+		end := start + prevTxBatchSize
+		if end > len(txids) {
+			end = len(txids)
+		}
+
+		futures := make([]rpcclient.FutureGetRawTransactionVerboseResult, 0, end-start)
+		for _, txid := range txids[start:end] {
+			hash, err := chainhash.NewHashFromStr(txid)
+			if err != nil {
+				return err
+			}
+			futures = append(futures, client.GetRawTransactionVerboseAsync(hash))
+		}
+
+		for i, future := range futures {
+			txid := txids[start+i]
+			preTx, err := future.Receive()
+			if err = r.addPrevTx(txid, preTx, err, missing[txid]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addPrevTx adds the outputs at indexes of preTx, the previous transaction txid
+// as fetched with fetchErr. A transaction unknown to the backend is marked
+// unresolvable.
+func (r *prevoutResolver) addPrevTx(txid string, preTx *btcjson.TxRawResult, fetchErr error, indexes []uint32) error {
+	if rpcErr, ok := fetchErr.(*btcjson.RPCError); ok && rpcErr.Code == btcjson.ErrRPCNoTxInfo {
+		log.Warn("prevoutResolver unresolvable prevouts", "txid", txid, "err", fetchErr)
+		r.unresolvable[txid] = true
+		return nil
+	}
+	if fetchErr != nil {
+		return fetchErr
+	}
+	for _, index := range indexes {
+		if int(index) >= len(preTx.Vout) {
+			return fmt.Errorf("tx %s has no output %d", preTx.Txid, index)
+		}
+		out := preTx.Vout[index]
+		r.add(preTx.Txid, index, &prevout{
+			amount:  btcToSatoshi(out.Value).Int64(),
+			address: r.a.scriptPubKeyAddress(out.ScriptPubKey),
+		})
+	}
+	return nil
+}
+
+// get returns the amount and address of a resolved prevout, or
+// errPrevoutUnresolvable if the backend has no lookup for it
+func (r *prevoutResolver) get(txid string, index uint32) (int64, string, error) {
+	p, ok := r.prevouts[r.key(txid, index)]
+	if !ok {
+		if r.unresolvable[txid] {
+			return 0, "", errPrevoutUnresolvable
+		}
+		return 0, "", fmt.Errorf("prevout %s:%d is not resolved", txid, index)
+	}
+	return p.amount, p.address, nil
+}
+
+// scriptPubKeyAddress returns the address of a verbose output. Bitcoin core 22
+// dropped the addresses field, in which case the script is decoded.
+func (a *ChainAdaptor) scriptPubKeyAddress(scriptPubKey btcjson.ScriptPubKeyResult) string {
+	if len(scriptPubKey.Addresses) > 0 {
+		return scriptPubKey.Addresses[0]
+	}
+	pkScript, err := hex.DecodeString(scriptPubKey.Hex)
+	if err != nil {
+		return ""
+	}
+	return a.pkScriptAddress(pkScript)
+}
@@ -0,0 +1,201 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func scriptPubKeyJSON(t *testing.T, address string) map[string]interface{} {
+	local := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	pkScript, err := local.addressPkScript(address)
+	require.Nil(t, err)
+	// like bitcoin core 22 and later, without the addresses field
+	return map[string]interface{}{"hex": hex.EncodeToString(pkScript)}
+}
+
+// prevoutBlockHandlers serves a block with a coinbase, a tx spending two older outputs
+// and a tx spending an output of the same block. withPrevouts tells whether the node
+// answers getblock at verbosity 3.
+func prevoutBlockHandlers(t *testing.T, seed int, withPrevouts bool, getRawTxCalls map[string]int) map[string]mockHandler {
+	miner := newTestKey("prevout miner")
+	from := newTestKey("prevout from")
+	to := newTestKey("prevout to")
+	prevA := fmt.Sprintf("%064x", seed)
+	prevB := fmt.Sprintf("%064x", seed+1)
+	tx1 := fmt.Sprintf("%064x", seed+2)
+
+	vout := func(n int, value float64, address string) map[string]interface{} {
+		return map[string]interface{}{"n": n, "value": value, "scriptPubKey": scriptPubKeyJSON(t, address)}
+	}
+	prevTxs := map[string]map[string]interface{}{
+		prevA: {"txid": prevA, "vout": []interface{}{vout(0, 0.001, from.address)}},
+		prevB: {"txid": prevB, "vout": []interface{}{vout(0, 0.5, to.address), vout(1, 0.002, from.address)}},
+	}
+
+	block := func(verbosity int) interface{} {
+		vinA := map[string]interface{}{"txid": prevA, "vout": 0}
+		vinB := map[string]interface{}{"txid": prevB, "vout": 1}
+		if verbosity == 3 {
+			vinA["prevout"] = map[string]interface{}{"value": 0.001, "scriptPubKey": scriptPubKeyJSON(t, from.address)}
+			vinB["prevout"] = map[string]interface{}{"value": 0.002, "scriptPubKey": scriptPubKeyJSON(t, from.address)}
+		}
+		return map[string]interface{}{
+			"hash":   fmt.Sprintf("%064x", 100),
+			"height": 1000,
+			"time":   1600000000,
+			"tx": []interface{}{
+				map[string]interface{}{
+					"txid": fmt.Sprintf("%064x", seed+3),
+					"vin":  []interface{}{map[string]interface{}{"coinbase": "03e80300"}},
+					"vout": []interface{}{vout(0, 6.25, miner.address)},
+				},
+				map[string]interface{}{
+					"txid": tx1,
+					"vin":  []interface{}{vinA, vinB},
+					"vout": []interface{}{vout(0, 0.0025, to.address), vout(1, 0.0004, from.address)},
+				},
+				map[string]interface{}{
+					"txid": fmt.Sprintf("%064x", seed+4),
+					"vin":  []interface{}{map[string]interface{}{"txid": tx1, "vout": 1}},
+					"vout": []interface{}{vout(0, 0.0003, to.address)},
+				},
+			},
+		}
+	}
+
+	return map[string]mockHandler{
+		"getblockhash": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return fmt.Sprintf("%064x", 100), nil
+		},
+		"getblock": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var verbosity int
+			require.Nil(t, json.Unmarshal(params[1], &verbosity))
+			if verbosity == 3 && !withPrevouts {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter, "Verbosity must be in range 0..2")
+			}
+			return block(verbosity), nil
+		},
+		"getrawtransaction": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			require.Nil(t, json.Unmarshal(params[0], &txid))
+			getRawTxCalls[txid]++
+			tx, ok := prevTxs[txid]
+			if !ok {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey, "No such mempool or blockchain transaction")
+			}
+			return tx, nil
+		},
+	}
+}
+
+func scanBlock(t *testing.T, adaptor *ChainAdaptor) []*proto.QueryUtxoTransactionReply {
+	replyCh := make(chan *proto.QueryUtxoTransactionReply, 10)
+	errCh := make(chan error, 1)
+	adaptor.GetUtxoTransactionByHeight(1000, replyCh, errCh)
+	close(replyCh)
+	require.Equal(t, 0, len(errCh))
+
+	var replies []*proto.QueryUtxoTransactionReply
+	for reply := range replyCh {
+		replies = append(replies, reply)
+	}
+	return replies
+}
+
+func checkScannedBlock(t *testing.T, replies []*proto.QueryUtxoTransactionReply) {
+	from := newTestKey("prevout from")
+	require.Equal(t, 3, len(replies))
+
+	assert.Equal(t, "0", replies[0].CostFee)
+
+	require.Equal(t, 2, len(replies[1].Vins))
+	assert.Equal(t, int64(100000), replies[1].Vins[0].Amount)
+	assert.Equal(t, from.address, replies[1].Vins[0].Address)
+	assert.Equal(t, int64(200000), replies[1].Vins[1].Amount)
+	assert.Equal(t, "10000", replies[1].CostFee)
+
+	// spends an output created earlier in the same block
+	require.Equal(t, 1, len(replies[2].Vins))
+	assert.Equal(t, int64(40000), replies[2].Vins[0].Amount)
+	assert.Equal(t, from.address, replies[2].Vins[0].Address)
+	assert.Equal(t, "10000", replies[2].CostFee)
+}
+
+func TestGetUtxoTransactionByHeightPrevoutsMockNode(t *testing.T) {
+	calls := make(map[string]int)
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, prevoutBlockHandlers(t, 0x1000, true, calls))
+	defer server.Close()
+
+	checkScannedBlock(t, scanBlock(t, adaptor))
+	assert.Equal(t, 0, len(calls))
+}
+
+func TestGetUtxoTransactionByHeightFetchPrevTxMockNode(t *testing.T) {
+	calls := make(map[string]int)
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, prevoutBlockHandlers(t, 0x2000, false, calls))
+	defer server.Close()
+
+	checkScannedBlock(t, scanBlock(t, adaptor))
+	assert.Equal(t, map[string]int{fmt.Sprintf("%064x", 0x2000): 1, fmt.Sprintf("%064x", 0x2001): 1}, calls)
+
+	// the second scan is served from the cache
+	checkScannedBlock(t, scanBlock(t, adaptor))
+	assert.Equal(t, 2, len(calls))
+	assert.Equal(t, 1, calls[fmt.Sprintf("%064x", 0x2000)])
+}
+
+func TestGetUtxoTransactionByHeightUnresolvedPrevoutMockNode(t *testing.T) {
+	calls := make(map[string]int)
+	handlers := prevoutBlockHandlers(t, 0x3000, false, calls)
+	getRawTx := handlers["getrawtransaction"]
+	handlers["getrawtransaction"] = func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+		var txid string
+		require.Nil(t, json.Unmarshal(params[0], &txid))
+		if txid == fmt.Sprintf("%064x", 0x3000) {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey, "No such mempool or blockchain transaction")
+		}
+		return getRawTx(params)
+	}
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, handlers)
+	defer server.Close()
+
+	// the block is still scanned, only the vin spending the unknown tx is left empty and marked
+	replies := scanBlock(t, adaptor)
+	require.Equal(t, 3, len(replies))
+	assert.True(t, replies[0].Vins[0].Coinbase)
+	require.Equal(t, 2, len(replies[1].Vins))
+	assert.Equal(t, fmt.Sprintf("%064x", 0x3000), replies[1].Vins[0].Hash)
+	assert.True(t, replies[1].Vins[0].Unresolved)
+	assert.Equal(t, int64(0), replies[1].Vins[0].Amount)
+	assert.Equal(t, "", replies[1].Vins[0].Address)
+	assert.Equal(t, "", replies[1].CostFee)
+	assert.False(t, replies[1].Vins[1].Unresolved)
+	assert.Equal(t, int64(200000), replies[1].Vins[1].Amount)
+	assert.Equal(t, int64(40000), replies[2].Vins[0].Amount)
+	assert.NotEqual(t, "", replies[2].CostFee)
+}
+
+func TestGetUtxoTransactionByHeightPrevoutErrorMockNode(t *testing.T) {
+	handlers := prevoutBlockHandlers(t, 0x4000, false, make(map[string]int))
+	handlers["getrawtransaction"] = func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+		return nil, btcjson.NewRPCError(btcjson.ErrRPCMisc, "Loading block index...")
+	}
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, handlers)
+	defer server.Close()
+
+	// a node failing to answer fails the block instead of leaving its vins empty
+	replyCh := make(chan *proto.QueryUtxoTransactionReply, 10)
+	errCh := make(chan error, 1)
+	adaptor.GetUtxoTransactionByHeight(1000, replyCh, errCh)
+	require.Equal(t, 1, len(errCh))
+	assert.Equal(t, 0, len(replyCh))
+}
@@ -0,0 +1,246 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// GetTxInclusionProof returns the header of the block containing the tx and the
+// merkle branch proving the tx is in it
+func (a *ChainAdaptor) GetTxInclusionProof(req *proto.GetTxInclusionProofRequest) (*proto.GetTxInclusionProofReply, error) {
+	proof, err := a.getTxInclusionProof(req)
+	if err != nil {
+		log.Error("GetTxInclusionProof", "err", err)
+		return &proto.GetTxInclusionProofReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return &proto.GetTxInclusionProofReply{
+		Code:  proto.ReturnCode_SUCCESS,
+		Proof: proof,
+	}, nil
+}
+
+func (a *ChainAdaptor) getTxInclusionProof(req *proto.GetTxInclusionProofRequest) (*proto.TxInclusionProof, error) {
+	txHash, err := chainhash.NewHashFromStr(req.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	client, err := a.getNode()
+	if err != nil {
+		return nil, err
+	}
+
+	blockHashStr := req.BlockHash
+	if blockHashStr == "" {
+		tx, err := client.GetRawTransactionVerbose(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx.BlockHash == "" {
+			return nil, fmt.Errorf("tx %s is not in a block yet", req.TxHash)
+		}
+		blockHashStr = tx.BlockHash
+	}
+	blockHash, err := chainhash.NewHashFromStr(blockHashStr)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := client.GetBlockHeader(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	// a header whose proof of work can not be checked makes a proof nobody can verify
+	if _, err = a.powHash(header); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = header.Serialize(&buf); err != nil {
+		return nil, err
+	}
+
+	block, err := client.GetBlockWithRawTransactionVerbose(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	txids := make([]chainhash.Hash, len(block.Tx))
+	index := -1
+	for i, tx := range block.Tx {
+		hash, err := chainhash.NewHashFromStr(tx.Txid)
+		if err != nil {
+			return nil, err
+		}
+		txids[i] = *hash
+		if hash.IsEqual(txHash) {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("tx %s is not in block %s", req.TxHash, blockHashStr)
+	}
+	rawTx, err := hex.DecodeString(block.Tx[index].Hex)
+	if err != nil {
+		return nil, err
+	}
+
+	var branch [][]byte
+	for _, hash := range merkleBranch(txids, index) {
+		branch = append(branch, hash.CloneBytes())
+	}
+	return &proto.TxInclusionProof{
+		TxHash:       req.TxHash,
+		BlockHeader:  buf.Bytes(),
+		BlockHash:    blockHashStr,
+		BlockHeight:  uint64(block.Height),
+		TxIndex:      uint32(index),
+		TxCount:      uint32(len(txids)),
+		MerkleBranch: branch,
+		RawTx:        rawTx,
+	}, nil
+}
+
+// VerifyTxInclusionProof checks offline that the header of the proof meets its
+// own proof of work target, that the raw tx hashes to the tx hash and that the
+// merkle branch leads from it to the merkle root of the header. Whether the block
+// is in the best chain is not checked.
+func (a *ChainAdaptor) VerifyTxInclusionProof(req *proto.VerifyTxInclusionProofRequest) (*proto.VerifyTxInclusionProofReply, error) {
+	err := a.verifyTxInclusionProof(req.Proof)
+	if err != nil {
+		log.Error("VerifyTxInclusionProof", "err", err)
+		return &proto.VerifyTxInclusionProofReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return &proto.VerifyTxInclusionProofReply{
+		Code:     proto.ReturnCode_SUCCESS,
+		Verified: true,
+	}, nil
+}
+
+func (a *ChainAdaptor) verifyTxInclusionProof(proof *proto.TxInclusionProof) error {
+	if proof == nil {
+		return errors.New("no proof in req")
+	}
+	if len(proof.BlockHeader) != wire.MaxBlockHeaderPayload {
+		return fmt.Errorf("block header must be %d bytes", wire.MaxBlockHeaderPayload)
+	}
+	var header wire.BlockHeader
+	err := header.Deserialize(bytes.NewReader(proof.BlockHeader))
+	if err != nil {
+		return err
+	}
+	powHash, err := a.powHash(&header)
+	if err != nil {
+		return err
+	}
+	blockHash := header.BlockHash()
+	if proof.BlockHash != "" && proof.BlockHash != blockHash.String() {
+		return fmt.Errorf("block hash %s does not match the header hash %s", proof.BlockHash, blockHash)
+	}
+
+	powLimit := a.getClient().GetNetwork().PowLimit
+	if powLimit == nil {
+		return errors.New("no proof of work limit to check the header against")
+	}
+	target := blockchain.CompactToBig(header.Bits)
+	if target.Sign() <= 0 || target.Cmp(powLimit) > 0 {
+		return fmt.Errorf("block target %064x is out of range", target)
+	}
+	if blockchain.HashToBig(&powHash).Cmp(target) > 0 {
+		return fmt.Errorf("block hash %s does not meet its target %064x", blockHash, target)
+	}
+
+	// the header does not commit to the tx count, a shorter branch from an inner
+	// node verifies as well. Inner nodes hash 64 bytes, txs never do.
+	if len(proof.RawTx) == 0 {
+		return errors.New("no raw tx in proof")
+	}
+	tx, err := a.deserializeTx(proof.RawTx)
+	if err != nil {
+		return err
+	}
+	if tx.SerializeSizeStripped() == 64 {
+		return errors.New("a 64 byte tx can not be told apart from an inner merkle node")
+	}
+	if rawTxHash := a.txHash(tx); rawTxHash.String() != proof.TxHash {
+		return fmt.Errorf("raw tx hashes to %s, not the tx hash %s", rawTxHash, proof.TxHash)
+	}
+	if proof.TxIndex >= proof.TxCount {
+		return fmt.Errorf("tx index %d out of %d txs", proof.TxIndex, proof.TxCount)
+	}
+	if len(proof.MerkleBranch) != merkleDepth(int(proof.TxCount)) {
+		return fmt.Errorf("merkle branch of %d txs must have %d hashes", proof.TxCount, merkleDepth(int(proof.TxCount)))
+	}
+	txHash, err := chainhash.NewHashFromStr(proof.TxHash)
+	if err != nil {
+		return err
+	}
+	root := *txHash
+	index := proof.TxIndex
+	for _, sibling := range proof.MerkleBranch {
+		siblingHash, err := chainhash.NewHash(sibling)
+		if err != nil {
+			return err
+		}
+		if index&1 == 0 {
+			root = *blockchain.HashMerkleBranches(&root, siblingHash)
+		} else {
+			root = *blockchain.HashMerkleBranches(siblingHash, &root)
+		}
+		index >>= 1
+	}
+	if !root.IsEqual(&header.MerkleRoot) {
+		return fmt.Errorf("merkle branch leads to %s, not the merkle root %s", root, header.MerkleRoot)
+	}
+	return nil
+}
+
+// powHash returns the hash of header that has to meet its target
+func (a *ChainAdaptor) powHash(header *wire.BlockHeader) (chainhash.Hash, error) {
+	if a.chain.PowHash != nil {
+		return a.chain.PowHash(header)
+	}
+	return header.BlockHash(), nil
+}
+
+// merkleDepth returns the number of levels above the txids in the merkle tree
+func merkleDepth(txCount int) int {
+	depth := 0
+	for width := txCount; width > 1; width = (width + 1) / 2 {
+		depth++
+	}
+	return depth
+}
+
+// merkleBranch returns the sibling hashes on the path from txids[index] to the
+// merkle root, duplicating the last hash of a level with an odd count as bitcoin does
+func merkleBranch(txids []chainhash.Hash, index int) []chainhash.Hash {
+	var branch []chainhash.Hash
+	level := txids
+	for len(level) > 1 {
+		if len(level)%2 == 1 {
+			level = append(level[:len(level):len(level)], level[len(level)-1])
+		}
+		branch = append(branch, level[index^1])
+
+		next := make([]chainhash.Hash, len(level)/2)
+		for i := range next {
+			next[i] = *blockchain.HashMerkleBranches(&level[2*i], &level[2*i+1])
+		}
+		level = next
+		index >>= 1
+	}
+	return branch
+}
@@ -0,0 +1,225 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// proofTx returns a tx whose pkScript makes it 60+len(pkScript) bytes
+func proofTx(lockTime uint32, pkScript []byte) *wire.MsgTx {
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1000, pkScript))
+	msgTx.LockTime = lockTime
+	return msgTx
+}
+
+// minedRegTestBlock returns the txs and the header of a regtest block of txCount txs
+func minedRegTestBlock(t *testing.T, txCount int) ([]*wire.MsgTx, *wire.BlockHeader) {
+	var txs []*btcutil.Tx
+	var msgTxs []*wire.MsgTx
+	for i := 0; i < txCount; i++ {
+		msgTx := proofTx(uint32(i), []byte{txscript.OP_TRUE})
+		txs = append(txs, btcutil.NewTx(msgTx))
+		msgTxs = append(msgTxs, msgTx)
+	}
+	store := blockchain.BuildMerkleTreeStore(txs, false)
+
+	header := &wire.BlockHeader{
+		Version:    0x20000000,
+		MerkleRoot: *store[len(store)-1],
+		Timestamp:  time.Unix(1600000000, 0),
+		Bits:       chaincfg.RegressionNetParams.PowLimitBits,
+	}
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return msgTxs, header
+		}
+		header.Nonce++
+	}
+}
+
+// rawTx returns the serialized msgTx
+func rawTx(t *testing.T, msgTx *wire.MsgTx) []byte {
+	var buf bytes.Buffer
+	require.Nil(t, msgTx.Serialize(&buf))
+	return buf.Bytes()
+}
+
+func TestTxInclusionProofMockNode(t *testing.T) {
+	txs, header := minedRegTestBlock(t, 5)
+	var txids []string
+	var blockTxs []map[string]interface{}
+	for _, tx := range txs {
+		txids = append(txids, tx.TxHash().String())
+		blockTxs = append(blockTxs, map[string]interface{}{"txid": tx.TxHash().String(), "hex": hex.EncodeToString(rawTx(t, tx))})
+	}
+	blockHash := header.BlockHash().String()
+	var headerBuf bytes.Buffer
+	require.Nil(t, header.Serialize(&headerBuf))
+
+	adaptor, server := newMockChainAdaptor(t, config.RegTest, map[string]mockHandler{
+		"getrawtransaction": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			require.Nil(t, json.Unmarshal(params[0], &txid))
+			if txid == txids[4] {
+				return map[string]interface{}{"txid": txid, "confirmations": 0}, nil
+			}
+			return map[string]interface{}{"txid": txid, "blockhash": blockHash, "confirmations": 3}, nil
+		},
+		"getblock": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var verbosity int
+			require.Nil(t, json.Unmarshal(params[1], &verbosity))
+			require.Equal(t, 2, verbosity)
+			return map[string]interface{}{"hash": blockHash, "height": 7, "tx": blockTxs}, nil
+		},
+		"getblockheader": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return hex.EncodeToString(headerBuf.Bytes()), nil
+		},
+	})
+	defer server.Close()
+
+	for i, txid := range txids[:4] {
+		reply, err := adaptor.GetTxInclusionProof(&proto.GetTxInclusionProofRequest{Chain: ChainName, TxHash: txid})
+		require.Nil(t, err)
+		proof := reply.Proof
+		assert.Equal(t, uint32(i), proof.TxIndex)
+		assert.Equal(t, uint32(5), proof.TxCount)
+		assert.Equal(t, uint64(7), proof.BlockHeight)
+		assert.Equal(t, 3, len(proof.MerkleBranch))
+		assert.Equal(t, rawTx(t, txs[i]), proof.RawTx)
+
+		verifyReply, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: proof})
+		require.Nil(t, err)
+		assert.True(t, verifyReply.Verified)
+	}
+
+	// without txindex the block hash has to be given
+	reply, err := adaptor.GetTxInclusionProof(&proto.GetTxInclusionProofRequest{Chain: ChainName, TxHash: txids[4]})
+	assert.NotNil(t, err)
+	reply, err = adaptor.GetTxInclusionProof(&proto.GetTxInclusionProofRequest{Chain: ChainName, TxHash: txids[4], BlockHash: blockHash})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(4), reply.Proof.TxIndex)
+	_, err = adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: reply.Proof})
+	assert.Nil(t, err)
+}
+
+func TestVerifyTxInclusionProofOffline(t *testing.T) {
+	txs, header := minedRegTestBlock(t, 5)
+	var txids []string
+	for _, tx := range txs {
+		txids = append(txids, tx.TxHash().String())
+	}
+	var headerBuf bytes.Buffer
+	require.Nil(t, header.Serialize(&headerBuf))
+
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.RegTest)})
+	valid := func() *proto.TxInclusionProof {
+		var hashes []chainhash.Hash
+		for _, txid := range txids {
+			hash, err := chainhash.NewHashFromStr(txid)
+			require.Nil(t, err)
+			hashes = append(hashes, *hash)
+		}
+		var branch [][]byte
+		for _, hash := range merkleBranch(hashes, 2) {
+			branch = append(branch, hash.CloneBytes())
+		}
+		return &proto.TxInclusionProof{
+			TxHash:       txids[2],
+			BlockHeader:  headerBuf.Bytes(),
+			BlockHash:    header.BlockHash().String(),
+			TxIndex:      2,
+			TxCount:      5,
+			MerkleBranch: branch,
+			RawTx:        rawTx(t, txs[2]),
+		}
+	}
+	verify := func(proof *proto.TxInclusionProof) error {
+		_, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: proof})
+		return err
+	}
+	assert.Nil(t, verify(valid()))
+
+	proof := valid()
+	proof.TxIndex = 3
+	assert.NotNil(t, verify(proof))
+
+	proof = valid()
+	proof.TxHash = txids[1]
+	assert.NotNil(t, verify(proof))
+
+	proof = valid()
+	proof.MerkleBranch[1][0] ^= 1
+	assert.NotNil(t, verify(proof))
+
+	proof = valid()
+	proof.MerkleBranch = proof.MerkleBranch[:2]
+	assert.NotNil(t, verify(proof))
+
+	proof = valid()
+	proof.RawTx = nil
+	assert.NotNil(t, verify(proof))
+
+	proof = valid()
+	proof.RawTx = rawTx(t, txs[1])
+	assert.NotNil(t, verify(proof))
+
+	// the header does not commit to the tx count, an inner node of the tree
+	// would pass for a tx of a smaller block if its 64 bytes were taken for one
+	tx2, err := chainhash.NewHashFromStr(txids[2])
+	require.Nil(t, err)
+	tx3, err := chainhash.NewHashFromStr(txids[3])
+	require.Nil(t, err)
+	proof = valid()
+	proof.TxHash = blockchain.HashMerkleBranches(tx2, tx3).String()
+	proof.TxIndex = 1
+	proof.TxCount = 3
+	proof.MerkleBranch = proof.MerkleBranch[1:]
+	proof.RawTx = append(tx2.CloneBytes(), tx3.CloneBytes()...)
+	assert.NotNil(t, verify(proof))
+
+	tx64 := proofTx(0, []byte{txscript.OP_TRUE, txscript.OP_TRUE, txscript.OP_TRUE, txscript.OP_TRUE})
+	require.Equal(t, 64, len(rawTx(t, tx64)))
+	proof = valid()
+	proof.TxHash = tx64.TxHash().String()
+	proof.RawTx = rawTx(t, tx64)
+	err = verify(proof)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "64 byte")
+
+	reply, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: valid()})
+	require.Nil(t, err)
+	assert.True(t, reply.Verified)
+
+	// a regtest target is far above what testnet allows
+	testnet := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	_, err = testnet.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: valid()})
+	assert.NotNil(t, err)
+
+	// a header not meeting its own target
+	header.Bits = 0x1d00ffff
+	headerBuf.Reset()
+	require.Nil(t, header.Serialize(&headerBuf))
+	proof = valid()
+	proof.BlockHash = ""
+	assert.NotNil(t, verify(proof))
+}
@@ -0,0 +1,209 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	// defaultIncrementalRelayFeeRate is Bitcoin Core's default -incrementalrelayfee in sat/vB
+	defaultIncrementalRelayFeeRate = 1
+)
+
+// BumpUtxoFee rebuilds a replaceable transaction with the same inputs and a
+// higher fee taken from its change output, following the rules of BIP125
+func (a *ChainAdaptor) BumpUtxoFee(req *proto.BumpUtxoFeeRequest) (*proto.BumpUtxoFeeReply, error) {
+	reply, err := a.bumpUtxoFee(req)
+	if err != nil {
+		log.Error("BumpUtxoFee", "err", err)
+		return &proto.BumpUtxoFeeReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return reply, nil
+}
+
+func (a *ChainAdaptor) bumpUtxoFee(req *proto.BumpUtxoFeeRequest) (*proto.BumpUtxoFeeReply, error) {
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+
+	msgTx, err := a.txToReplace(req)
+	if err != nil {
+		return nil, err
+	}
+	if !signalsReplaceability(msgTx) {
+		return nil, errors.New("transaction does not signal replaceability")
+	}
+
+	offline := len(req.Vins) > 0
+	if offline && len(req.Vins) != len(msgTx.TxIn) {
+		return nil, errors.New("the length of deserialized tx's in differs from vin in req")
+	}
+	ins, totalAmountIn, err := a.decodeVins(*msgTx, offline, req.Vins, false)
+	if err != nil {
+		return nil, err
+	}
+	var totalAmountOut int64
+	for _, out := range msgTx.TxOut {
+		totalAmountOut += out.Value
+	}
+	originalFee := totalAmountIn.Int64() - totalAmountOut
+	originalVSize := txVSize(msgTx)
+
+	// the replacement pays for the descendants it evicts as well, which only a
+	// full node knows
+	replacedFee := originalFee
+	if node, err := a.getNode(); err == nil {
+		if entry, err := node.GetMempoolEntry(a.txHash(msgTx).String()); err == nil {
+			if descendantFee := btcToSatoshi(entry.Fees.Descendant).Int64(); descendantFee > replacedFee {
+				replacedFee = descendantFee
+			}
+		}
+	}
+
+	changePkScript, err := a.addressPkScript(req.ChangeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid change address: %v", err)
+	}
+	changeIndex := -1
+	pkScripts := make([][]byte, len(msgTx.TxOut))
+	for i, out := range msgTx.TxOut {
+		pkScripts[i] = out.PkScript
+		if bytes.Equal(out.PkScript, changePkScript) {
+			changeIndex = i
+		}
+	}
+	if changeIndex < 0 {
+		return nil, errors.New("no output pays to the change address")
+	}
+
+	vsize := estimateTxSize(a.vinInputSizes(ins), pkScripts)
+	if originalVSize > vsize {
+		vsize = originalVSize
+	}
+	fee := int64(req.FeeRate) * vsize
+
+	// BIP125 rule 4: the replacement pays for its own relay at the incremental relay fee
+	minFee := replacedFee + a.incrementalRelayFeeRate()*vsize
+	if fee < minFee {
+		return nil, fmt.Errorf("fee rate too low, the replacement needs a fee of at least %d", minFee)
+	}
+	// BIP125 rule 3 and the fee rate of the original must be exceeded
+	if fee*originalVSize <= originalFee*vsize {
+		return nil, errors.New("fee rate must be higher than the original fee rate")
+	}
+
+	bumpedTx := msgTx.Copy()
+	for _, in := range bumpedTx.TxIn {
+		in.SignatureScript = nil
+		in.Witness = nil
+	}
+	change := bumpedTx.TxOut[changeIndex].Value - (fee - originalFee)
+	if change < a.dustThreshold(changePkScript) {
+		// give the rest of the change up to the fee
+		if len(bumpedTx.TxOut) == 1 {
+			return nil, errors.New("change output can not cover the fee increase")
+		}
+		fee = originalFee + bumpedTx.TxOut[changeIndex].Value
+		vsize -= int64(bumpedTx.TxOut[changeIndex].SerializeSize())
+		bumpedTx.TxOut = append(bumpedTx.TxOut[:changeIndex], bumpedTx.TxOut[changeIndex+1:]...)
+		// without the change the fee is all that is left, which still has to
+		// meet rule 4 and the requested rate
+		if minFee = replacedFee + a.incrementalRelayFeeRate()*vsize; fee < minFee {
+			return nil, fmt.Errorf("change output can not cover the fee increase, the replacement needs a fee of at least %d", minFee)
+		}
+		if fee < int64(req.FeeRate)*vsize {
+			return nil, fmt.Errorf("change output can not cover the fee increase, the rate needs a fee of %d", int64(req.FeeRate)*vsize)
+		}
+	} else {
+		bumpedTx.TxOut[changeIndex].Value = change
+	}
+
+	signHashes, err := a.calcSignHashes(bumpedTx, ins)
+	if err != nil {
+		return nil, err
+	}
+	txData, err := a.serializeTx(bumpedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	vouts := make([]*proto.Vout, len(bumpedTx.TxOut))
+	for i, out := range bumpedTx.TxOut {
+		vouts[i] = &proto.Vout{
+			Address: a.pkScriptAddress(out.PkScript),
+			Amount:  out.Value,
+			Index:   uint32(i),
+		}
+	}
+
+	return &proto.BumpUtxoFeeReply{
+		Code:        proto.ReturnCode_SUCCESS,
+		TxData:      txData,
+		SignHashes:  signHashes,
+		Vins:        ins,
+		Vouts:       vouts,
+		Fee:         strconv.FormatInt(fee, 10),
+		OriginalFee: strconv.FormatInt(originalFee, 10),
+	}, nil
+}
+
+// txToReplace returns the signed transaction of req, looking it up in the mempool by hash if needed
+func (a *ChainAdaptor) txToReplace(req *proto.BumpUtxoFeeRequest) (*wire.MsgTx, error) {
+	var txData []byte
+	if len(req.SignedTxData) > 0 {
+		txData = req.SignedTxData
+	} else {
+		txHash, err := chainhash.NewHashFromStr(req.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		tx, err := a.getClient().GetRawTransactionVerbose(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if tx.Confirmations > 0 {
+			return nil, errors.New("transaction is already confirmed")
+		}
+		txData, err = hex.DecodeString(tx.Hex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.deserializeTx(txData)
+}
+
+// incrementalRelayFeeRate returns the node's incremental relay fee in sat/vB
+func (a *ChainAdaptor) incrementalRelayFeeRate() int64 {
+	node, err := a.getNode()
+	if err != nil {
+		return defaultIncrementalRelayFeeRate
+	}
+	networkInfo, err := node.GetNetworkInfo()
+	if err != nil || networkInfo.IncrementalFee <= 0 {
+		return defaultIncrementalRelayFeeRate
+	}
+	return int64(btcPerKvBToSatPerVB(networkInfo.IncrementalFee))
+}
+
+// signalsReplaceability reports whether any input opts in to BIP125 replacement
+func signalsReplaceability(msgTx *wire.MsgTx) bool {
+	for _, in := range msgTx.TxIn {
+		if in.Sequence <= maxRBFSequence {
+			return true
+		}
+	}
+	return false
+}
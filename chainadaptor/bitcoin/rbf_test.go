@@ -0,0 +1,101 @@
+package bitcoin
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestBumpUtxoFeeOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("rbf key")
+	to := newTestKey("rbf to")
+
+	utxos := []*proto.Vin{
+		{Hash: fmt.Sprintf("%064x", 1), Index: 0, Amount: 100000, Address: from.address},
+		{Hash: fmt.Sprintf("%064x", 2), Index: 1, Amount: 200000, Address: from.address},
+	}
+	buildReq := &proto.BuildUtxoTransactionRequest{
+		Chain:         ChainName,
+		Utxos:         utxos,
+		Vouts:         []*proto.Vout{{Address: to.address, Amount: 250000}},
+		FeeRate:       2,
+		ChangeAddress: from.address,
+		Replaceable:   true,
+	}
+	built, err := adaptor.BuildUtxoTransaction(buildReq)
+	require.Nil(t, err)
+	require.True(t, built.ChangeIndex >= 0)
+
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(built.TxData)))
+	for _, in := range msgTx.TxIn {
+		assert.Equal(t, uint32(maxRBFSequence), in.Sequence)
+	}
+
+	keys := []*testKey{from, from}
+	signed := signTx(t, built.TxData, built.SignHashes, keys)
+
+	req := &proto.BumpUtxoFeeRequest{
+		Chain:         ChainName,
+		SignedTxData:  signed,
+		Vins:          built.Vins,
+		FeeRate:       20,
+		ChangeAddress: from.address,
+	}
+	reply, err := adaptor.BumpUtxoFee(req)
+	require.Nil(t, err)
+	assert.Equal(t, built.Fee, reply.OriginalFee)
+
+	fee, err := strconv.ParseInt(reply.Fee, 10, 64)
+	require.Nil(t, err)
+	originalFee, _ := strconv.ParseInt(built.Fee, 10, 64)
+	assert.True(t, fee > originalFee)
+	require.Equal(t, len(built.Vouts), len(reply.Vouts))
+	assert.Equal(t, built.Vouts[built.ChangeIndex].Amount-(fee-originalFee), reply.Vouts[built.ChangeIndex].Amount)
+	assert.Equal(t, int64(250000), reply.Vouts[0].Amount)
+
+	// the replacement spends the same inputs and can be signed
+	bumpedSigned := signTx(t, reply.TxData, reply.SignHashes, keys)
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: bumpedSigned,
+		Vins:         reply.Vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	// the increase has to pay for the relay of the replacement
+	req.FeeRate = 2
+	_, err = adaptor.BumpUtxoFee(req)
+	assert.NotNil(t, err)
+
+	// a change output too small for the increase is dropped
+	req.FeeRate = 140
+	reply, err = adaptor.BumpUtxoFee(req)
+	require.Nil(t, err)
+	assert.Equal(t, 1, len(reply.Vouts))
+	assert.Equal(t, "50000", reply.Fee)
+
+	// unless the fee it leaves is below the requested rate
+	req.FeeRate = 300
+	_, err = adaptor.BumpUtxoFee(req)
+	assert.NotNil(t, err)
+
+	buildReq.Replaceable = false
+	built, err = adaptor.BuildUtxoTransaction(buildReq)
+	require.Nil(t, err)
+	req.SignedTxData = signTx(t, built.TxData, built.SignHashes, keys)
+	req.FeeRate = 20
+	reply, err = adaptor.BumpUtxoFee(req)
+	assert.NotNil(t, err)
+	assert.Equal(t, "transaction does not signal replaceability", reply.Msg)
+}
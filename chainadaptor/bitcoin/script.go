@@ -0,0 +1,137 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	scriptTypeP2PK        = "p2pk"
+	scriptTypeMultiSig    = "multisig"
+	scriptTypeNullData    = "nulldata"
+	scriptTypeNonStandard = "nonstandard"
+
+	// witness version 1 with a 32 byte program
+	p2trScriptSize = 34
+)
+
+// pkScriptType classifies an output script by the type names used in the replies
+func pkScriptType(pkScript []byte) string {
+	switch txscript.GetScriptClass(pkScript) {
+	case txscript.PubKeyHashTy:
+		return scriptTypeP2PKH
+	case txscript.ScriptHashTy:
+		return scriptTypeP2SH
+	case txscript.WitnessV0PubKeyHashTy:
+		return scriptTypeP2WPKH
+	case txscript.WitnessV0ScriptHashTy:
+		return scriptTypeP2WSH
+	case txscript.NullDataTy:
+		return scriptTypeNullData
+	case txscript.MultiSigTy:
+		return scriptTypeMultiSig
+	case txscript.PubKeyTy:
+		return scriptTypeP2PK
+	}
+	// btcd predates taproot, so witness v1 programs are matched by hand
+	if len(pkScript) == p2trScriptSize && pkScript[0] == txscript.OP_1 && pkScript[1] == txscript.OP_DATA_32 {
+		return scriptTypeP2TR
+	}
+	return scriptTypeNonStandard
+}
+
+// nullDataMemo returns the data of a nulldata script as text, or empty if the
+// script carries no data or the data is not valid utf-8
+func nullDataMemo(pkScript []byte) string {
+	if txscript.GetScriptClass(pkScript) != txscript.NullDataTy {
+		return ""
+	}
+	pushes, err := txscript.PushedData(pkScript)
+	if err != nil {
+		return ""
+	}
+	var data []byte
+	for _, push := range pushes {
+		data = append(data, push...)
+	}
+	if !utf8.Valid(data) {
+		return ""
+	}
+	return string(data)
+}
+
+// buildMemoScript returns the OP_RETURN script carrying memo
+func buildMemoScript(memo string) ([]byte, error) {
+	if len(memo) > txscript.MaxDataCarrierSize {
+		return nil, errors.New("memo exceeds the data carrier size")
+	}
+	return txscript.NullDataScript([]byte(memo))
+}
+
+// fillVoutScript sets the script fields of a vout from its pkScript
+func fillVoutScript(vout *proto.Vout, pkScript []byte) {
+	vout.Script = hex.EncodeToString(pkScript)
+	vout.ScriptType = pkScriptType(pkScript)
+	vout.Omni = parseOmniSimpleSend(pkScript)
+	if vout.Omni == nil {
+		vout.Memo = nullDataMemo(pkScript)
+	}
+}
+
+// vinSigHashType returns the sighash type requested for the index'th input of
+// rawTx, defaulting to SIGHASH_ALL
+func vinSigHashType(vin *proto.Vin, rawTx *wire.MsgTx, index int) (txscript.SigHashType, error) {
+	if vin == nil || vin.SighashType == 0 {
+		return txscript.SigHashAll, nil
+	}
+
+	hashType := txscript.SigHashType(vin.SighashType)
+	switch hashType &^ txscript.SigHashAnyOneCanPay {
+	case txscript.SigHashAll, txscript.SigHashNone:
+	case txscript.SigHashSingle:
+		// without a matching output the legacy sighash commits to nothing
+		if index >= len(rawTx.TxOut) {
+			return 0, fmt.Errorf("SIGHASH_SINGLE input %d has no matching output", index)
+		}
+	default:
+		return 0, fmt.Errorf("invalid sighash type %#x of input %d", vin.SighashType, index)
+	}
+	return hashType, nil
+}
+
+// sigHashTypes are the sighash types an input can be signed with
+var sigHashTypes = []txscript.SigHashType{
+	txscript.SigHashAll,
+	txscript.SigHashNone,
+	txscript.SigHashSingle,
+	txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+	txscript.SigHashNone | txscript.SigHashAnyOneCanPay,
+	txscript.SigHashSingle | txscript.SigHashAnyOneCanPay,
+}
+
+// signedSigHashType returns the sighash type sig of pubKey signs the index'th
+// input of rawTx with, for signatures passed without the vins carrying the
+// requested types
+func (a *ChainAdaptor) signedSigHashType(rawTx *wire.MsgTx, index int, prevOuts []*wire.TxOut, sig *btcec.Signature, pubKey *btcec.PublicKey) (txscript.SigHashType, error) {
+	for _, hashType := range sigHashTypes {
+		if hashType&^txscript.SigHashAnyOneCanPay == txscript.SigHashSingle && index >= len(rawTx.TxOut) {
+			continue
+		}
+		signHash, err := a.chain.SigHasher.SignHash(rawTx, index, prevOuts, hashType)
+		if err != nil {
+			return 0, err
+		}
+		if sig.Verify(signHash, pubKey) {
+			return hashType, nil
+		}
+	}
+	return 0, fmt.Errorf("signature of input %d signs no sighash type", index)
+}
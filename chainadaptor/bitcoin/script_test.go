@@ -0,0 +1,216 @@
+package bitcoin
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestPkScriptTypeOffline(t *testing.T) {
+	cases := map[string]string{
+		"76a91419064bda7eb5049f922a4bca4c24808c6aea948d88ac":                         scriptTypeP2PKH,
+		"a91410080578e54a2a66efcb55e69b073100d0da47b987":                             scriptTypeP2SH,
+		"0014751e76e8199196d454941c45d1b3a323f1433bd6":                               scriptTypeP2WPKH,
+		"00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262":       scriptTypeP2WSH,
+		"5120a60869f0dbcf1dc659c9cecbaf8050135ea9e8cdc487053f1dc6880949dc684c":       scriptTypeP2TR,
+		"6a0b68656c6c6f20776f726c64":                                                 scriptTypeNullData,
+		"51210279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f8179851ae": scriptTypeMultiSig,
+		"51": scriptTypeNonStandard,
+	}
+	for script, expected := range cases {
+		pkScript, err := hex.DecodeString(script)
+		require.Nil(t, err)
+		assert.Equal(t, expected, pkScriptType(pkScript), script)
+	}
+
+	pkScript, _ := hex.DecodeString("6a0b68656c6c6f20776f726c64")
+	assert.Equal(t, "hello world", nullDataMemo(pkScript))
+	pkScript, _ = hex.DecodeString("6a02ffff")
+	assert.Equal(t, "", nullDataMemo(pkScript))
+}
+
+func TestCreateUtxoTransactionMemoOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("memo key")
+	to := newTestKey("memo to")
+
+	vins := []*proto.Vin{{Hash: fmt.Sprintf("%064x", 1), Index: 0, Amount: 100000, Address: from.address}}
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  vins,
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 90000}},
+		Fee:   "10000",
+		Memo:  "invoice 42",
+	}
+	reply, err := adaptor.CreateUtxoTransaction(req)
+	require.Nil(t, err)
+
+	decoded, err := adaptor.QueryUtxoTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		RawData: reply.TxData,
+		Vins:    vins,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(decoded.Vouts))
+	assert.Equal(t, scriptTypeP2PKH, decoded.Vouts[0].ScriptType)
+	assert.Equal(t, "", decoded.Vouts[0].Memo)
+	memo := decoded.Vouts[1]
+	assert.Equal(t, scriptTypeNullData, memo.ScriptType)
+	assert.Equal(t, int64(0), memo.Amount)
+	assert.Equal(t, "", memo.Address)
+	assert.Equal(t, "invoice 42", memo.Memo)
+	assert.Equal(t, "6a0a696e766f696365203432", memo.Script)
+	assert.Equal(t, "10000", decoded.CostFee)
+
+	// the signature commits to the memo output
+	signed := signTx(t, reply.TxData, reply.SignHashes, []*testKey{from})
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: signed,
+		Vins:         vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	req.Memo = string(make([]byte, txscript.MaxDataCarrierSize+1))
+	_, err = adaptor.CreateUtxoTransaction(req)
+	assert.NotNil(t, err)
+}
+
+func TestCreateUtxoTransactionSigHashOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("sighash key")
+	other := newTestKey("sighash other")
+	to := newTestKey("sighash to")
+
+	vins := []*proto.Vin{{
+		Hash:        fmt.Sprintf("%064x", 1),
+		Amount:      60000,
+		Address:     from.address,
+		SighashType: uint32(txscript.SigHashSingle | txscript.SigHashAnyOneCanPay),
+	}}
+	reply, err := adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  vins,
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 50000}},
+		Fee:   "10000",
+	})
+	require.Nil(t, err)
+
+	signed, err := adaptor.CreateUtxoSignedTransaction(&proto.CreateUtxoSignedTransactionRequest{
+		Chain:      ChainName,
+		TxData:     reply.TxData,
+		Signatures: [][]byte{rsSignature(t, from.privKey, reply.SignHashes[0])},
+		PublicKeys: [][]byte{from.privKey.PubKey().SerializeCompressed()},
+		Vins:       vins,
+	})
+	require.Nil(t, err)
+
+	// another party adds an input and an output without invalidating the first signature
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(signed.SignedTxData)))
+	otherPkScript, err := adaptor.addressPkScript(other.address)
+	require.Nil(t, err)
+	otherHash, err := chainhash.NewHashFromStr(fmt.Sprintf("%064x", 2))
+	require.Nil(t, err)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(otherHash, 0), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(39000, otherPkScript))
+	signHash, err := txscript.CalcSignatureHash(otherPkScript, txscript.SigHashAll, &msgTx, 1)
+	require.Nil(t, err)
+	pkData, sigData := signOneVin(other.privKey, signHash, true)
+	msgTx.TxIn[1].SignatureScript, err = txscript.NewScriptBuilder().AddData(sigData).AddData(pkData).Script()
+	require.Nil(t, err)
+
+	var buf bytes.Buffer
+	require.Nil(t, msgTx.Serialize(&buf))
+	allVins := append(vins, &proto.Vin{Hash: otherHash.String(), Amount: 40000, Address: other.address})
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: buf.Bytes(),
+		Vins:         allVins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	decoded, err := adaptor.QueryUtxoTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		RawData: buf.Bytes(),
+		Vins:    allVins,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, reply.SignHashes[0], decoded.SignHashes[0])
+
+	vins[0].SighashType = 4
+	_, err = adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  vins,
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 50000}},
+		Fee:   "10000",
+	})
+	assert.NotNil(t, err)
+}
+
+func TestCreateUtxoSignedTransactionSigHashMockNode(t *testing.T) {
+	from := newTestKey("sighash online key")
+	to := newTestKey("sighash online to")
+	prevHash := fmt.Sprintf("%064x", 3)
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getrawtransaction": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &btcjson.TxRawResult{
+				Txid: prevHash,
+				Vout: []btcjson.Vout{{
+					Value:        0.0006,
+					ScriptPubKey: btcjson.ScriptPubKeyResult{Addresses: []string{from.address}},
+				}},
+			}, nil
+		},
+	})
+	defer server.Close()
+
+	hashType := txscript.SigHashSingle | txscript.SigHashAnyOneCanPay
+	vins := []*proto.Vin{{Hash: prevHash, Amount: 60000, Address: from.address, SighashType: uint32(hashType)}}
+	reply, err := adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins:  vins,
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 50000}},
+		Fee:   "10000",
+	})
+	require.Nil(t, err)
+
+	// the signer passes no vins, the sighash type is recovered from the signature
+	signed, err := adaptor.CreateUtxoSignedTransaction(&proto.CreateUtxoSignedTransactionRequest{
+		Chain:      ChainName,
+		TxData:     reply.TxData,
+		Signatures: [][]byte{rsSignature(t, from.privKey, reply.SignHashes[0])},
+		PublicKeys: [][]byte{from.privKey.PubKey().SerializeCompressed()},
+	})
+	require.Nil(t, err)
+
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(signed.SignedTxData)))
+	pushes, err := txscript.PushedData(msgTx.TxIn[0].SignatureScript)
+	require.Nil(t, err)
+	sig := pushes[0]
+	assert.Equal(t, byte(hashType), sig[len(sig)-1])
+
+	// a signature over none of the sighash types is rejected
+	_, err = adaptor.CreateUtxoSignedTransaction(&proto.CreateUtxoSignedTransactionRequest{
+		Chain:      ChainName,
+		TxData:     reply.TxData,
+		Signatures: [][]byte{rsSignature(t, from.privKey, make([]byte, 32))},
+		PublicKeys: [][]byte{from.privKey.PubKey().SerializeCompressed()},
+	})
+	assert.NotNil(t, err)
+}
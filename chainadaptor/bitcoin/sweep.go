@@ -0,0 +1,113 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// BuildSweepTransaction consolidates the utxos of addresses into destination,
+// split into as many unsigned transactions as the standard weight or max_inputs
+// requires
+func (a *ChainAdaptor) BuildSweepTransaction(req *proto.BuildSweepTransactionRequest) (*proto.BuildSweepTransactionReply, error) {
+	reply, err := a.buildSweepTransaction(req)
+	if err != nil {
+		log.Error("BuildSweepTransaction", "err", err)
+		return &proto.BuildSweepTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+	return reply, nil
+}
+
+func (a *ChainAdaptor) buildSweepTransaction(req *proto.BuildSweepTransactionRequest) (*proto.BuildSweepTransactionReply, error) {
+	if req.FeeRate == 0 {
+		return nil, errors.New("fee rate must be positive")
+	}
+	destPkScript, err := a.addressPkScript(req.Destination)
+	if err != nil {
+		return nil, fmt.Errorf("invalid destination: %v", err)
+	}
+	feeRate := int64(req.FeeRate)
+
+	utxos, err := a.candidateUtxos(req.Utxos, req.Addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	// an input that does not pay for its own spend only adds to the fee
+	var spendable, skipped []*proto.Vin
+	for _, utxo := range utxos {
+		if utxo.Amount <= feeRate*a.vinInputSize(utxo).vsize() {
+			skipped = append(skipped, utxo)
+			continue
+		}
+		spendable = append(spendable, utxo)
+	}
+	sort.SliceStable(spendable, func(i, j int) bool {
+		return spendable[i].Amount > spendable[j].Amount
+	})
+
+	maxInputs := maxSweepInputs(destPkScript)
+	if req.MaxInputs > 0 && int(req.MaxInputs) < maxInputs {
+		maxInputs = int(req.MaxInputs)
+	}
+
+	reply := &proto.BuildSweepTransactionReply{Code: proto.ReturnCode_SUCCESS}
+	for start := 0; start < len(spendable); start += maxInputs {
+		end := start + maxInputs
+		if end > len(spendable) {
+			end = len(spendable)
+		}
+		vins := spendable[start:end]
+
+		var total int64
+		for _, in := range vins {
+			total += in.Amount
+		}
+		vsize := estimateTxSize(a.vinInputSizes(vins), [][]byte{destPkScript})
+		fee := feeRate * vsize
+		// the smallest utxos come last, together they may not be worth an output
+		if total-fee < a.dustThreshold(destPkScript) {
+			skipped = append(skipped, vins...)
+			continue
+		}
+
+		vout := &proto.Vout{Address: req.Destination, Amount: total - fee, Index: 0}
+		txData, signHashes, err := a.buildUnsignedTx(vins, []*proto.Vout{vout}, req.Replaceable)
+		if err != nil {
+			return nil, err
+		}
+		reply.Txs = append(reply.Txs, &proto.SweepTransaction{
+			TxData:     txData,
+			SignHashes: signHashes,
+			Vins:       vins,
+			Vout:       vout,
+			Fee:        strconv.FormatInt(fee, 10),
+			Vsize:      vsize,
+		})
+	}
+	if len(reply.Txs) == 0 {
+		return nil, fmt.Errorf("none of %d utxos is worth sweeping at %d sat/vB", len(utxos), feeRate)
+	}
+	reply.Skipped = skipped
+	return reply, nil
+}
+
+// maxSweepInputs returns the number of p2pkh inputs a transaction paying to
+// destPkScript can spend within the standard weight
+func maxSweepInputs(destPkScript []byte) int {
+	maxSize := int64(maxStandardTxWeight / witnessScaleFactor)
+	n := int((maxSize - estimateTxSize(nil, [][]byte{destPkScript})) / p2pkhInputSize)
+	// the input count may need a longer varint than estimated for none
+	for n > 0 && estimateTxSize(p2pkhInputs(n), [][]byte{destPkScript}) > maxSize {
+		n--
+	}
+	return n
+}
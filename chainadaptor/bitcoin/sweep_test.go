@@ -0,0 +1,146 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestBuildSweepTransactionOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("sweep from")
+	to := newTestKey("sweep to")
+
+	var utxos []*proto.Vin
+	for i, amount := range []int64{1480, 30000, 2000, 1000, 50000, 40000, 20000} {
+		utxos = append(utxos, &proto.Vin{Hash: fmt.Sprintf("%064x", i+1), Amount: amount, Address: from.address})
+	}
+	reply, err := adaptor.BuildSweepTransaction(&proto.BuildSweepTransactionRequest{
+		Chain:       ChainName,
+		Utxos:       utxos,
+		Destination: to.address,
+		FeeRate:     10,
+		MaxInputs:   2,
+	})
+	require.Nil(t, err)
+
+	// 1480 and 1000 cost more than they bring at 10 sat/vB, 2000 alone can not pay its tx
+	require.Equal(t, 2, len(reply.Txs))
+	require.Equal(t, 3, len(reply.Skipped))
+	assert.Equal(t, []int64{1480, 1000, 2000}, []int64{reply.Skipped[0].Amount, reply.Skipped[1].Amount, reply.Skipped[2].Amount})
+
+	tx := reply.Txs[0]
+	require.Equal(t, 2, len(tx.Vins))
+	assert.Equal(t, int64(50000), tx.Vins[0].Amount)
+	assert.Equal(t, int64(40000), tx.Vins[1].Amount)
+	assert.Equal(t, int64(340), tx.Vsize)
+	assert.Equal(t, "3400", tx.Fee)
+	assert.Equal(t, int64(86600), tx.Vout.Amount)
+	assert.Equal(t, to.address, tx.Vout.Address)
+	assert.Equal(t, int64(30000+20000-3400), reply.Txs[1].Vout.Amount)
+
+	signed := signTx(t, tx.TxData, tx.SignHashes, []*testKey{from, from})
+	verifyReply, err := adaptor.VerifyUtxoSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		SignedTxData: signed,
+		Vins:         tx.Vins,
+	})
+	require.Nil(t, err)
+	assert.True(t, verifyReply.Verified)
+
+	_, err = adaptor.BuildSweepTransaction(&proto.BuildSweepTransactionRequest{
+		Chain:       ChainName,
+		Utxos:       utxos[:1],
+		Destination: to.address,
+		FeeRate:     10,
+	})
+	assert.NotNil(t, err)
+
+	// a p2wpkh utxo needs a sign hash the adaptor does not build
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(from.privKey.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	_, err = adaptor.BuildSweepTransaction(&proto.BuildSweepTransactionRequest{
+		Chain:       ChainName,
+		Utxos:       append(utxos[1:2:2], &proto.Vin{Hash: fmt.Sprintf("%064x", 8), Amount: 30000, Address: p2wpkh.EncodeAddress()}),
+		Destination: to.address,
+		FeeRate:     10,
+	})
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "p2wpkh inputs can not be signed")
+}
+
+func TestBuildSweepTransactionSkipsUnsignableMockNode(t *testing.T) {
+	from := newTestKey("sweep from")
+	to := newTestKey("sweep to")
+	p2wpkh, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(from.privKey.PubKey().SerializeCompressed()), &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	p2pkh, err := btcutil.DecodeAddress(from.address, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	p2pkhScript, err := txscript.PayToAddrScript(p2pkh)
+	require.Nil(t, err)
+	p2wpkhScript, err := txscript.PayToAddrScript(p2wpkh)
+	require.Nil(t, err)
+
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"scantxoutset": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return &ScanTxOutSetResult{
+				Success: true,
+				Height:  100,
+				Unspents: []ScanTxOutSetUnspent{
+					{Txid: fmt.Sprintf("%064x", 1), ScriptPubKey: hex.EncodeToString(p2pkhScript), Amount: 0.001, Height: 90},
+					{Txid: fmt.Sprintf("%064x", 2), ScriptPubKey: hex.EncodeToString(p2wpkhScript), Amount: 0.002, Height: 90},
+				},
+			}, nil
+		},
+	})
+	defer server.Close()
+
+	// the listed p2wpkh utxo is left out rather than signed with the wrong sign hash
+	reply, err := adaptor.BuildSweepTransaction(&proto.BuildSweepTransactionRequest{
+		Chain:       ChainName,
+		Addresses:   []string{from.address, p2wpkh.EncodeAddress()},
+		Destination: to.address,
+		FeeRate:     10,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reply.Txs))
+	require.Equal(t, 1, len(reply.Txs[0].Vins))
+	assert.Equal(t, from.address, reply.Txs[0].Vins[0].Address)
+}
+
+func TestBuildSweepTransactionWeightOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("sweep from")
+	to := newTestKey("sweep to")
+
+	var utxos []*proto.Vin
+	for i := 0; i < 1500; i++ {
+		utxos = append(utxos, &proto.Vin{Hash: fmt.Sprintf("%064x", i+1), Amount: 10000, Address: from.address})
+	}
+	reply, err := adaptor.BuildSweepTransaction(&proto.BuildSweepTransactionRequest{
+		Chain:       ChainName,
+		Utxos:       utxos,
+		Destination: to.address,
+		FeeRate:     1,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 3, len(reply.Txs))
+	assert.Equal(t, 0, len(reply.Skipped))
+	assert.Equal(t, 675, len(reply.Txs[0].Vins))
+	assert.Equal(t, 150, len(reply.Txs[2].Vins))
+	for _, tx := range reply.Txs {
+		assert.True(t, tx.Vsize*witnessScaleFactor <= maxStandardTxWeight)
+		assert.Equal(t, len(tx.Vins), len(tx.SignHashes))
+	}
+}
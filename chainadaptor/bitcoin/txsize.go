@@ -0,0 +1,195 @@
+package bitcoin
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	// version + lock time
+	txOverheadSize = 4 + 4
+	// outpoint + script length + <sig> <compressed pubkey> + sequence
+	p2pkhInputSize = 32 + 4 + 1 + 107 + 4
+	// spend size Bitcoin Core assumes for witness outputs when computing dust
+	witnessDustSpendSize = 32 + 4 + 1 + 107/4 + 4
+)
+
+// txOutSize returns the serialized size of an output paying to pkScript
+func txOutSize(pkScript []byte) int64 {
+	return int64(8 + wire.VarIntSerializeSize(uint64(len(pkScript))) + len(pkScript))
+}
+
+// estimateTxSize estimates the virtual size of a transaction spending inputs of
+// the given sizes to outputs with the given pkScripts, once all inputs are signed.
+func estimateTxSize(ins []inputSize, pkScripts [][]byte) int64 {
+	size := int64(txOverheadSize + wire.VarIntSerializeSize(uint64(len(ins))) + wire.VarIntSerializeSize(uint64(len(pkScripts))))
+	for _, pkScript := range pkScripts {
+		size += txOutSize(pkScript)
+	}
+	weight := size * witnessScaleFactor
+	var witnessInputs int
+	for _, in := range ins {
+		weight += in.base*witnessScaleFactor + in.witness
+		if in.witness > 0 {
+			witnessInputs++
+		}
+	}
+	if witnessInputs > 0 {
+		// segwit marker and flag, plus an empty witness for every non-witness input
+		weight += 2 + int64(len(ins)-witnessInputs)
+	}
+	return (weight + witnessScaleFactor - 1) / witnessScaleFactor
+}
+
+// p2pkhInputs returns the sizes of n p2pkh inputs
+func p2pkhInputs(n int) []inputSize {
+	ins := make([]inputSize, n)
+	for i := range ins {
+		ins[i] = inputSizes[scriptTypeP2PKH]
+	}
+	return ins
+}
+
+// dustThreshold mirrors Bitcoin Core's GetDustThreshold: an output is dust when
+// spending it costs more than a third of its value at the dust relay fee.
+// Chains with a fixed dust limit apply it instead.
+func (p *Policy) dustThreshold(pkScript []byte) int64 {
+	if txscript.IsUnspendable(pkScript) {
+		return 0
+	}
+	if p.DustLimit > 0 {
+		return p.DustLimit
+	}
+	spendSize := int64(p2pkhInputSize)
+	if txscript.IsWitnessProgram(pkScript) {
+		spendSize = witnessDustSpendSize
+	}
+	return (txOutSize(pkScript) + spendSize) * p.DustRelayFeeRate
+}
+
+const (
+	scriptTypeP2PKH      = "p2pkh"
+	scriptTypeP2SH       = "p2sh"
+	scriptTypeP2SHP2WPKH = "p2sh-p2wpkh"
+	scriptTypeP2WPKH     = "p2wpkh"
+	scriptTypeP2WSH      = "p2wsh"
+	scriptTypeP2TR       = "p2tr"
+
+	witnessScaleFactor = 4
+)
+
+// inputSize is the size of a signed input split into its non-witness and witness parts
+type inputSize struct {
+	base    int64
+	witness int64
+}
+
+// vsize returns the virtual size of the input
+func (s inputSize) vsize() int64 {
+	return s.base + (s.witness+witnessScaleFactor-1)/witnessScaleFactor
+}
+
+var inputSizes = map[string]inputSize{
+	scriptTypeP2PKH: {base: p2pkhInputSize},
+	// outpoint + <0 <20-byte hash>> + sequence, witness <sig> <compressed pubkey>
+	scriptTypeP2SHP2WPKH: {base: 32 + 4 + 1 + 23 + 4, witness: 1 + 1 + 72 + 1 + 33},
+	scriptTypeP2WPKH:     {base: 32 + 4 + 1 + 4, witness: 1 + 1 + 72 + 1 + 33},
+	// key path spend with a default sighash schnorr signature
+	scriptTypeP2TR: {base: 32 + 4 + 1 + 4, witness: 1 + 1 + 64},
+}
+
+var outputScriptSizes = map[string]int{
+	scriptTypeP2PKH:  25,
+	scriptTypeP2SH:   23,
+	scriptTypeP2WPKH: 22,
+	scriptTypeP2WSH:  34,
+	scriptTypeP2TR:   34,
+}
+
+// pkScriptInputSize returns the size of a signed input spending pkScript. A p2wsh
+// input is sized by its witnessScript. The redeem script of a p2sh output is not
+// known, it is taken for the p2sh-p2wpkh of segwit wallets on chains with segwit.
+// Other types are sized as p2pkh.
+func (a *ChainAdaptor) pkScriptInputSize(pkScript, witnessScript []byte) inputSize {
+	scriptType := pkScriptType(pkScript)
+	switch {
+	case scriptType == scriptTypeP2WSH && len(witnessScript) > 0:
+		return inputSize{
+			base:    unsignedInputSize,
+			witness: p2wshWitnessSize + int64(wire.VarIntSerializeSize(uint64(len(witnessScript)))+len(witnessScript)),
+		}
+	case scriptType == scriptTypeP2SH && a.chain.SegWit:
+		return inputSizes[scriptTypeP2SHP2WPKH]
+	}
+	if size, ok := inputSizes[scriptType]; ok {
+		return size
+	}
+	return inputSizes[scriptTypeP2PKH]
+}
+
+// vinInputSize returns the size of the input spending vin once signed, judged by
+// the script of the vin address
+func (a *ChainAdaptor) vinInputSize(vin *proto.Vin) inputSize {
+	pkScript, err := a.addressPkScript(vin.Address)
+	if err != nil {
+		return inputSizes[scriptTypeP2PKH]
+	}
+	size := a.pkScriptInputSize(pkScript, vin.WitnessScript)
+	if len(vin.WitnessScript) > 0 {
+		for _, branch := range vin.WitnessBranches {
+			size.witness += int64(1 + len(branchCondition(branch)))
+		}
+	}
+	return size
+}
+
+// vinInputSizes returns the input size of each of vins
+func (a *ChainAdaptor) vinInputSizes(vins []*proto.Vin) []inputSize {
+	sizes := make([]inputSize, len(vins))
+	for i, vin := range vins {
+		sizes[i] = a.vinInputSize(vin)
+	}
+	return sizes
+}
+
+// estimateVSize estimates the virtual size of a signed transaction spending
+// inputs of inputTypes to outputs of outputTypes
+func estimateVSize(inputTypes, outputTypes []string) (int64, error) {
+	weight := int64(txOverheadSize+wire.VarIntSerializeSize(uint64(len(inputTypes)))+wire.VarIntSerializeSize(uint64(len(outputTypes)))) * witnessScaleFactor
+
+	var witnessInputs int
+	for _, t := range inputTypes {
+		size, ok := inputSizes[t]
+		if !ok {
+			return 0, fmt.Errorf("unsupported input type %s", t)
+		}
+		weight += size.base*witnessScaleFactor + size.witness
+		if size.witness > 0 {
+			witnessInputs++
+		}
+	}
+
+	for _, t := range outputTypes {
+		size, ok := outputScriptSizes[t]
+		if !ok {
+			return 0, fmt.Errorf("unsupported output type %s", t)
+		}
+		weight += int64(8+wire.VarIntSerializeSize(uint64(size))+size) * witnessScaleFactor
+	}
+
+	if witnessInputs > 0 {
+		// segwit marker and flag, plus an empty witness for every non-witness input
+		weight += 2 + int64(len(inputTypes)-witnessInputs)
+	}
+	return (weight + witnessScaleFactor - 1) / witnessScaleFactor, nil
+}
+
+// txVSize returns the virtual size of a serialized transaction
+func txVSize(msgTx *wire.MsgTx) int64 {
+	weight := int64(msgTx.SerializeSizeStripped()*(witnessScaleFactor-1) + msgTx.SerializeSize())
+	return (weight + witnessScaleFactor - 1) / witnessScaleFactor
+}
@@ -0,0 +1,111 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// QueryBalance returns the confirmed balance of an address in satoshi
+func (a *ChainAdaptor) QueryBalance(req *proto.QueryBalanceRequest) (*proto.QueryBalanceReply, error) {
+	if req.BlockHeight != 0 {
+		err := errors.New("query balance at a specific height is not supported")
+		return &proto.QueryBalanceReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	utxos, _, err := a.listUnspent([]string{req.Address}, confirms)
+	if err != nil {
+		log.Error("QueryBalance listUnspent", "err", err)
+		return &proto.QueryBalanceReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	balance := big.NewInt(0)
+	for _, utxo := range utxos {
+		balance.Add(balance, big.NewInt(utxo.Amount))
+	}
+
+	return &proto.QueryBalanceReply{
+		Code:    proto.ReturnCode_SUCCESS,
+		Balance: balance.String(),
+	}, nil
+}
+
+// ListUtxos lists the unspent outputs of the given addresses
+func (a *ChainAdaptor) ListUtxos(req *proto.ListUtxosRequest) (*proto.ListUtxosReply, error) {
+	if len(req.Addresses) == 0 {
+		err := errors.New("no address in req")
+		return &proto.ListUtxosReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	utxos, height, err := a.listUnspent(req.Addresses, req.MinConf)
+	if err != nil {
+		log.Error("ListUtxos listUnspent", "err", err)
+		return &proto.ListUtxosReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, err
+	}
+
+	return &proto.ListUtxosReply{
+		Code:        proto.ReturnCode_SUCCESS,
+		Utxos:       utxos,
+		BlockHeight: uint64(height),
+	}, nil
+}
+
+// listUnspent scans the utxo set for the addresses and returns the outputs with
+// at least minConf confirmations, together with the height the scan was made at.
+func (a *ChainAdaptor) listUnspent(addresses []string, minConf uint64) ([]*proto.Utxo, int64, error) {
+	for _, address := range addresses {
+		addr, err := a.decodeAddress(address)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !addr.IsForNet(a.getClient().GetNetwork()) {
+			return nil, 0, errors.New("address is not valid for this network")
+		}
+	}
+
+	result, err := a.getClient().ListUnspent(addresses)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	utxos := make([]*proto.Utxo, 0, len(result.Unspents))
+	for _, unspent := range result.Unspents {
+		// backends list confirmed outputs only, so every output has at least one confirmation
+		confirmations := uint64(result.Height - unspent.Height + 1)
+		if confirmations < minConf {
+			continue
+		}
+
+		pkScript, err := hex.DecodeString(unspent.ScriptPubKey)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		utxos = append(utxos, &proto.Utxo{
+			Hash:          unspent.Txid,
+			Index:         unspent.Vout,
+			Amount:        btcToSatoshi(unspent.Amount).Int64(),
+			Address:       a.pkScriptAddress(pkScript),
+			ScriptPubKey:  unspent.ScriptPubKey,
+			BlockHeight:   uint64(unspent.Height),
+			Confirmations: confirmations,
+		})
+	}
+	return utxos, result.Height, nil
+}
@@ -0,0 +1,120 @@
+package bitcoin
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func scanTxOutSetHandler(t *testing.T, address string) mockHandler {
+	addr, err := btcutil.DecodeAddress(address, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	pkScript, err := txscript.PayToAddrScript(addr)
+	require.Nil(t, err)
+
+	return func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+		var descriptors []string
+		if err := json.Unmarshal(params[1], &descriptors); err != nil {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter, err.Error())
+		}
+		if len(descriptors) != 1 || descriptors[0] != "addr("+address+")" {
+			return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidParameter, "unexpected descriptors")
+		}
+		return &ScanTxOutSetResult{
+			Success: true,
+			Height:  100,
+			Unspents: []ScanTxOutSetUnspent{
+				{
+					Txid:         "c2247fb66cf44652f27552b052a7d359d48a1c8e90a50651f6104a441041963f",
+					Vout:         0,
+					ScriptPubKey: hex.EncodeToString(pkScript),
+					Amount:       0.0001,
+					Height:       90,
+				},
+				{
+					Txid:         "d4b9d1f4cd8ebb6e25ca3fd7fc4d4a45ea1d1e72ad9aa49396a3c5b5fdd9d5bd",
+					Vout:         2,
+					ScriptPubKey: hex.EncodeToString(pkScript),
+					Amount:       0.5,
+					Height:       100,
+				},
+			},
+			TotalAmount: 0.5001,
+		}, nil
+	}
+}
+
+func TestListUtxosMockNode(t *testing.T) {
+	address := testnetAddrs[0]
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"scantxoutset": scanTxOutSetHandler(t, address),
+	})
+	defer server.Close()
+
+	reply, err := adaptor.ListUtxos(&proto.ListUtxosRequest{
+		Chain:     ChainName,
+		Addresses: []string{address},
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, uint64(100), reply.BlockHeight)
+	require.Equal(t, 2, len(reply.Utxos))
+	assert.Equal(t, int64(10000), reply.Utxos[0].Amount)
+	assert.Equal(t, address, reply.Utxos[0].Address)
+	assert.Equal(t, uint64(11), reply.Utxos[0].Confirmations)
+	assert.Equal(t, uint32(2), reply.Utxos[1].Index)
+	assert.Equal(t, uint64(1), reply.Utxos[1].Confirmations)
+
+	reply, err = adaptor.ListUtxos(&proto.ListUtxosRequest{
+		Chain:     ChainName,
+		Addresses: []string{address},
+		MinConf:   6,
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(reply.Utxos))
+	assert.Equal(t, int64(10000), reply.Utxos[0].Amount)
+
+	// mainnet address on testnet
+	reply, err = adaptor.ListUtxos(&proto.ListUtxosRequest{
+		Chain:     ChainName,
+		Addresses: []string{mainnetAddrs[0]},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, reply.Code)
+}
+
+func TestQueryBalanceMockNode(t *testing.T) {
+	address := testnetAddrs[0]
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"scantxoutset": scanTxOutSetHandler(t, address),
+	})
+	defer server.Close()
+
+	reply, err := adaptor.QueryBalance(&proto.QueryBalanceRequest{
+		Chain:   ChainName,
+		Symbol:  Symbol,
+		Address: address,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, "50010000", reply.Balance)
+
+	reply, err = adaptor.QueryBalance(&proto.QueryBalanceRequest{
+		Chain:       ChainName,
+		Symbol:      Symbol,
+		Address:     address,
+		BlockHeight: 90,
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, reply.Code)
+}
@@ -0,0 +1,88 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// witnessSignHash computes the BIP143 sign hash of a p2wsh input spent through
+// its witness script, e.g. a CSV or CLTV locked recovery path
+func (a *ChainAdaptor) witnessSignHash(rawTx *wire.MsgTx, sigHashes *txscript.TxSigHashes, vin *proto.Vin, index int) ([]byte, error) {
+	if !a.chain.SegWit {
+		return nil, fmt.Errorf("%s has no segwit", a.chain.Name)
+	}
+	addr, err := a.decodeAddress(vin.Address)
+	if err != nil {
+		return nil, err
+	}
+	scriptHash := sha256.Sum256(vin.WitnessScript)
+	if _, ok := addr.(*btcutil.AddressWitnessScriptHash); !ok || !bytes.Equal(addr.ScriptAddress(), scriptHash[:]) {
+		return nil, errors.New("witness script does not match the vin address")
+	}
+
+	hasBranches, err := scriptHasBranches(vin.WitnessScript)
+	if err != nil {
+		return nil, err
+	}
+	if hasBranches && len(vin.WitnessBranches) == 0 {
+		return nil, errors.New("witness script has branches, witness branches must select one")
+	}
+	if !hasBranches && len(vin.WitnessBranches) > 0 {
+		return nil, errors.New("witness branches given for a witness script without branches")
+	}
+
+	hashType, err := vinSigHashType(vin, rawTx, index)
+	if err != nil {
+		return nil, err
+	}
+	return txscript.CalcWitnessSigHash(vin.WitnessScript, sigHashes, hashType, rawTx, index, vin.Amount)
+}
+
+// witnessScriptStack returns the witness spending a single key witness script.
+// The public key is only pushed if the script does not carry it, as in a key
+// hash check. The branch conditions go on top, the first one executed last.
+func witnessScriptStack(sig, pkData, witnessScript []byte, branches []bool) wire.TxWitness {
+	witness := wire.TxWitness{sig, pkData}
+	pushes, _ := txscript.PushedData(witnessScript)
+	for _, push := range pushes {
+		if bytes.Equal(push, pkData) {
+			witness = witness[:1]
+			break
+		}
+	}
+	for i := len(branches) - 1; i >= 0; i-- {
+		witness = append(witness, branchCondition(branches[i]))
+	}
+	return append(witness, witnessScript)
+}
+
+// branchCondition returns the minimal witness element selecting a branch
+func branchCondition(branch bool) []byte {
+	if branch {
+		return []byte{1}
+	}
+	return []byte{}
+}
+
+// scriptHasBranches reports whether script has an OP_IF or OP_NOTIF
+func scriptHasBranches(script []byte) (bool, error) {
+	disasm, err := txscript.DisasmString(script)
+	if err != nil {
+		return false, err
+	}
+	for _, op := range strings.Fields(disasm) {
+		if op == "OP_IF" || op == "OP_NOTIF" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
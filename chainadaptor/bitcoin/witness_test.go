@@ -0,0 +1,200 @@
+package bitcoin
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func p2wshAddress(t *testing.T, witnessScript []byte) string {
+	scriptHash := sha256.Sum256(witnessScript)
+	addr, err := btcutil.NewAddressWitnessScriptHash(scriptHash[:], &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	return addr.EncodeAddress()
+}
+
+// createAndSign creates the transaction and assembles it with the signatures of key
+func createAndSign(t *testing.T, adaptor *ChainAdaptor, req *proto.CreateUtxoTransactionRequest, key *testKey) (*wire.MsgTx, error) {
+	reply, err := adaptor.CreateUtxoTransaction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	signReq := &proto.CreateUtxoSignedTransactionRequest{
+		Chain:  ChainName,
+		TxData: reply.TxData,
+		Vins:   req.Vins,
+	}
+	for _, signHash := range reply.SignHashes {
+		signReq.Signatures = append(signReq.Signatures, rsSignature(t, key.privKey, signHash))
+		signReq.PublicKeys = append(signReq.PublicKeys, key.privKey.PubKey().SerializeCompressed())
+	}
+	signed, err := adaptor.CreateUtxoSignedTransaction(signReq)
+	if err != nil {
+		return nil, err
+	}
+
+	var msgTx wire.MsgTx
+	require.Nil(t, msgTx.Deserialize(bytes.NewReader(signed.SignedTxData)))
+	return &msgTx, nil
+}
+
+func TestSpendCSVLockedWitnessScriptOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	key := newTestKey("vault recovery key")
+	to := newTestKey("vault to")
+
+	// the recovery key can spend 144 blocks after the output confirmed
+	witnessScript, err := txscript.NewScriptBuilder().AddInt64(144).AddOp(txscript.OP_CHECKSEQUENCEVERIFY).AddOp(txscript.OP_DROP).
+		AddData(key.privKey.PubKey().SerializeCompressed()).AddOp(txscript.OP_CHECKSIG).Script()
+	require.Nil(t, err)
+
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins: []*proto.Vin{{
+			Hash:          fmt.Sprintf("%064x", 1),
+			Amount:        100000,
+			Address:       p2wshAddress(t, witnessScript),
+			Sequence:      144,
+			WitnessScript: witnessScript,
+		}},
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 99000}},
+		Fee:   "1000",
+	}
+	msgTx, err := createAndSign(t, adaptor, req, key)
+	require.Nil(t, err)
+	assert.Equal(t, int32(2), msgTx.Version)
+	assert.Equal(t, uint32(144), msgTx.TxIn[0].Sequence)
+	assert.Equal(t, 0, len(msgTx.TxIn[0].SignatureScript))
+	require.Equal(t, 2, len(msgTx.TxIn[0].Witness))
+	assert.Equal(t, witnessScript, []byte(msgTx.TxIn[0].Witness[1]))
+
+	// the relative lock is not over yet
+	req.Vins[0].Sequence = 143
+	_, err = createAndSign(t, adaptor, req, key)
+	assert.NotNil(t, err)
+
+	req.Vins[0].Sequence = 144
+	req.Vins[0].Address = to.address
+	_, err = adaptor.CreateUtxoTransaction(req)
+	assert.NotNil(t, err)
+}
+
+func TestSpendCLTVLockedWitnessScriptOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	key := newTestKey("cltv key")
+	to := newTestKey("cltv to")
+
+	// a key hash check keeps the public key out of the script
+	witnessScript, err := txscript.NewScriptBuilder().AddInt64(500000).AddOp(txscript.OP_CHECKLOCKTIMEVERIFY).AddOp(txscript.OP_DROP).
+		AddOp(txscript.OP_DUP).AddOp(txscript.OP_HASH160).AddData(btcutil.Hash160(key.privKey.PubKey().SerializeCompressed())).
+		AddOp(txscript.OP_EQUALVERIFY).AddOp(txscript.OP_CHECKSIG).Script()
+	require.Nil(t, err)
+
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins: []*proto.Vin{{
+			Hash:          fmt.Sprintf("%064x", 1),
+			Amount:        100000,
+			Address:       p2wshAddress(t, witnessScript),
+			WitnessScript: witnessScript,
+		}},
+		Vouts:    []*proto.Vout{{Address: to.address, Amount: 99000}},
+		Fee:      "1000",
+		LockTime: 500001,
+	}
+	msgTx, err := createAndSign(t, adaptor, req, key)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(500001), msgTx.LockTime)
+	assert.Equal(t, uint32(wire.MaxTxInSequenceNum-1), msgTx.TxIn[0].Sequence)
+	assert.Equal(t, int32(wire.TxVersion), msgTx.Version)
+	assert.Equal(t, 3, len(msgTx.TxIn[0].Witness))
+
+	req.LockTime = 499999
+	_, err = createAndSign(t, adaptor, req, key)
+	assert.NotNil(t, err)
+}
+
+func TestLockTimeOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	from := newTestKey("locktime key")
+	to := newTestKey("locktime to")
+
+	// anti fee sniping: only valid in the block after the current tip
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins: []*proto.Vin{
+			{Hash: fmt.Sprintf("%064x", 1), Amount: 50000, Address: from.address},
+			{Hash: fmt.Sprintf("%064x", 2), Amount: 50000, Address: from.address, Sequence: 7},
+		},
+		Vouts:       []*proto.Vout{{Address: to.address, Amount: 99000}},
+		Fee:         "1000",
+		LockTime:    1800000,
+		Replaceable: true,
+	}
+	msgTx, err := createAndSign(t, adaptor, req, from)
+	require.Nil(t, err)
+	assert.Equal(t, uint32(1800000), msgTx.LockTime)
+	assert.Equal(t, uint32(maxRBFSequence), msgTx.TxIn[0].Sequence)
+	assert.Equal(t, uint32(7), msgTx.TxIn[1].Sequence)
+	assert.Equal(t, int32(2), msgTx.Version)
+}
+
+func TestSpendWitnessScriptBranchOffline(t *testing.T) {
+	adaptor := newChainAdaptorWithClients([]*btcClient{newLocalBtcClient(config.TestNet)})
+	hot := newTestKey("branch hot key")
+	recovery := newTestKey("branch recovery key")
+	to := newTestKey("branch to")
+
+	// the hot key spends at any time, the recovery key 144 blocks after the output confirmed
+	witnessScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_IF).AddData(hot.privKey.PubKey().SerializeCompressed()).
+		AddOp(txscript.OP_ELSE).AddInt64(144).AddOp(txscript.OP_CHECKSEQUENCEVERIFY).AddOp(txscript.OP_DROP).
+		AddData(recovery.privKey.PubKey().SerializeCompressed()).
+		AddOp(txscript.OP_ENDIF).AddOp(txscript.OP_CHECKSIG).Script()
+	require.Nil(t, err)
+
+	req := &proto.CreateUtxoTransactionRequest{
+		Chain: ChainName,
+		Vins: []*proto.Vin{{
+			Hash:          fmt.Sprintf("%064x", 1),
+			Amount:        100000,
+			Address:       p2wshAddress(t, witnessScript),
+			WitnessScript: witnessScript,
+		}},
+		Vouts: []*proto.Vout{{Address: to.address, Amount: 99000}},
+		Fee:   "1000",
+	}
+	// the branch to spend through has to be selected
+	_, err = adaptor.CreateUtxoTransaction(req)
+	assert.NotNil(t, err)
+
+	req.Vins[0].WitnessBranches = []bool{true}
+	msgTx, err := createAndSign(t, adaptor, req, hot)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(msgTx.TxIn[0].Witness))
+	assert.Equal(t, []byte{1}, []byte(msgTx.TxIn[0].Witness[1]))
+
+	req.Vins[0].WitnessBranches = []bool{false}
+	req.Vins[0].Sequence = 144
+	msgTx, err = createAndSign(t, adaptor, req, recovery)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(msgTx.TxIn[0].Witness))
+	assert.Equal(t, 0, len(msgTx.TxIn[0].Witness[1]))
+
+	// the recovery key does not open the hot branch
+	req.Vins[0].WitnessBranches = []bool{true}
+	_, err = createAndSign(t, adaptor, req, recovery)
+	assert.NotNil(t, err)
+}
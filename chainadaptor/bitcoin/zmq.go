@@ -0,0 +1,223 @@
+package bitcoin
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	zmtpFlagMore    = 0x01
+	zmtpFlagLong    = 0x02
+	zmtpFlagCommand = 0x04
+
+	// zmtpMaxFrame bounds the frames read, a raw tx is at most 4MB
+	zmtpMaxFrame = 8 << 20
+
+	zmqDialTimeout = 10 * time.Second
+	// zmqKeepAlive is the tcp keep-alive period, which finds a dead publisher
+	// while no notification is due
+	zmqKeepAlive = 30 * time.Second
+)
+
+// zmtpConn is a connection speaking ZMTP 3.0 with the NULL security mechanism,
+// which is all the zmq publishers of bitcoind offer
+type zmtpConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// dialZMQ connects a SUB socket to the tcp endpoint of a publisher and
+// subscribes to the topics
+func dialZMQ(endpoint string, topics []string) (*zmtpConn, error) {
+	address := strings.TrimPrefix(endpoint, "tcp://")
+	if address == endpoint {
+		return nil, fmt.Errorf("unsupported zmq endpoint %s, only tcp is", endpoint)
+	}
+	dialer := net.Dialer{Timeout: zmqDialTimeout, KeepAlive: zmqKeepAlive}
+	conn, err := dialer.Dial("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &zmtpConn{conn: conn, reader: bufio.NewReader(conn)}
+	_ = conn.SetDeadline(time.Now().Add(zmqDialTimeout))
+	peerType, err := c.handshake("SUB")
+	if err == nil && peerType != "PUB" && peerType != "XPUB" {
+		err = fmt.Errorf("zmq peer is a %s socket, not a publisher", peerType)
+	}
+	for _, topic := range topics {
+		if err != nil {
+			break
+		}
+		// ZMTP 3.0 subscribes with a message of 1 and the topic
+		err = c.writeFrame(0, append([]byte{1}, topic...))
+	}
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return c, nil
+}
+
+// handshake exchanges the greeting and the READY command with the peer and
+// returns the socket type of the peer
+func (c *zmtpConn) handshake(socketType string) (string, error) {
+	greeting := make([]byte, 64)
+	greeting[0], greeting[9] = 0xff, 0x7f
+	greeting[10], greeting[11] = 3, 0
+	copy(greeting[12:32], "NULL")
+	if _, err := c.conn.Write(greeting); err != nil {
+		return "", err
+	}
+
+	peer := make([]byte, 64)
+	if _, err := io.ReadFull(c.reader, peer); err != nil {
+		return "", err
+	}
+	if peer[0] != 0xff || peer[9] != 0x7f {
+		return "", errors.New("zmq peer sent no ZMTP greeting")
+	}
+	if peer[10] < 3 {
+		return "", fmt.Errorf("unsupported ZMTP version %d.%d", peer[10], peer[11])
+	}
+	if mechanism := strings.TrimRight(string(peer[12:32]), "\x00"); mechanism != "NULL" {
+		return "", fmt.Errorf("unsupported zmq security mechanism %s", mechanism)
+	}
+
+	if err := c.writeCommand("READY", "Socket-Type", socketType); err != nil {
+		return "", err
+	}
+	flags, body, err := c.readFrame()
+	if err != nil {
+		return "", err
+	}
+	name, props, err := parseZMTPCommand(flags, body)
+	if err != nil {
+		return "", err
+	}
+	switch name {
+	case "READY":
+		return props["Socket-Type"], nil
+	case "ERROR":
+		return "", fmt.Errorf("zmq peer refused the connection: %s", props[""])
+	}
+	return "", fmt.Errorf("unexpected zmq command %s", name)
+}
+
+// writeCommand writes a command with its properties given as name and value pairs
+func (c *zmtpConn) writeCommand(name string, props ...string) error {
+	body := append([]byte{byte(len(name))}, name...)
+	for i := 0; i+1 < len(props); i += 2 {
+		body = append(body, byte(len(props[i])))
+		body = append(body, props[i]...)
+		size := make([]byte, 4)
+		binary.BigEndian.PutUint32(size, uint32(len(props[i+1])))
+		body = append(body, size...)
+		body = append(body, props[i+1]...)
+	}
+	return c.writeFrame(zmtpFlagCommand, body)
+}
+
+// parseZMTPCommand returns the name and the properties of a command frame, the
+// reason of an ERROR is the property with the empty name
+func parseZMTPCommand(flags byte, body []byte) (string, map[string]string, error) {
+	if flags&zmtpFlagCommand == 0 || len(body) == 0 || len(body) < 1+int(body[0]) {
+		return "", nil, errors.New("invalid zmq command")
+	}
+	name := string(body[1 : 1+body[0]])
+	body = body[1+body[0]:]
+
+	props := make(map[string]string)
+	if name == "ERROR" {
+		if len(body) > 0 && len(body) >= 1+int(body[0]) {
+			props[""] = string(body[1 : 1+body[0]])
+		}
+		return name, props, nil
+	}
+	for len(body) > 0 {
+		if len(body) < 1+int(body[0])+4 {
+			return "", nil, errors.New("invalid zmq command property")
+		}
+		key := string(body[1 : 1+body[0]])
+		body = body[1+body[0]:]
+		size := binary.BigEndian.Uint32(body)
+		body = body[4:]
+		if uint32(len(body)) < size {
+			return "", nil, errors.New("invalid zmq command property")
+		}
+		props[key] = string(body[:size])
+		body = body[size:]
+	}
+	return name, props, nil
+}
+
+func (c *zmtpConn) writeFrame(flags byte, body []byte) error {
+	var header []byte
+	if len(body) > 0xff {
+		header = make([]byte, 9)
+		header[0] = flags | zmtpFlagLong
+		binary.BigEndian.PutUint64(header[1:], uint64(len(body)))
+	} else {
+		header = []byte{flags, byte(len(body))}
+	}
+	_, err := c.conn.Write(append(header, body...))
+	return err
+}
+
+func (c *zmtpConn) readFrame() (byte, []byte, error) {
+	flags, err := c.reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	var size uint64
+	if flags&zmtpFlagLong != 0 {
+		var b [8]byte
+		if _, err := io.ReadFull(c.reader, b[:]); err != nil {
+			return 0, nil, err
+		}
+		size = binary.BigEndian.Uint64(b[:])
+	} else {
+		b, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		size = uint64(b)
+	}
+	if size > zmtpMaxFrame {
+		return 0, nil, fmt.Errorf("zmq frame of %d bytes is too large", size)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(c.reader, body); err != nil {
+		return 0, nil, err
+	}
+	return flags, body, nil
+}
+
+// receive returns the frames of the next message, skipping any command
+func (c *zmtpConn) receive() ([][]byte, error) {
+	var frames [][]byte
+	for {
+		flags, body, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if flags&zmtpFlagCommand != 0 {
+			continue
+		}
+		frames = append(frames, body)
+		if flags&zmtpFlagMore == 0 {
+			return frames, nil
+		}
+	}
+}
+
+func (c *zmtpConn) close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,186 @@
+package bitcoin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// acceptSubscriber accepts a subscriber on the listener as a zmq publisher and
+// checks the topics it subscribes to
+func acceptSubscriber(t *testing.T, listener net.Listener, topics ...string) *zmtpConn {
+	conn, err := listener.Accept()
+	require.Nil(t, err)
+	pub := &zmtpConn{conn: conn, reader: bufio.NewReader(conn)}
+	peerType, err := pub.handshake("PUB")
+	require.Nil(t, err)
+	require.Equal(t, "SUB", peerType)
+	for _, topic := range topics {
+		_, body, err := pub.readFrame()
+		require.Nil(t, err)
+		require.Equal(t, append([]byte{1}, topic...), body)
+	}
+	return pub
+}
+
+func (c *zmtpConn) publish(t *testing.T, topic string, body []byte, sequence uint32) {
+	seq := make([]byte, 4)
+	binary.LittleEndian.PutUint32(seq, sequence)
+	require.Nil(t, c.writeFrame(zmtpFlagMore, []byte(topic)))
+	require.Nil(t, c.writeFrame(zmtpFlagMore, body))
+	require.Nil(t, c.writeFrame(0, seq))
+}
+
+func testPaymentTx(t *testing.T, prevIndex uint32, pkScript []byte) ([]byte, string) {
+	prevHash, err := chainhash.NewHashFromStr(fmt.Sprintf("%064x", 1))
+	require.Nil(t, err)
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, prevIndex), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(10000, pkScript))
+	var buf bytes.Buffer
+	require.Nil(t, msgTx.Serialize(&buf))
+	return buf.Bytes(), msgTx.TxHash().String()
+}
+
+func TestZMQNotificationsMockNode(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet).(*ChainAdaptor)
+	watched, err := adaptor.addressPkScript(testnetAddrs[0])
+	require.Nil(t, err)
+	other, err := adaptor.addressPkScript(testnetAddrs[1])
+	require.Nil(t, err)
+
+	otherTx, _ := testPaymentTx(t, 0, other)
+	txA, hashA := testPaymentTx(t, 1, watched)
+	txC, _ := testPaymentTx(t, 2, watched)
+	txD, hashD := testPaymentTx(t, 3, watched)
+	blockHash, err := chainhash.NewHashFromStr(fmt.Sprintf("%064x", 0xb10c))
+	require.Nil(t, err)
+
+	adaptor, server := newMockChainAdaptor(t, config.TestNet, map[string]mockHandler{
+		"getblockcount": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			return 100, nil
+		},
+		"getblockheader": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var hash string
+			if json.Unmarshal(params[0], &hash) != nil || hash != blockHash.String() {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCBlockNotFound, "Block not found")
+			}
+			return &btcjson.GetBlockHeaderVerboseResult{Hash: hash, Height: 101}, nil
+		},
+		"getmempoolentry": func(params []json.RawMessage) (interface{}, *btcjson.RPCError) {
+			var txid string
+			if json.Unmarshal(params[0], &txid) != nil || (txid != hashA && txid != hashD) {
+				return nil, btcjson.NewRPCError(btcjson.ErrRPCInvalidAddressOrKey, "Transaction not in mempool")
+			}
+			return &MempoolEntryResult{VSize: 100, Fees: MempoolEntryFees{Base: 0.000002}}, nil
+		},
+		"getrawtransaction": func([]json.RawMessage) (interface{}, *btcjson.RPCError) {
+			vout := btcjson.Vout{Value: 0.0001, ScriptPubKey: btcjson.ScriptPubKeyResult{Addresses: []string{testnetAddrs[1]}}}
+			return &btcjson.TxRawResult{Txid: fmt.Sprintf("%064x", 1), Vout: []btcjson.Vout{vout, vout, vout, vout}}, nil
+		},
+	})
+	defer server.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	defer listener.Close()
+	endpoint := "tcp://" + listener.Addr().String()
+
+	node := adaptor.getClient().(*btcClient)
+	node.notifier = newZMQNotifier(&config.RPC{ZMQHashBlock: endpoint, ZMQRawTx: endpoint}, node)
+	// the head outlives a slow block
+	assert.Equal(t, 30*time.Minute, node.notifier.silence)
+	adaptor.notifiers = []*zmqNotifier{node.notifier}
+	done := make(chan error, 1)
+	go func() {
+		done <- node.notifier.listen(endpoint, node.notifier.endpoints[endpoint])
+	}()
+	pub := acceptSubscriber(t, listener, zmqTopicHashBlock, zmqTopicRawTx)
+
+	// the head follows the announced blocks without polling
+	require.Eventually(t, func() bool {
+		_, ok := node.notifier.head()
+		return ok
+	}, time.Second, 10*time.Millisecond)
+	reversed := make([]byte, chainhash.HashSize)
+	for i := range reversed {
+		reversed[i] = blockHash[chainhash.HashSize-1-i]
+	}
+	pub.publish(t, zmqTopicHashBlock, reversed, 0)
+	require.Eventually(t, func() bool {
+		height, err := adaptor.GetLatestBlockHeight()
+		return err == nil && height == 101
+	}, time.Second, 10*time.Millisecond)
+
+	quit := make(chan struct{})
+	replyCh := make(chan *proto.QueryUtxoTransactionReply)
+	errCh := make(chan error, 1)
+	go adaptor.SubscribeUtxoMempool([]string{testnetAddrs[0]}, quit, replyCh, errCh)
+	defer close(quit)
+	require.Eventually(t, func() bool {
+		node.notifier.lock.Lock()
+		defer node.notifier.lock.Unlock()
+		return len(node.notifier.txSubs) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// txC pays the watched address but is not in the mempool, txA is announced twice
+	for i, rawTx := range [][]byte{otherTx, txA, txA, txC, txD} {
+		pub.publish(t, zmqTopicRawTx, rawTx, uint32(i))
+	}
+	for _, hash := range []string{hashA, hashD} {
+		select {
+		case reply := <-replyCh:
+			assert.Equal(t, hash, reply.TxHash)
+			assert.Equal(t, proto.TxStatus_Pending, reply.TxStatus)
+			require.NotNil(t, reply.Mempool)
+			assert.Equal(t, "200", reply.Mempool.Fee)
+			require.Equal(t, 1, len(reply.Vouts))
+			assert.Equal(t, testnetAddrs[0], reply.Vouts[0].Address)
+			assert.Equal(t, "0", reply.CostFee)
+		case err := <-errCh:
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatal("no mempool notification of", hash)
+		}
+	}
+
+	// the head is polled once the notifications are silent or lost
+	node.notifier.heard.Store(time.Now().Add(-2 * node.notifier.silence).UnixNano())
+	height, err := adaptor.GetLatestBlockHeight()
+	require.Nil(t, err)
+	assert.Equal(t, int64(100), height)
+
+	pub.close()
+	select {
+	case err := <-done:
+		assert.NotNil(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("the notifier did not see the publisher go")
+	}
+	_, ok := node.notifier.head()
+	assert.False(t, ok)
+}
+
+func TestSubscribeUtxoMempoolWithoutZMQ(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet)
+	errCh := make(chan error, 1)
+	adaptor.SubscribeUtxoMempool([]string{testnetAddrs[0]}, make(chan struct{}), nil, errCh)
+	assert.Equal(t, errNoZMQ, <-errCh)
+
+	adaptor.SubscribeUtxoMempool([]string{"not an address"}, make(chan struct{}), nil, errCh)
+	assert.NotNil(t, <-errCh)
+}
@@ -10,7 +10,14 @@ type ChainAdaptor interface {
 	QueryBalance(req *proto.QueryBalanceRequest) (*proto.QueryBalanceReply, error)
 	QueryNonce(req *proto.QueryNonceRequest) (*proto.QueryNonceReply, error)
 	QueryGasPrice(req *proto.QueryGasPriceRequest) (*proto.QueryGasPriceReply, error)
+	EstimateUtxoFee(req *proto.EstimateUtxoFeeRequest) (*proto.EstimateUtxoFeeReply, error)
 	CreateUtxoTransaction(req *proto.CreateUtxoTransactionRequest) (*proto.CreateUtxoTransactionReply, error)
+	BuildUtxoTransaction(req *proto.BuildUtxoTransactionRequest) (*proto.BuildUtxoTransactionReply, error)
+	BumpUtxoFee(req *proto.BumpUtxoFeeRequest) (*proto.BumpUtxoFeeReply, error)
+	BuildCpfpTransaction(req *proto.BuildCpfpTransactionRequest) (*proto.BuildCpfpTransactionReply, error)
+	BuildSweepTransaction(req *proto.BuildSweepTransactionRequest) (*proto.BuildSweepTransactionReply, error)
+	BuildPayoutBatch(req *proto.BuildPayoutBatchRequest) (*proto.BuildPayoutBatchReply, error)
+	CheckDoubleSpend(req *proto.CheckDoubleSpendRequest) (*proto.CheckDoubleSpendReply, error)
 	CreateAccountTransaction(req *proto.CreateAccountTransactionRequest) (*proto.CreateAccountTransactionReply, error)
 	CreateUtxoSignedTransaction(req *proto.CreateUtxoSignedTransactionRequest) (*proto.CreateSignedTransactionReply, error)
 	CreateAccountSignedTransaction(req *proto.CreateAccountSignedTransactionRequest) (*proto.CreateSignedTransactionReply, error)
@@ -19,13 +26,18 @@ type ChainAdaptor interface {
 	QueryUtxoTransactionFromData(req *proto.QueryTransactionFromDataRequest) (*proto.QueryUtxoTransactionReply, error)
 	QueryUtxoTransactionFromSignedData(req *proto.QueryTransactionFromSignedDataRequest) (*proto.QueryUtxoTransactionReply, error)
 	BroadcastTransaction(req *proto.BroadcastTransactionRequest) (*proto.BroadcastTransactionReply, error)
+	DryRunBroadcast(req *proto.BroadcastTransactionRequest) (*proto.DryRunBroadcastReply, error)
 	QueryUtxo(req *proto.QueryUtxoRequest) (*proto.QueryUtxoReply, error)
 	QueryUtxoInsFromData(req *proto.QueryUtxoInsFromDataRequest) (*proto.QueryUtxoInsReply, error)
+	ListUtxos(req *proto.ListUtxosRequest) (*proto.ListUtxosReply, error)
 	QueryUtxoTransaction(req *proto.QueryTransactionRequest) (*proto.QueryUtxoTransactionReply, error)
 	QueryAccountTransaction(req *proto.QueryTransactionRequest) (*proto.QueryAccountTransactionReply, error)
 	VerifyAccountSignedTransaction(req *proto.VerifySignedTransactionRequest) (*proto.VerifySignedTransactionReply, error)
 	VerifyUtxoSignedTransaction(req *proto.VerifySignedTransactionRequest) (*proto.VerifySignedTransactionReply, error)
+	GetTxInclusionProof(req *proto.GetTxInclusionProofRequest) (*proto.GetTxInclusionProofReply, error)
+	VerifyTxInclusionProof(req *proto.VerifyTxInclusionProofRequest) (*proto.VerifyTxInclusionProofReply, error)
 	GetLatestBlockHeight() (int64, error)
 	GetAccountTransactionByHeight(height int64, replyCh chan *proto.QueryAccountTransactionReply, errCh chan error)
 	GetUtxoTransactionByHeight(height int64, replyCh chan *proto.QueryUtxoTransactionReply, errCh chan error)
+	SubscribeUtxoMempool(addresses []string, quit <-chan struct{}, replyCh chan *proto.QueryUtxoTransactionReply, errCh chan error)
 }
@@ -0,0 +1,147 @@
+package doge
+
+import (
+	"time"
+
+	"errors"
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+const (
+	ChainName = "doge"
+	Symbol    = "doge"
+
+	// versionAuxPow flags the headers of merged mined blocks
+	versionAuxPow = 1 << 8
+)
+
+var (
+	bigOne = big.NewInt(1)
+	// mainPowLimit is 2^236 - 1, the highest target of mainnet and testnet
+	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+	// regTestPowLimit is 2^255 - 1
+	regTestPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
+)
+
+// MainNetParams are the parameters of the dogecoin main network
+var MainNetParams = chaincfg.Params{
+	Name:        "mainnet",
+	Net:         wire.BitcoinNet(0xc0c0c0c0),
+	DefaultPort: "22556",
+
+	TargetTimePerBlock: time.Minute,
+
+	PowLimit:     mainPowLimit,
+	PowLimitBits: 0x1e0fffff,
+
+	PubKeyHashAddrID: 0x1e, // starts with D
+	ScriptHashAddrID: 0x16, // starts with 9 or A
+	PrivateKeyID:     0x9e,
+
+	HDPrivateKeyID: [4]byte{0x02, 0xfa, 0xc3, 0x98}, // dgpv
+	HDPublicKeyID:  [4]byte{0x02, 0xfa, 0xca, 0xfd}, // dgub
+	HDCoinType:     3,
+}
+
+// TestNetParams are the parameters of the dogecoin test network
+var TestNetParams = chaincfg.Params{
+	Name:        "testnet3",
+	Net:         wire.BitcoinNet(0xdcb7c1fc),
+	DefaultPort: "44556",
+
+	TargetTimePerBlock: time.Minute,
+
+	PowLimit:     mainPowLimit,
+	PowLimitBits: 0x1e0fffff,
+
+	PubKeyHashAddrID: 0x71, // starts with n
+	ScriptHashAddrID: 0xc4, // starts with 2
+	PrivateKeyID:     0xf1,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // tpub
+	HDCoinType:     1,
+}
+
+// RegTestParams are the parameters of the dogecoin regression test network
+var RegTestParams = chaincfg.Params{
+	Name:        "regtest",
+	Net:         wire.BitcoinNet(0xdab5bffa),
+	DefaultPort: "18444",
+
+	TargetTimePerBlock: time.Minute,
+
+	PowLimit:     regTestPowLimit,
+	PowLimitBits: 0x207fffff,
+
+	PubKeyHashAddrID: 0x6f,
+	ScriptHashAddrID: 0xc4,
+	PrivateKeyID:     0xef,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94},
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf},
+	HDCoinType:     1,
+}
+
+// Params returns the dogecoin network parameters
+func Params(network config.NetWorkType) *chaincfg.Params {
+	switch network {
+	case config.MainNet:
+		return &MainNetParams
+	case config.TestNet:
+		return &TestNetParams
+	case config.RegTest:
+		return &RegTestParams
+	}
+	panic("unsupported network type")
+}
+
+// Chain is dogecoin: bitcoin without segwit, with scrypt proof of work and a
+// fixed dust limit of 0.01 doge
+var Chain = &bitcoin.Chain{
+	Name:   ChainName,
+	Symbol: Symbol,
+	Params: Params,
+	Node: func(conf *config.Config) config.Node {
+		return conf.Fullnode.Doge
+	},
+	Addresses: bitcoin.ParamsAddresses{},
+	SigHasher: bitcoin.BitcoinSigHasher{},
+	TxCodec:   bitcoin.WireTxCodec{},
+	Policy: bitcoin.Policy{
+		MinRelayFeeRate: 100000,
+		DustLimit:       1000000,
+		MaxFeeRate:      100000,
+		FeeBlocks:       10,
+	},
+	SegWit:  false,
+	PowHash: powHash,
+	// sendrawtransaction of Dogecoin Core takes allowhighfees
+	MaxFeeRateVersion: 0,
+}
+
+// powHash returns the scrypt hash of the header. The proof of work of a merged
+// mined block is in the block of its parent chain, which the header does not carry,
+// so inclusion proofs of most blocks since 2014 are not supported.
+func powHash(header *wire.BlockHeader) (chainhash.Hash, error) {
+	if header.Version&versionAuxPow != 0 {
+		return chainhash.Hash{}, errors.New("merged mined doge blocks are not supported, their proof of work is in the parent chain")
+	}
+	return bitcoin.ScryptPowHash(header)
+}
+
+func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
+	return bitcoin.NewChainAdaptorWithChain(conf, Chain)
+}
+
+func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
+	return bitcoin.NewLocalChainAdaptorWithChain(network, Chain)
+}
@@ -0,0 +1,128 @@
+package doge
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin/bitcointest"
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestAddressesOffline(t *testing.T) {
+	mainnet := NewLocalChainAdaptor(config.MainNet)
+	testnet := NewLocalChainAdaptor(config.TestNet)
+
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("doge address key"))
+	mainReply, err := mainnet.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	assert.Equal(t, byte('D'), mainReply.Address[0])
+	testReply, err := testnet.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	assert.Equal(t, byte('n'), testReply.Address[0])
+
+	validReply, err := mainnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: mainReply.Address})
+	require.Nil(t, err)
+	assert.Equal(t, mainReply.Address, validReply.CanonicalAddress)
+	_, err = testnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: mainReply.Address})
+	assert.NotNil(t, err)
+
+	invalid := []string{
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		// dogecoin has no segwit
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9",
+	}
+	for _, addr := range invalid {
+		_, err := mainnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: addr})
+		assert.NotNil(t, err, addr)
+	}
+}
+
+func TestCreateSignedTransactionOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet)
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("doge offline sign key"))
+	convertReply, err := adaptor.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	from := convertReply.Address
+	_, toPubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("doge offline sign to"))
+	convertReply, err = adaptor.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: toPubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	to := convertReply.Address
+
+	// a 374 vbyte tx at the recommended 0.01 doge/kB
+	const fee = 374000
+	vins := []*proto.Vin{
+		{Hash: "c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9", Index: 0, Amount: 5000000000, Address: from},
+		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: 1, Address: from},
+	}
+	create := func(change int64) (*proto.CreateUtxoTransactionReply, error) {
+		vins[1].Amount = change + fee
+		return adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+			Chain:  ChainName,
+			Symbol: Symbol,
+			Vins:   vins,
+			Vouts: []*proto.Vout{
+				{Address: to, Amount: 5000000000},
+				{Address: from, Amount: change},
+			},
+			Fee: "374000",
+		})
+	}
+
+	// the dust limit is 0.01 doge whatever the output
+	_, err = create(999999)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "dust")
+
+	createReply, err := create(1000000)
+	require.Nil(t, err)
+
+	bitcointest.SignUtxoTransaction(t, adaptor, Chain, createReply, vins, privKey)
+
+	// no input can be spent through a witness script
+	vins[1].WitnessScript = []byte{0x51}
+	_, err = create(1000000)
+	assert.NotNil(t, err)
+}
+
+func TestVerifyTxInclusionProofOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.MainNet)
+
+	merkleRoot, err := chainhash.NewHashFromStr("5b2a3f53f605d62c53e62932dac6925e3d74afa5a4b459745c36d42d0ed26a69")
+	require.Nil(t, err)
+	coinbase, err := hex.DecodeString("01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff1004ffff001d0104084e696e746f6e646fffffffff010058850c020000004341040184710fa689ad5023690c80f3a49c8f13f8d45b8c857fbcbc8bc4a8e4d3eb4b10f4d4604fa08dce601aaf0f470216fe1b51850b4acf21b179c45070ac7b03a9ac00000000")
+	require.Nil(t, err)
+	header := wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *merkleRoot,
+		Timestamp:  time.Unix(1386325540, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      99943,
+	}
+	verify := func() error {
+		var buf bytes.Buffer
+		require.Nil(t, header.Serialize(&buf))
+		_, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{
+			Chain: ChainName,
+			Proof: &proto.TxInclusionProof{TxHash: merkleRoot.String(), BlockHeader: buf.Bytes(), TxCount: 1, RawTx: coinbase},
+		})
+		return err
+	}
+	assert.Equal(t, "1a91e3dace36e2be3bf030a65679fe821aa1d6ef92e7c9902eb318182c355691", header.BlockHash().String())
+	assert.Nil(t, verify())
+
+	header.Version |= versionAuxPow
+	err = verify()
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "merged mined doge blocks are not supported")
+}
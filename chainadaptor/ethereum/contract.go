@@ -0,0 +1,296 @@
+package ethereum
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+var errNoABI = errors.New("no ABI of the contract")
+
+// loadContractABIs reads the ABIs of the registered contracts
+func loadContractABIs(contracts []*config.Contract) (map[common.Address]abi.ABI, error) {
+	abis := make(map[common.Address]abi.ABI, len(contracts))
+	for _, contract := range contracts {
+		if !common.IsHexAddress(contract.Address) {
+			return nil, fmt.Errorf("invalid contract address %s", contract.Address)
+		}
+		data, err := ioutil.ReadFile(contract.ABI)
+		if err != nil {
+			return nil, err
+		}
+		parsed, err := abi.JSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABI of %s: %v", contract.Address, err)
+		}
+		abis[common.HexToAddress(contract.Address)] = parsed
+		log.Info("contract ABI registered", "address", contract.Address, "methods", len(parsed.Methods))
+	}
+	return abis, nil
+}
+
+// contractABI returns the ABI in json if given, or the one registered for the contract
+func (a *ChainAdaptor) contractABI(contract common.Address, abiJSON string) (abi.ABI, error) {
+	if abiJSON != "" {
+		return abi.JSON(strings.NewReader(abiJSON))
+	}
+	if parsed, ok := a.abis[contract]; ok {
+		return parsed, nil
+	}
+	return abi.ABI{}, errNoABI
+}
+
+// callData returns the calldata of the contract call of the request, nil for a transfer
+func (a *ChainAdaptor) callData(req *proto.CreateAccountTransactionRequest) ([]byte, error) {
+	if len(req.Data) == 0 && req.Method == "" {
+		return nil, nil
+	}
+	if len(req.ContractAddress) > 0 {
+		return nil, errors.New("a contract call is not an ERC20 transfer")
+	}
+	if len(req.Data) > 0 {
+		if req.Method != "" {
+			return nil, errors.New("either data or a method is called")
+		}
+		return req.Data, nil
+	}
+
+	parsed, err := a.contractABI(common.HexToAddress(req.To), req.Abi)
+	if err != nil {
+		return nil, err
+	}
+	method, err := abiMethod(parsed, req.Method)
+	if err != nil {
+		return nil, err
+	}
+	var args []json.RawMessage
+	if req.Args != "" {
+		if err := json.Unmarshal([]byte(req.Args), &args); err != nil {
+			return nil, fmt.Errorf("args are not a json array: %v", err)
+		}
+	}
+	if len(args) != len(method.Inputs) {
+		return nil, fmt.Errorf("%s takes %d args, got %d", method.Sig, len(method.Inputs), len(args))
+	}
+	values := make([]interface{}, len(args))
+	for i, arg := range method.Inputs {
+		value, err := abiValue(arg.Type, args[i])
+		if err != nil {
+			return nil, fmt.Errorf("arg %d of %s: %v", i, method.Sig, err)
+		}
+		values[i] = value.Interface()
+	}
+	input, err := method.Inputs.Pack(values...)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.CopyBytes(method.ID), input...), nil
+}
+
+// abiMethod finds the method by name, or by signature among the overloaded ones
+func abiMethod(parsed abi.ABI, name string) (*abi.Method, error) {
+	if strings.Contains(name, "(") {
+		sig := strings.Replace(name, " ", "", -1)
+		for _, method := range parsed.Methods {
+			if method.Sig == sig {
+				method := method
+				return &method, nil
+			}
+		}
+	} else if method, ok := parsed.Methods[name]; ok {
+		return &method, nil
+	}
+	return nil, fmt.Errorf("no method %s in the ABI", name)
+}
+
+// decodeCall returns the signature of the method the calldata calls and its
+// arguments in json
+func decodeCall(parsed abi.ABI, data []byte) (string, string, error) {
+	if len(data) < 4 {
+		return "", "", errors.New("calldata shorter than a method id")
+	}
+	method, err := parsed.MethodById(data[:4])
+	if err != nil {
+		return "", "", err
+	}
+	values, err := method.Inputs.UnpackValues(data[4:])
+	if err != nil {
+		return "", "", err
+	}
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		args[i] = abiJSONValue(method.Inputs[i].Type, reflect.ValueOf(value))
+	}
+	encoded, err := json.Marshal(args)
+	if err != nil {
+		return "", "", err
+	}
+	return method.Sig, string(encoded), nil
+}
+
+// abiType returns the go type the abi packs for the type, tuples are not supported
+func abiType(t abi.Type) (reflect.Type, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		return abiIntType(t.T == abi.UintTy, t.Size), nil
+	case abi.BoolTy:
+		return reflect.TypeOf(false), nil
+	case abi.StringTy:
+		return reflect.TypeOf(""), nil
+	case abi.AddressTy:
+		return reflect.TypeOf(common.Address{}), nil
+	case abi.BytesTy:
+		return reflect.TypeOf([]byte{}), nil
+	case abi.FixedBytesTy:
+		return reflect.ArrayOf(t.Size, reflect.TypeOf(byte(0))), nil
+	case abi.SliceTy, abi.ArrayTy:
+		elem, err := abiType(*t.Elem)
+		if err != nil {
+			return nil, err
+		}
+		if t.T == abi.SliceTy {
+			return reflect.SliceOf(elem), nil
+		}
+		return reflect.ArrayOf(t.Size, elem), nil
+	}
+	return nil, fmt.Errorf("unsupported abi type %s", t.String())
+}
+
+func abiIntType(unsigned bool, size int) reflect.Type {
+	intTypes := map[int][2]reflect.Type{
+		8:  {reflect.TypeOf(int8(0)), reflect.TypeOf(uint8(0))},
+		16: {reflect.TypeOf(int16(0)), reflect.TypeOf(uint16(0))},
+		32: {reflect.TypeOf(int32(0)), reflect.TypeOf(uint32(0))},
+		64: {reflect.TypeOf(int64(0)), reflect.TypeOf(uint64(0))},
+	}
+	if pair, ok := intTypes[size]; ok {
+		if unsigned {
+			return pair[1]
+		}
+		return pair[0]
+	}
+	return reflect.TypeOf(&big.Int{})
+}
+
+// abiValue converts the json of an argument to the go value the abi packs for the type,
+// integers are given as numbers or strings and bytes in hex
+func abiValue(t abi.Type, raw json.RawMessage) (reflect.Value, error) {
+	typ, err := abiType(t)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			s = string(raw)
+		}
+		n, ok := new(big.Int).SetString(s, 0)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("invalid %s %s", t.String(), raw)
+		}
+		if (t.T == abi.UintTy && (n.Sign() < 0 || n.BitLen() > t.Size)) ||
+			(t.T == abi.IntTy && n.BitLen() > t.Size-1 && n.Cmp(new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), uint(t.Size-1)))) != 0) {
+			return reflect.Value{}, fmt.Errorf("%s overflows %s", n, t.String())
+		}
+		if typ.Kind() == reflect.Ptr {
+			return reflect.ValueOf(n), nil
+		}
+		v := reflect.New(typ).Elem()
+		if t.T == abi.UintTy {
+			v.SetUint(n.Uint64())
+		} else {
+			v.SetInt(n.Int64())
+		}
+		return v, nil
+	case abi.AddressTy:
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil || !common.IsHexAddress(s) {
+			return reflect.Value{}, fmt.Errorf("invalid address %s", raw)
+		}
+		return reflect.ValueOf(common.HexToAddress(s)), nil
+	case abi.BytesTy, abi.FixedBytesTy:
+		var b hexutil.Bytes
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid %s %s: %v", t.String(), raw, err)
+		}
+		if t.T == abi.BytesTy {
+			return reflect.ValueOf([]byte(b)), nil
+		}
+		if len(b) != t.Size {
+			return reflect.Value{}, fmt.Errorf("%s takes %d bytes, got %d", t.String(), t.Size, len(b))
+		}
+		v := reflect.New(typ).Elem()
+		reflect.Copy(v, reflect.ValueOf([]byte(b)))
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal(raw, &elems); err != nil {
+			return reflect.Value{}, fmt.Errorf("invalid %s %s: %v", t.String(), raw, err)
+		}
+		var v reflect.Value
+		if t.T == abi.SliceTy {
+			v = reflect.MakeSlice(typ, len(elems), len(elems))
+		} else {
+			if len(elems) != t.Size {
+				return reflect.Value{}, fmt.Errorf("%s takes %d elements, got %d", t.String(), t.Size, len(elems))
+			}
+			v = reflect.New(typ).Elem()
+		}
+		for i, elem := range elems {
+			value, err := abiValue(*t.Elem, elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v.Index(i).Set(value)
+		}
+		return v, nil
+	}
+	// bool and string
+	v := reflect.New(typ)
+	if err := json.Unmarshal(raw, v.Interface()); err != nil {
+		return reflect.Value{}, fmt.Errorf("invalid %s %s: %v", t.String(), raw, err)
+	}
+	return v.Elem(), nil
+}
+
+// abiJSONValue returns the json value of an unpacked argument of the type,
+// integers are strings not to lose precision and bytes are in hex
+func abiJSONValue(t abi.Type, v reflect.Value) interface{} {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		if n, ok := v.Interface().(*big.Int); ok {
+			return n.String()
+		}
+		if t.T == abi.UintTy {
+			return fmt.Sprint(v.Uint())
+		}
+		return fmt.Sprint(v.Int())
+	case abi.AddressTy:
+		return v.Interface().(common.Address).String()
+	case abi.BytesTy, abi.FixedBytesTy:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Bytes(b)
+	case abi.SliceTy, abi.ArrayTy:
+		elems := make([]interface{}, v.Len())
+		for i := range elems {
+			elems[i] = abiJSONValue(*t.Elem, v.Index(i))
+		}
+		return elems
+	}
+	return v.Interface()
+}
@@ -0,0 +1,146 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const testSweeperABI = `[
+	{"type":"function","name":"approve","stateMutability":"nonpayable",
+	 "inputs":[{"name":"spender","type":"address"},{"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"sweep","stateMutability":"nonpayable",
+	 "inputs":[{"name":"id","type":"bytes32"},{"name":"slots","type":"uint8[]"},{"name":"delta","type":"int256"},
+	           {"name":"all","type":"bool"},{"name":"memo","type":"string"},{"name":"extra","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"sweep","stateMutability":"nonpayable",
+	 "inputs":[{"name":"to","type":"address"}],"outputs":[]}
+]`
+
+func newMockContractAdaptor(t *testing.T) *ChainAdaptor {
+	mockClient := &MockEthClient{}
+	mockClient.On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(
+		types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10000000)}), nil)
+	return newChainAdaptor(newMockEthClient(mockClient)).(*ChainAdaptor)
+}
+
+func TestContractCallMock(t *testing.T) {
+	adaptor := newMockContractAdaptor(t)
+	contract := "0x722dd3F80BAC40c951b51BdD28Dd19d435762180"
+	spender := "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c"
+
+	req := &proto.CreateAccountTransactionRequest{
+		Chain:    ChainName,
+		Symbol:   Symbol,
+		From:     "0xd139E358aE9cB5424B2067da96F94cC938343446",
+		To:       contract,
+		Amount:   "0",
+		Nonce:    1,
+		GasLimit: "60000",
+		GasPrice: "1000000000",
+		Method:   "approve",
+		Args:     `["` + spender + `", "1000"]`,
+		Abi:      testSweeperABI,
+	}
+	res, err := adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+
+	reply, err := adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  Symbol,
+		RawData: res.TxData,
+		Abi:     testSweeperABI,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, contract, reply.To)
+	assert.Equal(t, "0", reply.Amount)
+	assert.Equal(t, "095ea7b3"+
+		"000000000000000000000000c96d141c9110a8e61ed62caad8a7c858db15b82c"+
+		"00000000000000000000000000000000000000000000000000000000000003e8", hex.EncodeToString(reply.Data))
+	assert.Equal(t, "approve(address,uint256)", reply.Method)
+	assert.Equal(t, `["`+spender+`","1000"]`, reply.Args)
+
+	// the raw calldata is sent as is
+	data := reply.Data
+	req.Method, req.Args, req.Data = "", "", data
+	res, err = adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+	reply, err = adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  Symbol,
+		RawData: res.TxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, data, reply.Data)
+	assert.Equal(t, "", reply.Method)
+
+	for _, bad := range []*proto.CreateAccountTransactionRequest{
+		{Method: "approve", Data: data, Abi: testSweeperABI},
+		{Method: "approve", Args: `["` + spender + `"]`, Abi: testSweeperABI},
+		{Method: "approve", Args: `["` + spender + `", "-1"]`, Abi: testSweeperABI},
+		{Method: "transfer", Args: `[]`, Abi: testSweeperABI},
+		{Method: "approve", Args: `["` + spender + `", "1000"]`},
+	} {
+		bad.Chain, bad.Symbol, bad.From, bad.To = ChainName, Symbol, req.From, contract
+		bad.Amount, bad.GasLimit, bad.GasPrice = "0", "60000", "1000000000"
+		res, err := adaptor.CreateAccountTransaction(bad)
+		require.Nil(t, err)
+		assert.Equal(t, proto.ReturnCode_ERROR, res.Code, bad.Method)
+	}
+}
+
+func TestContractCallRegisteredABI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "abi")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sweeper.json")
+	require.Nil(t, ioutil.WriteFile(path, []byte(testSweeperABI), 0644))
+
+	contract := common.HexToAddress("0x722dd3F80BAC40c951b51BdD28Dd19d435762180")
+	adaptor := newMockContractAdaptor(t)
+	adaptor.abis, err = loadContractABIs([]*config.Contract{{Address: contract.String(), ABI: path}})
+	require.Nil(t, err)
+
+	args := `["0x0000000000000000000000000000000000000000000000000000000000000001",[1,2,255],"-5",true,"sweep all","0xbeef"]`
+	res, err := adaptor.CreateAccountTransaction(&proto.CreateAccountTransactionRequest{
+		Chain:                ChainName,
+		Symbol:               Symbol,
+		From:                 "0xd139E358aE9cB5424B2067da96F94cC938343446",
+		To:                   contract.String(),
+		Amount:               "0",
+		Nonce:                1,
+		GasLimit:             "200000",
+		MaxFeePerGas:         "30000000000",
+		MaxPriorityFeePerGas: "2000000000",
+		Method:               "sweep(bytes32,uint8[],int256,bool,string,bytes)",
+		Args:                 args,
+	})
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+
+	reply, err := adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  Symbol,
+		RawData: res.TxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(DynamicFeeTxType), reply.TxType)
+	assert.Equal(t, "sweep(bytes32,uint8[],int256,bool,string,bytes)", reply.Method)
+	assert.Equal(t, `["0x0000000000000000000000000000000000000000000000000000000000000001",["1","2","255"],"-5",true,"sweep all","0xbeef"]`, reply.Args)
+
+	_, err = loadContractABIs([]*config.Contract{{Address: contract.String(), ABI: filepath.Join(dir, "missing.json")}})
+	assert.NotNil(t, err)
+}
@@ -61,6 +61,19 @@ func (client *ethClient) erc20RawTransfer(tokenAddress string, nonce uint64, to
 		to, value)
 }
 
+func (client *ethClient) erc20PackTransfer(tokenAddress string, to common.Address, value *big.Int) ([]byte, error) {
+	if client == nil {
+		return nil, errors.New("nil client")
+	}
+	tokenContractWrapper, err := factory.NewTokenContractWrapper(common.HexToAddress(tokenAddress), client)
+	if err != nil {
+		log.Error("Failed to create token contract wrapper", "err", err)
+		return nil, err
+	}
+
+	return tokenContractWrapper.PackTransfer(to, value)
+}
+
 func (client *ethClient) unpackTransfer(tokenContract common.Address, data []byte) (to common.Address, amount *big.Int, err error) {
 	if client == nil {
 		err = errors.New("nil client")
@@ -75,7 +88,7 @@ func (client *ethClient) unpackTransfer(tokenContract common.Address, data []byt
 	return tokenContractWrapper.UnpackTransfer(data)
 }
 
-func (a *ChainAdaptor) validateAndQueryERC20RawTransfer(contract common.Address, tx *types.Transaction) (
+func (a *ChainAdaptor) validateAndQueryERC20RawTransfer(contract common.Address, tx *transaction) (
 	to common.Address, amount *big.Int, err error) {
 	// erc20 transfer transaction
 	if tx.Value().Cmp(big.NewInt(0)) != 0 {
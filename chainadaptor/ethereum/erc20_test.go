@@ -35,6 +35,8 @@ func TestBalanceOf(t *testing.T) {
 }
 
 func TestDecimal(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	client := ethChainAdaptor.(*ChainAdaptor).getClient()
 	decimals, err := client.erc20Decimals(tbtcContractAddress)
 	assert.NoError(t, err)
@@ -68,6 +70,8 @@ func TestQueryBalanceForERC20(t *testing.T) {
 }
 
 func TestCreateERC20Transaction(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	expectedData := "f86959843b9aca00830186a09450802b3e32748a75696e7124b25d6113468958b180b844a9059cbb000000000000000000000000c96d141c9110a8e61ed62caad8a7c858db15b82c00000000000000000000000000000000000000000000000000000006fc23ac00808080"
 	expectedHash := "74c5e2d36c3c66905fa64be1824db6fe0b61329381dd34dfbd43a6cb8e4e3189"
 
@@ -90,6 +94,8 @@ func TestCreateERC20Transaction(t *testing.T) {
 }
 
 func TestQueryERC20TransactionFromData(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	txData, _ := hex.DecodeString("f86959843b9aca00830186a09450802b3e32748a75696e7124b25d6113468958b180b844a9059cbb000000000000000000000000c96d141c9110a8e61ed62caad8a7c858db15b82c00000000000000000000000000000000000000000000000000000006fc23ac00808080")
 
 	req := &proto.QueryTransactionFromDataRequest{
@@ -119,6 +125,8 @@ func TestQueryERC20TransactionFromData(t *testing.T) {
 }
 
 func TestQueryERC20TransactionFromSignedData(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	signedTxData, _ := hex.DecodeString("f8a901843b9aca00830186a09450802b3e32748a75696e7124b25d6113468958b180b844a9059cbb0000000000000000000000007801e6fc30c77852f82272c17f853bff20a70c4200000000000000000000000000000000000000000000000000000000000027102aa0796fc79bc923e3bcab68b79e237be2a2ed270e511821fc8ae48b385ea75de0a8a02b3acc092454bb1f7c1ddabd56b4c8693226b3fa551b1daecec81448e77e59bb")
 
 	req := &proto.QueryTransactionFromSignedDataRequest{
@@ -148,6 +156,8 @@ func TestQueryERC20TransactionFromSignedData(t *testing.T) {
 }
 
 func TestQueryERC20Transaction(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	hash := "0x1509e8fec49276ae38f503213e5f49eaef904ad042e35c3edbed4e45ecb18f43"
 	req := &proto.QueryTransactionRequest{
 		Chain:  ChainName,
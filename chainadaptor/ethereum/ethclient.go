@@ -2,19 +2,25 @@ package ethereum
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"math/big"
 	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/hbtc-chain/chainnode/config"
 )
@@ -25,6 +31,8 @@ var (
 
 type ethClient struct {
 	Client
+	// rpc is the connection under Client, for the calls go-ethereum predates
+	rpc              rpcCaller
 	chainConfig      *params.ChainConfig
 	cacheBlockNumber *big.Int
 	cacheTime        int64
@@ -42,6 +50,10 @@ type Client interface {
 	NonceAt(context.Context, common.Address, *big.Int) (uint64, error)
 }
 
+type rpcCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
 // newEthClient init the eth client
 func newEthClients(conf *config.Config) ([]*ethClient, error) {
 
@@ -75,12 +87,13 @@ func newEthClients(conf *config.Config) ([]*ethClient, error) {
 
 			rpcURL = strings.Replace(rpc.RPCURL, words[0], ipAddr.String(), 1)
 		}
-		var err error
-		client.Client, err = ethclient.Dial(rpcURL)
+		c, err := gethrpc.Dial(rpcURL)
 		if err != nil {
 			log.Error("ethclient dial failed", "err", err)
 			continue
 		}
+		client.Client = ethclient.NewClient(c)
+		client.rpc = c
 		clients = append(clients, client)
 	}
 	if len(clients) == 0 {
@@ -125,12 +138,12 @@ func (client *ethClient) blockNumber() *big.Int {
 	if now-client.cacheTime < blockNumberCacheTime {
 		return client.cacheBlockNumber
 	}
-	latestBlock, err := client.BlockByNumber(context.Background(), nil)
+	latestNumber, err := client.latestBlockNumber(context.Background())
 	if err != nil {
 		log.Error("get BlockByNumber failed", "error", err)
 		return nil
 	}
-	client.cacheBlockNumber = latestBlock.Number()
+	client.cacheBlockNumber = latestNumber
 	client.cacheTime = now
 	return client.cacheBlockNumber
 }
@@ -141,9 +154,288 @@ func (client *ethClient) isContractAddress(address common.Address) bool {
 }
 
 func (client *ethClient) GetLatestBlockHeight() (int64, error) {
-	number, err := client.BlockByNumber(context.TODO(), nil)
+	number, err := client.latestBlockNumber(context.TODO())
 	if err != nil {
 		return 0, err
 	}
-	return number.Number().Int64(), err
+	return number.Int64(), err
+}
+
+// latestBlockNumber returns the height of the chain head, asked without the
+// block whose typed txs go-ethereum can not decode
+func (client *ethClient) latestBlockNumber(ctx context.Context) (*big.Int, error) {
+	if client.rpc == nil {
+		latestBlock, err := client.BlockByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return latestBlock.Number(), nil
+	}
+	var number hexutil.Big
+	if err := client.rpc.CallContext(ctx, &number, "eth_blockNumber"); err != nil {
+		return nil, err
+	}
+	return number.ToInt(), nil
+}
+
+// errNoRPC is returned for the calls needing the rpc connection of a node
+var errNoRPC = errors.New("no rpc connection to a node")
+
+const (
+	// feeHistoryBlocks is the number of recent blocks the priority fees are taken over
+	feeHistoryBlocks = 20
+)
+
+// feeHistoryPercentiles are the percentiles of the priority fees reported, of
+// the gas used in each block
+var feeHistoryPercentiles = []float64{10, 50, 90}
+
+type feeHistoryResult struct {
+	BaseFee []*hexutil.Big   `json:"baseFeePerGas"`
+	Reward  [][]*hexutil.Big `json:"reward"`
+}
+
+// feeHistory returns the base fee of the next block and, for each percentile,
+// the median priority fee of recent blocks
+func (client *ethClient) feeHistory(ctx context.Context) (*big.Int, []*big.Int, error) {
+	if client.rpc == nil {
+		return nil, nil, errNoRPC
+	}
+	var result feeHistoryResult
+	err := client.rpc.CallContext(ctx, &result, "eth_feeHistory",
+		hexutil.Uint64(feeHistoryBlocks), "latest", feeHistoryPercentiles)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(result.BaseFee) == 0 {
+		return nil, nil, errors.New("no base fee, the chain is not on london")
+	}
+
+	fees := make([]*big.Int, len(feeHistoryPercentiles))
+	for i := range feeHistoryPercentiles {
+		var rewards []*big.Int
+		for _, reward := range result.Reward {
+			if i < len(reward) && reward[i] != nil {
+				rewards = append(rewards, reward[i].ToInt())
+			}
+		}
+		fees[i] = new(big.Int)
+		if len(rewards) == 0 {
+			continue
+		}
+		sort.Slice(rewards, func(a, b int) bool {
+			return rewards[a].Cmp(rewards[b]) < 0
+		})
+		fees[i].Set(rewards[len(rewards)/2])
+	}
+	// the last base fee is the one of the next block
+	return result.BaseFee[len(result.BaseFee)-1].ToInt(), fees, nil
+}
+
+// sendTransaction sends a signed tx, a typed one encoded raw as go-ethereum
+// can not encode it
+func (client *ethClient) sendTransaction(ctx context.Context, tx *transaction) error {
+	if tx.legacy != nil {
+		return client.SendTransaction(ctx, tx.legacy)
+	}
+	if client.rpc == nil {
+		return errNoRPC
+	}
+	data, err := tx.encode()
+	if err != nil {
+		return err
+	}
+	return client.rpc.CallContext(ctx, nil, "eth_sendRawTransaction", hexutil.Encode(data))
+}
+
+// rpcTransaction is a tx as returned by the node, of any type
+type rpcTransaction struct {
+	Type        *hexutil.Uint64 `json:"type"`
+	BlockNumber *string         `json:"blockNumber"`
+	ChainID     *hexutil.Big    `json:"chainId"`
+	Nonce       hexutil.Uint64  `json:"nonce"`
+	GasPrice    *hexutil.Big    `json:"gasPrice"`
+	GasTipCap   *hexutil.Big    `json:"maxPriorityFeePerGas"`
+	GasFeeCap   *hexutil.Big    `json:"maxFeePerGas"`
+	Gas         hexutil.Uint64  `json:"gas"`
+	To          *common.Address `json:"to"`
+	Value       *hexutil.Big    `json:"value"`
+	Input       hexutil.Bytes   `json:"input"`
+	AccessList  []accessTuple   `json:"accessList"`
+	V           *hexutil.Big    `json:"v"`
+	R           *hexutil.Big    `json:"r"`
+	S           *hexutil.Big    `json:"s"`
+}
+
+// decodeRPCTransaction decodes a tx returned by the node, go-ethereum decodes
+// the legacy ones only
+func decodeRPCTransaction(raw json.RawMessage) (*transaction, error) {
+	var meta rpcTransaction
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, err
+	}
+	if meta.Type == nil || *meta.Type == LegacyTxType {
+		tx := new(types.Transaction)
+		if err := json.Unmarshal(raw, tx); err != nil {
+			return nil, err
+		}
+		return newLegacyTransaction(tx), nil
+	}
+	if meta.ChainID == nil || meta.GasPrice == nil || meta.Value == nil || meta.V == nil || meta.R == nil || meta.S == nil {
+		return nil, fmt.Errorf("missing fields of a transaction of type %d", *meta.Type)
+	}
+	switch *meta.Type {
+	case AccessListTxType:
+		return &transaction{accessList: &accessListTx{
+			ChainID:    meta.ChainID.ToInt(),
+			Nonce:      uint64(meta.Nonce),
+			GasPrice:   meta.GasPrice.ToInt(),
+			Gas:        uint64(meta.Gas),
+			To:         meta.To,
+			Value:      meta.Value.ToInt(),
+			Data:       meta.Input,
+			AccessList: meta.AccessList,
+			V:          meta.V.ToInt(),
+			R:          meta.R.ToInt(),
+			S:          meta.S.ToInt(),
+		}}, nil
+	case DynamicFeeTxType:
+	default:
+		return nil, fmt.Errorf("%w %d", errTxType, *meta.Type)
+	}
+	if meta.GasTipCap == nil || meta.GasFeeCap == nil {
+		return nil, errors.New("missing fields of a dynamic fee transaction")
+	}
+	tx := &transaction{dynamic: &dynamicFeeTx{
+		ChainID:    meta.ChainID.ToInt(),
+		Nonce:      uint64(meta.Nonce),
+		GasTipCap:  meta.GasTipCap.ToInt(),
+		GasFeeCap:  meta.GasFeeCap.ToInt(),
+		Gas:        uint64(meta.Gas),
+		To:         meta.To,
+		Value:      meta.Value.ToInt(),
+		Data:       meta.Input,
+		AccessList: meta.AccessList,
+		V:          meta.V.ToInt(),
+		R:          meta.R.ToInt(),
+		S:          meta.S.ToInt(),
+	}}
+	if meta.BlockNumber != nil {
+		tx.gasPrice = meta.GasPrice.ToInt()
+	}
+	return tx, nil
+}
+
+// transactionByHash returns the tx of the hash and whether it is pending
+func (client *ethClient) transactionByHash(ctx context.Context, hash common.Hash) (*transaction, bool, error) {
+	if client.rpc == nil {
+		tx, pending, err := client.TransactionByHash(ctx, hash)
+		if err != nil {
+			return nil, false, err
+		}
+		return newLegacyTransaction(tx), pending, nil
+	}
+	var raw json.RawMessage
+	if err := client.rpc.CallContext(ctx, &raw, "eth_getTransactionByHash", hash); err != nil {
+		return nil, false, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, false, ethereum.NotFound
+	}
+	var meta struct {
+		BlockNumber *string `json:"blockNumber"`
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, false, err
+	}
+	tx, err := decodeRPCTransaction(raw)
+	if err != nil {
+		return nil, false, err
+	}
+	return tx, meta.BlockNumber == nil, nil
+}
+
+// blockTransactions returns the time and the txs of the block at the height
+func (client *ethClient) blockTransactions(ctx context.Context, height *big.Int) (uint64, []*transaction, error) {
+	if client.rpc == nil {
+		block, err := client.BlockByNumber(ctx, height)
+		if err != nil {
+			return 0, nil, err
+		}
+		txs := make([]*transaction, len(block.Transactions()))
+		for i, tx := range block.Transactions() {
+			txs[i] = newLegacyTransaction(tx)
+		}
+		return block.Time(), txs, nil
+	}
+	var block struct {
+		Time         hexutil.Uint64    `json:"timestamp"`
+		Transactions []json.RawMessage `json:"transactions"`
+	}
+	var raw json.RawMessage
+	if err := client.rpc.CallContext(ctx, &raw, "eth_getBlockByNumber", hexutil.EncodeBig(height), true); err != nil {
+		return 0, nil, err
+	}
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil, ethereum.NotFound
+	}
+	if err := json.Unmarshal(raw, &block); err != nil {
+		return 0, nil, err
+	}
+	txs := make([]*transaction, 0, len(block.Transactions))
+	for i, rawTx := range block.Transactions {
+		tx, err := decodeRPCTransaction(rawTx)
+		if errors.Is(err, errTxType) {
+			// newer types, like the blob txs, can not be decoded and are left out
+			// instead of stopping the scan of the block
+			log.Warn("blockTransactions skip transaction", "height", height, "index", i, "err", err)
+			continue
+		}
+		if err != nil {
+			return 0, nil, err
+		}
+		txs = append(txs, tx)
+	}
+	return uint64(block.Time), txs, nil
+}
+
+type createAccessListResult struct {
+	AccessList []accessTuple  `json:"accessList"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Error      string         `json:"error"`
+}
+
+// createAccessList returns the access list the node finds the tx from the
+// address to access, and the gas the tx uses with it
+func (client *ethClient) createAccessList(ctx context.Context, from common.Address, tx *transaction) ([]accessTuple, uint64, error) {
+	if client.rpc == nil {
+		return nil, 0, errNoRPC
+	}
+	args := map[string]interface{}{
+		"from":  from,
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"data":  hexutil.Bytes(tx.Data()),
+	}
+	if to := tx.To(); to != nil {
+		args["to"] = to
+	}
+	if tx.Type() == DynamicFeeTxType {
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	}
+	if accessList := tx.AccessList(); len(accessList) > 0 {
+		args["accessList"] = accessList
+	}
+
+	var result createAccessListResult
+	if err := client.rpc.CallContext(ctx, &result, "eth_createAccessList", args, "pending"); err != nil {
+		return nil, 0, err
+	}
+	if result.Error != "" {
+		return nil, 0, fmt.Errorf("create access list: %s", result.Error)
+	}
+	return result.AccessList, uint64(result.GasUsed), nil
 }
@@ -14,6 +14,8 @@ import (
 )
 
 func TestGetTxHash(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	client := ethChainAdaptor.(*ChainAdaptor).getClient()
 	txHash := common.HexToHash("0x33ac277a7e48a77fc6762660c5fa1372ca3395b9a59370ebbb0e7419ba4441bc")
 	tx, pending, err := client.TransactionByHash(context.TODO(), txHash)
@@ -24,6 +26,8 @@ func TestGetTxHash(t *testing.T) {
 }
 
 func TestGetBlockByNumber(t *testing.T) {
+	skipWithoutFullNode(t)
+
 	client := ethChainAdaptor.(*ChainAdaptor).getClient()
 
 	num := big.NewInt(6552057)
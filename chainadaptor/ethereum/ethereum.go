@@ -13,12 +13,12 @@ import (
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/shopspring/decimal"
 	"go.uber.org/atomic"
 
@@ -38,6 +38,8 @@ const (
 type ChainAdaptor struct {
 	fallback.ChainAdaptor
 	clients *multiclient.MultiClient
+	// abis are the ABIs of the registered contracts
+	abis map[common.Address]abi.ABI
 }
 
 func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
@@ -45,12 +47,17 @@ func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
 	if err != nil {
 		return nil, err
 	}
+	abis, err := loadContractABIs(conf.Fullnode.Eth.Contracts)
+	if err != nil {
+		return nil, err
+	}
 	clis := make([]multiclient.Client, len(clients))
 	for i, client := range clients {
 		clis[i] = client
 	}
 	return &ChainAdaptor{
 		clients: multiclient.New(clis),
+		abis:    abis,
 	}, nil
 }
 
@@ -187,11 +194,23 @@ func (a *ChainAdaptor) QueryGasPrice(_ *proto.QueryGasPriceRequest) (*proto.Quer
 		}, nil
 	}
 
-	return &proto.QueryGasPriceReply{
+	reply := &proto.QueryGasPriceReply{
 		Code:     proto.ReturnCode_SUCCESS,
 		GasPrice: price.String(),
-	}, nil
-
+	}
+	baseFee, priorityFees, err := a.getClient().feeHistory(context.TODO())
+	if err != nil {
+		log.Warn("get fee history failed, gas price only", "err", err)
+		return reply, nil
+	}
+	reply.BaseFee = baseFee.String()
+	for i, fee := range priorityFees {
+		reply.PriorityFees = append(reply.PriorityFees, &proto.PriorityFee{
+			Percentile: feeHistoryPercentiles[i],
+			Fee:        fee.String(),
+		})
+	}
+	return reply, nil
 }
 
 // QueryTransaction query tx info from chain
@@ -202,7 +221,7 @@ func (a *ChainAdaptor) QueryAccountTransaction(req *proto.QueryTransactionReques
 		return r.(*proto.QueryAccountTransactionReply), nil
 	}
 
-	tx, pending, err := a.getClient().TransactionByHash(context.TODO(), common.HexToHash(req.TxHash))
+	tx, pending, err := a.getClient().transactionByHash(context.TODO(), common.HexToHash(req.TxHash))
 	if err != nil {
 		if err == ethereum.NotFound {
 			return &proto.QueryAccountTransactionReply{
@@ -296,8 +315,8 @@ func (a *ChainAdaptor) QueryAccountTransaction(req *proto.QueryTransactionReques
 
 // QueryTransactionFromSignedData query tx info from a signed transaction
 func (a *ChainAdaptor) QueryAccountTransactionFromSignedData(req *proto.QueryTransactionFromSignedDataRequest) (*proto.QueryAccountTransactionReply, error) {
-	signedTx := new(types.Transaction)
-	if err := rlp.DecodeBytes(req.SignedTxData, signedTx); err != nil {
+	signedTx, err := decodeTransaction(req.SignedTxData)
+	if err != nil {
 		log.Error("signedTx unmarlshal failed", "err", err)
 		return &proto.QueryAccountTransactionReply{
 			Code: proto.ReturnCode_SUCCESS,
@@ -310,8 +329,8 @@ func (a *ChainAdaptor) QueryAccountTransactionFromSignedData(req *proto.QueryTra
 
 // QueryTransactionFromData query tx info from a raw(unsigned) transaction
 func (a *ChainAdaptor) QueryAccountTransactionFromData(req *proto.QueryTransactionFromDataRequest) (*proto.QueryAccountTransactionReply, error) {
-	rawTx := new(types.Transaction)
-	if err := rlp.DecodeBytes(req.RawData, rawTx); err != nil {
+	rawTx, err := decodeTransaction(req.RawData)
+	if err != nil {
 		log.Error("signedTx unmarlshal failed", "err", err)
 		return &proto.QueryAccountTransactionReply{
 			Code: proto.ReturnCode_SUCCESS,
@@ -319,7 +338,7 @@ func (a *ChainAdaptor) QueryAccountTransactionFromData(req *proto.QueryTransacti
 		}, err
 	}
 
-	reply, err := a.queryRawTransaction(req.Chain != req.Symbol, rawTx)
+	reply, err := a.queryRawTransaction(req.Chain != req.Symbol, rawTx, req.Abi)
 	if err != nil {
 		log.Error("queryRawTransaction failed", "err", err)
 		return &proto.QueryAccountTransactionReply{
@@ -328,7 +347,7 @@ func (a *ChainAdaptor) QueryAccountTransactionFromData(req *proto.QueryTransacti
 		}, nil
 	}
 	signer := a.makeSignerOffline(req.Height)
-	reply.SignHash = signer.Hash(rawTx).Bytes()
+	reply.SignHash = rawTx.signHash(signer).Bytes()
 	return reply, nil
 }
 
@@ -366,7 +385,16 @@ func (a *ChainAdaptor) CreateAccountTransaction(req *proto.CreateAccountTransact
 		}, nil
 	}
 
-	if req.GasPrice == "" {
+	dynamicFee := req.MaxFeePerGas != ""
+	if dynamicFee && req.MaxPriorityFeePerGas == "" {
+		log.Info("max priority fee can not be zero", "max_priority_fee_per_gas", req.MaxPriorityFeePerGas)
+		return &proto.CreateAccountTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  "zero max priority fee",
+		}, nil
+	}
+
+	if !dynamicFee && req.GasPrice == "" {
 		log.Info("gas price can not be zero", "gas_price", req.GasPrice)
 		return &proto.CreateAccountTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -386,14 +414,34 @@ func (a *ChainAdaptor) CreateAccountTransaction(req *proto.CreateAccountTransact
 		}, nil
 	}
 
-	// convert gas price
-	gasPrice := stringToInt(req.GasPrice)
-	if gasPrice == nil {
-		log.Error("convert gasPrice failed")
-		return &proto.CreateAccountTransactionReply{
-			Code: proto.ReturnCode_ERROR,
-			Msg:  "convert gasPrice failed",
-		}, nil
+	// convert gas price, or the fee caps of a dynamic fee tx
+	var gasPrice, gasFeeCap, gasTipCap *big.Int
+	if dynamicFee {
+		gasFeeCap = stringToInt(req.MaxFeePerGas)
+		gasTipCap = stringToInt(req.MaxPriorityFeePerGas)
+		if gasFeeCap == nil || gasTipCap == nil {
+			log.Error("convert max fees failed")
+			return &proto.CreateAccountTransactionReply{
+				Code: proto.ReturnCode_ERROR,
+				Msg:  "convert max fees failed",
+			}, nil
+		}
+		if gasTipCap.Cmp(gasFeeCap) > 0 {
+			log.Info("max priority fee higher than max fee", "max_fee_per_gas", gasFeeCap, "max_priority_fee_per_gas", gasTipCap)
+			return &proto.CreateAccountTransactionReply{
+				Code: proto.ReturnCode_ERROR,
+				Msg:  "max priority fee higher than max fee",
+			}, nil
+		}
+	} else {
+		gasPrice = stringToInt(req.GasPrice)
+		if gasPrice == nil {
+			log.Error("convert gasPrice failed")
+			return &proto.CreateAccountTransactionReply{
+				Code: proto.ReturnCode_ERROR,
+				Msg:  "convert gasPrice failed",
+			}, nil
+		}
 	}
 
 	gasLimit := stringToInt(req.GasLimit)
@@ -405,20 +453,48 @@ func (a *ChainAdaptor) CreateAccountTransaction(req *proto.CreateAccountTransact
 		}, nil
 	}
 
-	var err error
+	data, err := a.callData(req)
+	if err != nil {
+		log.Error("contract call data failed", "err", err)
+		return &proto.CreateAccountTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+
 	// make transaction
-	var tx *types.Transaction
-	if len(req.ContractAddress) > 0 {
-		tx, err = a.getClient().erc20RawTransfer(req.ContractAddress, nonce, common.HexToAddress(req.To), assetAmount,
+	var tx *transaction
+	if dynamicFee {
+		tx, err = a.dynamicFeeTransaction(req, nonce, assetAmount, gasLimit.Uint64(), gasFeeCap, gasTipCap, data)
+		if err != nil {
+			log.Error("dynamic fee tx failed", "err", err)
+			return nil, err
+		}
+	} else if len(req.ContractAddress) > 0 {
+		legacyTx, err := a.getClient().erc20RawTransfer(req.ContractAddress, nonce, common.HexToAddress(req.To), assetAmount,
 			gasLimit.Uint64(), gasPrice)
 		if err != nil {
 			log.Error("ERC20 tx raw transfer failed", "err", err)
 			return nil, err
 		}
+		tx = newLegacyTransaction(legacyTx)
 	} else {
-		tx = types.NewTransaction(nonce, common.HexToAddress(req.To), assetAmount, gasLimit.Uint64(), gasPrice, nil)
+		tx = newLegacyTransaction(types.NewTransaction(nonce, common.HexToAddress(req.To), assetAmount, gasLimit.Uint64(), gasPrice, data))
+	}
+
+	accessList, err := a.requestAccessList(req, tx)
+	if err != nil {
+		log.Error("access list failed", "err", err)
+		return &proto.CreateAccountTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  err.Error(),
+		}, nil
+	}
+	if len(accessList) > 0 {
+		tx = tx.withAccessList(a.getClient().chainConfig.ChainID, accessList)
 	}
-	txData, err := rlp.EncodeToBytes(tx)
+
+	txData, err := tx.encode()
 	if err != nil {
 		log.Error("tx EncodeToBytes failed", "err", err)
 		return &proto.CreateAccountTransactionReply{
@@ -435,14 +511,77 @@ func (a *ChainAdaptor) CreateAccountTransaction(req *proto.CreateAccountTransact
 	return &proto.CreateAccountTransactionReply{
 		Code:     proto.ReturnCode_SUCCESS,
 		TxData:   txData,
-		SignHash: signer.Hash(tx).Bytes(),
+		SignHash: tx.signHash(signer).Bytes(),
 	}, nil
 }
 
+// requestAccessList returns the access list of the request, made by the node for
+// the tx if asked to
+func (a *ChainAdaptor) requestAccessList(req *proto.CreateAccountTransactionRequest, tx *transaction) ([]accessTuple, error) {
+	var accessList []accessTuple
+	for _, tuple := range req.AccessList {
+		if !common.IsHexAddress(tuple.Address) {
+			return nil, fmt.Errorf("invalid access list address %s", tuple.Address)
+		}
+		t := accessTuple{Address: common.HexToAddress(tuple.Address), StorageKeys: []common.Hash{}}
+		for _, key := range tuple.StorageKeys {
+			b, err := hexutil.Decode(key)
+			if err != nil || len(b) != common.HashLength {
+				return nil, fmt.Errorf("invalid access list storage key %s", key)
+			}
+			t.StorageKeys = append(t.StorageKeys, common.BytesToHash(b))
+		}
+		accessList = append(accessList, t)
+	}
+	if !req.CreateAccessList {
+		return accessList, nil
+	}
+
+	if len(accessList) > 0 {
+		tx = tx.withAccessList(a.getClient().chainConfig.ChainID, accessList)
+	}
+	accessList, gasUsed, err := a.getClient().createAccessList(context.TODO(), common.HexToAddress(req.From), tx)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("create access list", "tuples", len(accessList), "gas_used", gasUsed)
+	if gasUsed > tx.Gas() {
+		return nil, fmt.Errorf("gas limit %d below the %d gas used with the access list", tx.Gas(), gasUsed)
+	}
+	return accessList, nil
+}
+
+// dynamicFeeTransaction makes the EIP-1559 tx of a transfer of ether or of an ERC20 token,
+// or of a contract call with the data
+func (a *ChainAdaptor) dynamicFeeTransaction(req *proto.CreateAccountTransactionRequest, nonce uint64, amount *big.Int,
+	gasLimit uint64, gasFeeCap, gasTipCap *big.Int, data []byte) (*transaction, error) {
+	to := common.HexToAddress(req.To)
+	value := amount
+	if len(req.ContractAddress) > 0 {
+		var err error
+		data, err = a.getClient().erc20PackTransfer(req.ContractAddress, to, amount)
+		if err != nil {
+			return nil, err
+		}
+		to = common.HexToAddress(req.ContractAddress)
+		value = new(big.Int)
+	}
+	return &transaction{dynamic: &dynamicFeeTx{
+		ChainID:   a.getClient().chainConfig.ChainID,
+		Nonce:     nonce,
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     value,
+		Data:      data,
+	}}, nil
+}
+
 // CreateSignedTransaction create signed transaction
 func (a *ChainAdaptor) CreateAccountSignedTransaction(req *proto.CreateAccountSignedTransactionRequest) (*proto.CreateSignedTransactionReply, error) {
-	tx := new(types.Transaction)
-	if err := rlp.DecodeBytes(req.TxData, tx); err != nil {
+	tx, err := decodeTransaction(req.TxData)
+	if err != nil {
 		log.Error("tx unmarlshal failed", "err", err)
 		return &proto.CreateSignedTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -455,7 +594,7 @@ func (a *ChainAdaptor) CreateAccountSignedTransaction(req *proto.CreateAccountSi
 		return nil, err
 	}
 
-	signedTx, err := tx.WithSignature(signer, req.Signature)
+	signedTx, err := tx.withSignature(signer, req.Signature)
 	if err != nil {
 		log.Error("tx WithSignature failed", "err", err)
 		return &proto.CreateSignedTransactionReply{
@@ -464,7 +603,7 @@ func (a *ChainAdaptor) CreateAccountSignedTransaction(req *proto.CreateAccountSi
 		}, err
 	}
 
-	signedTxData, err := rlp.EncodeToBytes(signedTx)
+	signedTxData, err := signedTx.encode()
 	if err != nil {
 		log.Error("signedTx EncodeToBytes failed", "err", err)
 		return &proto.CreateSignedTransactionReply{
@@ -482,8 +621,8 @@ func (a *ChainAdaptor) CreateAccountSignedTransaction(req *proto.CreateAccountSi
 
 // BroadcastTransaction  broadcast tx to chain
 func (a *ChainAdaptor) BroadcastTransaction(req *proto.BroadcastTransactionRequest) (*proto.BroadcastTransactionReply, error) {
-	signedTx := new(types.Transaction)
-	if err := rlp.DecodeBytes(req.SignedTxData, signedTx); err != nil {
+	signedTx, err := decodeTransaction(req.SignedTxData)
+	if err != nil {
 		log.Error("signedTx DecodeBytes failed", "err", err)
 		return &proto.BroadcastTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -493,7 +632,7 @@ func (a *ChainAdaptor) BroadcastTransaction(req *proto.BroadcastTransactionReque
 	log.Info("broadcast tx", "tx", hexutil.Encode(req.SignedTxData))
 
 	txHash := fmt.Sprintf("0x%x", signedTx.Hash())
-	if err := a.getClient().SendTransaction(context.TODO(), signedTx); err != nil {
+	if err := a.getClient().sendTransaction(context.TODO(), signedTx); err != nil {
 		log.Error("braoadcast tx failed", "tx_hash", txHash, "err", err)
 		return &proto.BroadcastTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -509,8 +648,8 @@ func (a *ChainAdaptor) BroadcastTransaction(req *proto.BroadcastTransactionReque
 }
 
 func (a *ChainAdaptor) VerifyAccountSignedTransaction(req *proto.VerifySignedTransactionRequest) (*proto.VerifySignedTransactionReply, error) {
-	signedTx := new(types.Transaction)
-	if err := rlp.DecodeBytes(req.SignedTxData, signedTx); err != nil {
+	signedTx, err := decodeTransaction(req.SignedTxData)
+	if err != nil {
 		log.Error("signedTx DecodeBytess failed", "err", err)
 		return &proto.VerifySignedTransactionReply{
 			Code: proto.ReturnCode_ERROR,
@@ -520,7 +659,7 @@ func (a *ChainAdaptor) VerifyAccountSignedTransaction(req *proto.VerifySignedTra
 
 	signer := a.makeSignerOffline(req.Height)
 
-	sender, err := signer.Sender(signedTx)
+	sender, err := a.txSender(signedTx, signer)
 	if err != nil {
 		log.Error("failed to get sender from signed tx", "err", err)
 		return &proto.VerifySignedTransactionReply{
@@ -547,14 +686,12 @@ func (a *ChainAdaptor) GetLatestBlockHeight() (int64, error) {
 }
 
 func (a *ChainAdaptor) GetAccountTransactionByHeight(height int64, replyCh chan *proto.QueryAccountTransactionReply, errCh chan error) {
-	block, err := a.getClient().BlockByNumber(context.TODO(), big.NewInt(height))
+	blockTime, transactions, err := a.getClient().blockTransactions(context.TODO(), big.NewInt(height))
 	if err != nil {
 		errCh <- err
 		return
 	}
 
-	transactions := block.Transactions()
-
 	var wg sync.WaitGroup
 	wg.Add(len(transactions))
 	sem := make(semaphore, runtime.NumCPU())
@@ -587,7 +724,7 @@ func (a *ChainAdaptor) GetAccountTransactionByHeight(height int64, replyCh chan
 				return
 			}
 			signer := a.makeSignerOffline(height)
-			sender, err := signer.Sender(tx)
+			sender, err := a.txSender(tx, signer)
 			if err != nil {
 				errCh <- err
 				needStop.Store(true)
@@ -608,8 +745,8 @@ func (a *ChainAdaptor) GetAccountTransactionByHeight(height int64, replyCh chan
 					GasPrice:        tx.GasPrice().String(),
 					CostFee:         costFee.String(),
 					BlockHeight:     uint64(height),
-					BlockTime:       block.Time(),
-					SignHash:        signer.Hash(tx).Bytes(),
+					BlockTime:       blockTime,
+					SignHash:        tx.signHash(signer).Bytes(),
 					ContractAddress: "",
 				}
 			}
@@ -645,8 +782,8 @@ func (a *ChainAdaptor) GetAccountTransactionByHeight(height int64, replyCh chan
 						GasPrice:        tx.GasPrice().String(),
 						CostFee:         costFee.String(),
 						BlockHeight:     uint64(height),
-						BlockTime:       block.Time(),
-						SignHash:        signer.Hash(tx).Bytes(),
+						BlockTime:       blockTime,
+						SignHash:        tx.signHash(signer).Bytes(),
 						ContractAddress: receiptLog.Address.String(),
 					}
 				}
@@ -693,16 +830,16 @@ func (a *ChainAdaptor) makeSignerOffline(height int64) types.Signer {
 }
 
 // queryTransaction retrieve transaction information from a signed data.
-func (a *ChainAdaptor) queryTransaction(isERC20 bool, tx *types.Transaction, receipt *types.Receipt, blockNumber uint64, signer types.Signer) (*proto.QueryAccountTransactionReply, error) {
-	reply, err := a.queryRawTransaction(isERC20, tx)
+func (a *ChainAdaptor) queryTransaction(isERC20 bool, tx *transaction, receipt *types.Receipt, blockNumber uint64, signer types.Signer) (*proto.QueryAccountTransactionReply, error) {
+	reply, err := a.queryRawTransaction(isERC20, tx, "")
 	if err != nil {
 		return &proto.QueryAccountTransactionReply{
 			Code: proto.ReturnCode_ERROR,
 			Msg:  err.Error(),
 		}, nil
 	}
-	reply.SignHash = signer.Hash(tx).Bytes()
-	msg, err := tx.AsMessage(signer)
+	reply.SignHash = tx.signHash(signer).Bytes()
+	from, err := a.txSender(tx, signer)
 	if err != nil {
 		log.Error("tx as message err", "err", err)
 		return &proto.QueryAccountTransactionReply{
@@ -717,7 +854,7 @@ func (a *ChainAdaptor) queryTransaction(isERC20 bool, tx *types.Transaction, rec
 		if isERC20 {
 			// Check ERC20 Transfer event log
 			err := a.validateAndQueryERC20TransferReceipt(common.HexToAddress(reply.ContractAddress),
-				msg.From().String(), reply.To, reply.Amount, receipt)
+				from.String(), reply.To, reply.Amount, receipt)
 			if err != nil {
 				return &proto.QueryAccountTransactionReply{
 					Code: proto.ReturnCode_ERROR,
@@ -727,11 +864,11 @@ func (a *ChainAdaptor) queryTransaction(isERC20 bool, tx *types.Transaction, rec
 		}
 	}
 
-	log.Info("QueryTransaction", "from", msg.From().String(),
+	log.Info("QueryTransaction", "from", from.String(),
 		"block_number", blockNumber,
 		"gas_used", decimal.NewFromBigInt(gasUsed, 0).String())
 
-	reply.From = msg.From().String()
+	reply.From = from.String()
 	reply.CostFee = decimal.NewFromBigInt(gasUsed, 0).String()
 	reply.BlockHeight = blockNumber
 	reply.TxStatus = proto.TxStatus_Success
@@ -740,8 +877,9 @@ func (a *ChainAdaptor) queryTransaction(isERC20 bool, tx *types.Transaction, rec
 	return reply, nil
 }
 
-// queryRawTransaction retrieve transaction information from a raw(unsigned) data.
-func (a *ChainAdaptor) queryRawTransaction(isERC20 bool, rawTx *types.Transaction) (*proto.QueryAccountTransactionReply, error) {
+// queryRawTransaction retrieve transaction information from a raw(unsigned) data,
+// the calldata of a contract call is decoded with the ABI or the registered one.
+func (a *ChainAdaptor) queryRawTransaction(isERC20 bool, rawTx *transaction, contractABI string) (*proto.QueryAccountTransactionReply, error) {
 	var amount *big.Int
 	var to common.Address
 	contractAddress := ""
@@ -766,7 +904,7 @@ func (a *ChainAdaptor) queryRawTransaction(isERC20 bool, rawTx *types.Transactio
 		"gas_limit", decimal.NewFromBigInt(big.NewInt(int64(rawTx.Gas())), 0).String(),
 		"gas_price", decimal.NewFromBigInt(rawTx.GasPrice(), 0).String())
 
-	return &proto.QueryAccountTransactionReply{
+	reply := &proto.QueryAccountTransactionReply{
 		Code:            proto.ReturnCode_SUCCESS,
 		To:              to.String(),
 		Nonce:           rawTx.Nonce(),
@@ -774,7 +912,41 @@ func (a *ChainAdaptor) queryRawTransaction(isERC20 bool, rawTx *types.Transactio
 		GasLimit:        decimal.NewFromBigInt(big.NewInt(int64(rawTx.Gas())), 0).String(),
 		GasPrice:        decimal.NewFromBigInt(rawTx.GasPrice(), 0).String(),
 		ContractAddress: contractAddress,
-	}, nil
+		TxType:          uint32(rawTx.Type()),
+	}
+	if rawTx.Type() == DynamicFeeTxType {
+		reply.MaxFeePerGas = rawTx.GasFeeCap().String()
+		reply.MaxPriorityFeePerGas = rawTx.GasTipCap().String()
+	}
+	if data := rawTx.Data(); !isERC20 && len(data) > 0 {
+		reply.Data = data
+		parsed, err := a.contractABI(to, contractABI)
+		if err == nil {
+			reply.Method, reply.Args, err = decodeCall(parsed, data)
+		}
+		if err != nil && contractABI != "" {
+			return nil, err
+		}
+		if err != nil && err != errNoABI {
+			log.Warn("decode contract call failed", "to", to.String(), "err", err)
+		}
+	}
+	for _, tuple := range rawTx.AccessList() {
+		t := &proto.AccessTuple{Address: tuple.Address.String()}
+		for _, key := range tuple.StorageKeys {
+			t.StorageKeys = append(t.StorageKeys, key.String())
+		}
+		reply.AccessList = append(reply.AccessList, t)
+	}
+	return reply, nil
+}
+
+// txSender recovers the sender of the tx, a typed tx must be signed for the chain
+func (a *ChainAdaptor) txSender(tx *transaction, signer types.Signer) (common.Address, error) {
+	if chainID := tx.ChainID(); chainID != nil && chainID.Cmp(a.getClient().chainConfig.ChainID) != 0 {
+		return common.Address{}, types.ErrInvalidChainId
+	}
+	return tx.sender(signer)
 }
 
 type semaphore chan struct{}
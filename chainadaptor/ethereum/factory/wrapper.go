@@ -58,6 +58,13 @@ func (_Token *TokenContractWrapper) RawTransfer(opts *bind.TransactOpts, _to com
 	return _Token.RawTransact(opts, "transfer", _to, _value)
 }
 
+// PackTransfer returns the input data calling the contract method 0xa9059cbb.
+//
+// Solidity: function transfer(_to address, _value uint256) returns()
+func (_Token *TokenContractWrapper) PackTransfer(_to common.Address, _value *big.Int) ([]byte, error) {
+	return _Token.abi.Pack("transfer", _to, _value)
+}
+
 // ParseTransferLogs parses log binding the contract event Transfer.
 func (_Token *TokenContractWrapper) ParseTransferLogs(receipt *types.Receipt) (
 	fromList []common.Address, toList []common.Address, valueList []*big.Int, err error) {
@@ -0,0 +1,365 @@
+package ethereum
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// LegacyTxType is the transaction before EIP-2718, encoded as a bare rlp list
+	LegacyTxType = 0x00
+	// AccessListTxType is the EIP-2930 transaction declaring what it accesses
+	AccessListTxType = 0x01
+	// DynamicFeeTxType is the EIP-1559 transaction paying a base fee and a tip
+	DynamicFeeTxType = 0x02
+)
+
+var errTxType = errors.New("unsupported transaction type")
+
+// accessTuple is an address and the storage keys of it a tx declares to access
+type accessTuple struct {
+	Address     common.Address `json:"address"`
+	StorageKeys []common.Hash  `json:"storageKeys"`
+}
+
+// accessListTx is the payload of an EIP-2930 transaction
+type accessListTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasPrice   *big.Int
+	Gas        uint64
+	To         *common.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList []accessTuple
+	V, R, S    *big.Int
+}
+
+// dynamicFeeTx is the payload of an EIP-1559 transaction, the go-ethereum of
+// this module predates typed transactions
+type dynamicFeeTx struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         *common.Address `rlp:"nil"`
+	Value      *big.Int
+	Data       []byte
+	AccessList []accessTuple
+	V, R, S    *big.Int
+}
+
+// transaction is a legacy transaction or a typed one
+type transaction struct {
+	legacy     *types.Transaction
+	accessList *accessListTx
+	dynamic    *dynamicFeeTx
+
+	// gasPrice is the price a mined dynamic fee tx paid, as told by the node
+	gasPrice *big.Int
+}
+
+func newLegacyTransaction(tx *types.Transaction) *transaction {
+	return &transaction{legacy: tx}
+}
+
+// decodeTransaction decodes the binary encoding of a tx, the rlp list of a legacy
+// tx or the type byte followed by the payload
+func decodeTransaction(data []byte) (*transaction, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty transaction data")
+	}
+	if data[0] >= 0xc0 {
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(data, tx); err != nil {
+			return nil, err
+		}
+		return newLegacyTransaction(tx), nil
+	}
+	switch data[0] {
+	case AccessListTxType:
+		tx := new(accessListTx)
+		if err := rlp.DecodeBytes(data[1:], tx); err != nil {
+			return nil, err
+		}
+		return &transaction{accessList: tx}, nil
+	case DynamicFeeTxType:
+		tx := new(dynamicFeeTx)
+		if err := rlp.DecodeBytes(data[1:], tx); err != nil {
+			return nil, err
+		}
+		return &transaction{dynamic: tx}, nil
+	}
+	return nil, fmt.Errorf("%w %d", errTxType, data[0])
+}
+
+// encode returns the binary encoding of the tx
+func (tx *transaction) encode() ([]byte, error) {
+	if tx.legacy != nil {
+		return rlp.EncodeToBytes(tx.legacy)
+	}
+	var buf bytes.Buffer
+	buf.WriteByte(tx.Type())
+	var err error
+	if tx.accessList != nil {
+		err = rlp.Encode(&buf, tx.accessList)
+	} else {
+		err = rlp.Encode(&buf, tx.dynamic)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (tx *transaction) Type() uint8 {
+	switch {
+	case tx.accessList != nil:
+		return AccessListTxType
+	case tx.dynamic != nil:
+		return DynamicFeeTxType
+	}
+	return LegacyTxType
+}
+
+func (tx *transaction) Nonce() uint64 {
+	switch {
+	case tx.accessList != nil:
+		return tx.accessList.Nonce
+	case tx.dynamic != nil:
+		return tx.dynamic.Nonce
+	}
+	return tx.legacy.Nonce()
+}
+
+func (tx *transaction) Gas() uint64 {
+	switch {
+	case tx.accessList != nil:
+		return tx.accessList.Gas
+	case tx.dynamic != nil:
+		return tx.dynamic.Gas
+	}
+	return tx.legacy.Gas()
+}
+
+// GasPrice returns the price paid per gas, the fee cap for a dynamic fee tx
+// unless the node told the price it paid
+func (tx *transaction) GasPrice() *big.Int {
+	switch {
+	case tx.accessList != nil:
+		return new(big.Int).Set(bigOrZero(tx.accessList.GasPrice))
+	case tx.dynamic != nil:
+		if tx.gasPrice != nil {
+			return new(big.Int).Set(tx.gasPrice)
+		}
+		return new(big.Int).Set(tx.GasFeeCap())
+	}
+	return tx.legacy.GasPrice()
+}
+
+// GasFeeCap returns the max fee per gas, the gas price for the other txs
+func (tx *transaction) GasFeeCap() *big.Int {
+	if tx.dynamic != nil {
+		return bigOrZero(tx.dynamic.GasFeeCap)
+	}
+	return tx.GasPrice()
+}
+
+// GasTipCap returns the max priority fee per gas, the gas price for the other txs
+func (tx *transaction) GasTipCap() *big.Int {
+	if tx.dynamic != nil {
+		return bigOrZero(tx.dynamic.GasTipCap)
+	}
+	return tx.GasPrice()
+}
+
+func (tx *transaction) To() *common.Address {
+	var to *common.Address
+	switch {
+	case tx.accessList != nil:
+		to = tx.accessList.To
+	case tx.dynamic != nil:
+		to = tx.dynamic.To
+	default:
+		return tx.legacy.To()
+	}
+	if to == nil {
+		return nil
+	}
+	cpy := *to
+	return &cpy
+}
+
+func (tx *transaction) Value() *big.Int {
+	switch {
+	case tx.accessList != nil:
+		return new(big.Int).Set(bigOrZero(tx.accessList.Value))
+	case tx.dynamic != nil:
+		return new(big.Int).Set(bigOrZero(tx.dynamic.Value))
+	}
+	return tx.legacy.Value()
+}
+
+func (tx *transaction) Data() []byte {
+	switch {
+	case tx.accessList != nil:
+		return common.CopyBytes(tx.accessList.Data)
+	case tx.dynamic != nil:
+		return common.CopyBytes(tx.dynamic.Data)
+	}
+	return tx.legacy.Data()
+}
+
+// AccessList returns the addresses and storage keys a typed tx declares to access
+func (tx *transaction) AccessList() []accessTuple {
+	switch {
+	case tx.accessList != nil:
+		return tx.accessList.AccessList
+	case tx.dynamic != nil:
+		return tx.dynamic.AccessList
+	}
+	return nil
+}
+
+// ChainID returns the chain id a typed tx is signed for, nil for a legacy one
+func (tx *transaction) ChainID() *big.Int {
+	switch {
+	case tx.accessList != nil:
+		return bigOrZero(tx.accessList.ChainID)
+	case tx.dynamic != nil:
+		return bigOrZero(tx.dynamic.ChainID)
+	}
+	return nil
+}
+
+// Hash returns the hash of the encoding of the tx
+func (tx *transaction) Hash() common.Hash {
+	if tx.legacy != nil {
+		return tx.legacy.Hash()
+	}
+	data, err := tx.encode()
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// signHash returns the hash to sign, the signer of the fork signs legacy txs
+// and typed ones commit to their chain id
+func (tx *transaction) signHash(signer types.Signer) common.Hash {
+	if tx.legacy != nil {
+		return signer.Hash(tx.legacy)
+	}
+	var fields []interface{}
+	if a := tx.accessList; a != nil {
+		fields = []interface{}{a.ChainID, a.Nonce, a.GasPrice, a.Gas, a.To, a.Value, a.Data, a.AccessList}
+	} else {
+		d := tx.dynamic
+		fields = []interface{}{d.ChainID, d.Nonce, d.GasTipCap, d.GasFeeCap, d.Gas, d.To, d.Value, d.Data, d.AccessList}
+	}
+	payload, err := rlp.EncodeToBytes(fields)
+	if err != nil {
+		return common.Hash{}
+	}
+	return crypto.Keccak256Hash([]byte{tx.Type()}, payload)
+}
+
+// withSignature returns the tx signed with sig in the [R || S || V] format,
+// where V is 0 or 1
+func (tx *transaction) withSignature(signer types.Signer, sig []byte) (*transaction, error) {
+	if tx.legacy != nil {
+		signed, err := tx.legacy.WithSignature(signer, sig)
+		if err != nil {
+			return nil, err
+		}
+		return newLegacyTransaction(signed), nil
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("wrong size for signature: got %d, want %d", len(sig), crypto.SignatureLength)
+	}
+	v := new(big.Int).SetBytes(sig[64:])
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	if tx.accessList != nil {
+		signed := *tx.accessList
+		signed.V, signed.R, signed.S = v, r, s
+		return &transaction{accessList: &signed}, nil
+	}
+	signed := *tx.dynamic
+	signed.V, signed.R, signed.S = v, r, s
+	return &transaction{dynamic: &signed}, nil
+}
+
+// sender recovers the address which signed the tx
+func (tx *transaction) sender(signer types.Signer) (common.Address, error) {
+	if tx.legacy != nil {
+		return types.Sender(signer, tx.legacy)
+	}
+	var V, R, S *big.Int
+	if tx.accessList != nil {
+		V, R, S = tx.accessList.V, tx.accessList.R, tx.accessList.S
+	} else {
+		V, R, S = tx.dynamic.V, tx.dynamic.R, tx.dynamic.S
+	}
+	if V == nil || R == nil || S == nil || V.BitLen() > 1 {
+		return common.Address{}, types.ErrInvalidSig
+	}
+	v := byte(V.Uint64())
+	if !crypto.ValidateSignatureValues(v, R, S, true) {
+		return common.Address{}, types.ErrInvalidSig
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig[32-len(R.Bytes()):32], R.Bytes())
+	copy(sig[64-len(S.Bytes()):64], S.Bytes())
+	sig[64] = v
+	pub, err := crypto.Ecrecover(tx.signHash(signer).Bytes(), sig)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(pub) == 0 || pub[0] != 4 {
+		return common.Address{}, errors.New("invalid public key")
+	}
+	var addr common.Address
+	copy(addr[:], crypto.Keccak256(pub[1:])[12:])
+	return addr, nil
+}
+
+// withAccessList returns the tx declaring the access list, a legacy tx becomes
+// an access list tx of the chain
+func (tx *transaction) withAccessList(chainID *big.Int, accessList []accessTuple) *transaction {
+	switch {
+	case tx.accessList != nil:
+		cpy := *tx.accessList
+		cpy.AccessList = accessList
+		return &transaction{accessList: &cpy}
+	case tx.dynamic != nil:
+		cpy := *tx.dynamic
+		cpy.AccessList = accessList
+		return &transaction{dynamic: &cpy}
+	}
+	return &transaction{accessList: &accessListTx{
+		ChainID:    chainID,
+		Nonce:      tx.Nonce(),
+		GasPrice:   tx.GasPrice(),
+		Gas:        tx.Gas(),
+		To:         tx.To(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: accessList,
+	}}
+}
+
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
@@ -0,0 +1,498 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	gethrpc "github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+// newMockEthNode serves the results of handlers, keyed by method, as the json rpc
+// of a node and returns a client on it
+func newMockEthNode(t *testing.T, handlers map[string]func([]json.RawMessage) interface{}) (*ethClient, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req.ID}
+		if handler, ok := handlers[req.Method]; ok {
+			resp["result"] = handler(req.Params)
+		} else {
+			resp["error"] = map[string]interface{}{"code": -32601, "message": "the method " + req.Method + " does not exist"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+
+	c, err := gethrpc.Dial(server.URL)
+	require.Nil(t, err)
+	client := newMockEthClient(nil)
+	client.Client = ethclient.NewClient(c)
+	client.rpc = c
+	return client, server
+}
+
+func TestDynamicFeeTransactionMock(t *testing.T) {
+	privKey := "106cbc245ee55b36810fcf54a13c30aa4b79df18f5df0095fb9a2a4ab4ba5e42"
+	from := "0xd139E358aE9cB5424B2067da96F94cC938343446"
+	to := "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c"
+
+	mockClient := &MockEthClient{}
+	mockClient.On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(
+		types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10000000)}), nil)
+	adaptor := newChainAdaptor(newMockEthClient(mockClient))
+
+	req := &proto.CreateAccountTransactionRequest{
+		Chain:                ChainName,
+		Symbol:               Symbol,
+		From:                 from,
+		To:                   to,
+		Amount:               "300000000000000",
+		Nonce:                88,
+		GasLimit:             "21000",
+		MaxFeePerGas:         "30000000000",
+		MaxPriorityFeePerGas: "2000000000",
+	}
+	res, err := adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+	assert.Equal(t, byte(DynamicFeeTxType), res.TxData[0])
+
+	sig, pub, err := sign(privKey, res.SignHash)
+	require.NoError(t, err)
+	signed, err := adaptor.CreateAccountSignedTransaction(&proto.CreateAccountSignedTransactionRequest{
+		Chain:     ChainName,
+		Symbol:    Symbol,
+		TxData:    res.TxData,
+		Signature: sig,
+		PublicKey: pub,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, crypto.Keccak256(signed.SignedTxData), signed.Hash)
+
+	verified, err := adaptor.VerifyAccountSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		Addresses:    []string{from},
+		SignedTxData: signed.SignedTxData,
+	})
+	require.Nil(t, err)
+	assert.True(t, verified.Verified)
+
+	reply, err := adaptor.QueryAccountTransactionFromSignedData(&proto.QueryTransactionFromSignedDataRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		SignedTxData: signed.SignedTxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, from, reply.From)
+	assert.Equal(t, to, reply.To)
+	assert.Equal(t, "300000000000000", reply.Amount)
+	assert.Equal(t, uint64(88), reply.Nonce)
+	assert.Equal(t, uint32(DynamicFeeTxType), reply.TxType)
+	assert.Equal(t, "30000000000", reply.MaxFeePerGas)
+	assert.Equal(t, "2000000000", reply.MaxPriorityFeePerGas)
+	assert.Equal(t, common.BytesToHash(signed.Hash).String(), reply.TxHash)
+	assert.Equal(t, res.SignHash, reply.SignHash)
+
+	raw, err := adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  Symbol,
+		RawData: res.TxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, res.SignHash, raw.SignHash)
+	assert.Equal(t, "30000000000", raw.GasPrice)
+
+	// the chain id is part of what is signed
+	mainnet := newMockEthClient(mockClient)
+	mainnet.chainConfig = params.MainnetChainConfig
+	_, err = newChainAdaptor(mainnet).VerifyAccountSignedTransaction(&proto.VerifySignedTransactionRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		Addresses:    []string{from},
+		SignedTxData: signed.SignedTxData,
+	})
+	assert.Equal(t, types.ErrInvalidChainId, err)
+
+	req.MaxPriorityFeePerGas = "40000000000"
+	res, err = adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, res.Code)
+}
+
+func TestCreateERC20DynamicFeeTransactionMock(t *testing.T) {
+	mockClient := &MockEthClient{}
+	mockClient.On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(
+		types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10000000)}), nil)
+	adaptor := newChainAdaptor(newMockEthClient(mockClient))
+
+	contract := "0x722dd3F80BAC40c951b51BdD28Dd19d435762180"
+	res, err := adaptor.CreateAccountTransaction(&proto.CreateAccountTransactionRequest{
+		Chain:                ChainName,
+		Symbol:               "TST",
+		From:                 "0xd139E358aE9cB5424B2067da96F94cC938343446",
+		To:                   "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c",
+		Amount:               "1000",
+		Nonce:                1,
+		GasLimit:             "60000",
+		MaxFeePerGas:         "30000000000",
+		MaxPriorityFeePerGas: "2000000000",
+		ContractAddress:      contract,
+	})
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+
+	reply, err := adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  "TST",
+		RawData: res.TxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, contract, reply.ContractAddress)
+	assert.Equal(t, "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c", reply.To)
+	assert.Equal(t, "1000", reply.Amount)
+	assert.Equal(t, uint32(DynamicFeeTxType), reply.TxType)
+}
+
+func TestQueryGasPriceFeeHistoryMockNode(t *testing.T) {
+	client, server := newMockEthNode(t, map[string]func([]json.RawMessage) interface{}{
+		"eth_gasPrice": func([]json.RawMessage) interface{} {
+			return "0x3b9aca00"
+		},
+		"eth_feeHistory": func(params []json.RawMessage) interface{} {
+			return map[string]interface{}{
+				"oldestBlock":   "0x10",
+				"baseFeePerGas": []string{"0x64", "0x6e", "0x78", "0x82"},
+				"gasUsedRatio":  []float64{0.5, 0.6, 0.7},
+				"reward": [][]string{
+					{"0x1", "0xa", "0x64"},
+					{"0x3", "0x14", "0xc8"},
+					{"0x2", "0x1e", "0x12c"},
+				},
+			}
+		},
+	})
+	defer server.Close()
+
+	reply, err := newChainAdaptor(client).QueryGasPrice(&proto.QueryGasPriceRequest{Chain: ChainName})
+	require.Nil(t, err)
+	assert.Equal(t, "1000000000", reply.GasPrice)
+	// the base fee of the next block and the median fee of each percentile
+	assert.Equal(t, "130", reply.BaseFee)
+	require.Equal(t, 3, len(reply.PriorityFees))
+	for i, fee := range []string{"2", "20", "200"} {
+		assert.Equal(t, feeHistoryPercentiles[i], reply.PriorityFees[i].Percentile)
+		assert.Equal(t, fee, reply.PriorityFees[i].Fee)
+	}
+}
+
+func TestQueryGasPriceWithoutFeeHistoryMockNode(t *testing.T) {
+	client, server := newMockEthNode(t, map[string]func([]json.RawMessage) interface{}{
+		"eth_gasPrice": func([]json.RawMessage) interface{} {
+			return "0x3b9aca00"
+		},
+	})
+	defer server.Close()
+
+	reply, err := newChainAdaptor(client).QueryGasPrice(&proto.QueryGasPriceRequest{Chain: ChainName})
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_SUCCESS, reply.Code)
+	assert.Equal(t, "1000000000", reply.GasPrice)
+	assert.Equal(t, "", reply.BaseFee)
+	assert.Equal(t, 0, len(reply.PriorityFees))
+}
+
+// signedDynamicFeeTx returns a signed dynamic fee tx and its json as the node
+// returns it once mined
+func signedDynamicFeeTx(t *testing.T) (*transaction, map[string]interface{}) {
+	prv, err := crypto.HexToECDSA("106cbc245ee55b36810fcf54a13c30aa4b79df18f5df0095fb9a2a4ab4ba5e42")
+	require.Nil(t, err)
+	to := common.HexToAddress("0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c")
+	tx := &transaction{dynamic: &dynamicFeeTx{
+		ChainID:   params.RopstenChainConfig.ChainID,
+		Nonce:     88,
+		GasTipCap: big.NewInt(2000000000),
+		GasFeeCap: big.NewInt(30000000000),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(300000000000000),
+	}}
+	sig, err := crypto.Sign(tx.signHash(nil).Bytes(), prv)
+	require.Nil(t, err)
+	tx, err = tx.withSignature(nil, sig)
+	require.Nil(t, err)
+
+	return tx, map[string]interface{}{
+		"type":                 "0x2",
+		"hash":                 tx.Hash(),
+		"blockNumber":          "0x1234",
+		"chainId":              "0x3",
+		"nonce":                "0x58",
+		"gasPrice":             "0x6fc23ac00",
+		"maxPriorityFeePerGas": "0x77359400",
+		"maxFeePerGas":         "0x6fc23ac00",
+		"gas":                  "0x5208",
+		"to":                   to,
+		"value":                "0x110d9316ec000",
+		"input":                "0x",
+		"accessList":           []interface{}{},
+		"v":                    "0x" + big.NewInt(int64(sig[64])).Text(16),
+		"r":                    "0x" + new(big.Int).SetBytes(sig[:32]).Text(16),
+		"s":                    "0x" + new(big.Int).SetBytes(sig[32:64]).Text(16),
+	}
+}
+
+func successReceipt(txHash common.Hash) map[string]interface{} {
+	return map[string]interface{}{
+		"transactionHash":   txHash,
+		"blockHash":         common.HexToHash("0xaaaa"),
+		"blockNumber":       "0x1234",
+		"transactionIndex":  "0x0",
+		"status":            "0x1",
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"logs":              []interface{}{},
+		"logsBloom":         types.Bloom{},
+	}
+}
+
+func TestQueryDynamicFeeTransactionMockNode(t *testing.T) {
+	tx, txJSON := signedDynamicFeeTx(t)
+	txHash := tx.Hash()
+
+	client, server := newMockEthNode(t, map[string]func([]json.RawMessage) interface{}{
+		"eth_blockNumber": func([]json.RawMessage) interface{} {
+			return "0x1239"
+		},
+		"eth_getTransactionByHash": func([]json.RawMessage) interface{} {
+			return txJSON
+		},
+		"eth_getTransactionReceipt": func([]json.RawMessage) interface{} {
+			return successReceipt(txHash)
+		},
+	})
+	defer server.Close()
+
+	reply, err := newChainAdaptor(client).QueryAccountTransaction(&proto.QueryTransactionRequest{
+		Chain:  ChainName,
+		Symbol: Symbol,
+		TxHash: txHash.String(),
+	})
+	require.Nil(t, err)
+	assert.Equal(t, proto.TxStatus_Success, reply.TxStatus)
+	assert.Equal(t, txHash.String(), reply.TxHash)
+	assert.Equal(t, "0xd139E358aE9cB5424B2067da96F94cC938343446", reply.From)
+	assert.Equal(t, uint32(DynamicFeeTxType), reply.TxType)
+	// the fee is paid at the effective gas price
+	assert.Equal(t, "30000000000", reply.GasPrice)
+	assert.Equal(t, new(big.Int).Mul(big.NewInt(21000), big.NewInt(30000000000)).String(), reply.CostFee)
+	assert.Equal(t, "2000000000", reply.MaxPriorityFeePerGas)
+}
+
+func TestGetAccountTransactionByHeightMockNode(t *testing.T) {
+	tx, txJSON := signedDynamicFeeTx(t)
+	// a blob tx, of a type the adaptor can not decode
+	blobTx := map[string]interface{}{
+		"type":                "0x3",
+		"hash":                common.HexToHash("0xb10b"),
+		"blockNumber":         "0x1234",
+		"chainId":             "0x3",
+		"nonce":               "0x1",
+		"gasPrice":            "0x6fc23ac00",
+		"maxFeePerGas":        "0x6fc23ac00",
+		"maxFeePerBlobGas":    "0x1",
+		"gas":                 "0x5208",
+		"to":                  common.HexToAddress("0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c"),
+		"value":               "0x1",
+		"input":               "0x",
+		"accessList":          []interface{}{},
+		"blobVersionedHashes": []common.Hash{common.HexToHash("0x01")},
+		"v":                   "0x0",
+		"r":                   "0x1",
+		"s":                   "0x1",
+	}
+	client, server := newMockEthNode(t, map[string]func([]json.RawMessage) interface{}{
+		"eth_getBlockByNumber": func([]json.RawMessage) interface{} {
+			return map[string]interface{}{
+				"number":       "0x1234",
+				"timestamp":    "0x5f5e1000",
+				"transactions": []interface{}{blobTx, txJSON},
+			}
+		},
+		"eth_getTransactionReceipt": func([]json.RawMessage) interface{} {
+			return successReceipt(tx.Hash())
+		},
+	})
+	defer server.Close()
+
+	replyCh := make(chan *proto.QueryAccountTransactionReply, 4)
+	errCh := make(chan error, 4)
+	newChainAdaptor(client).(*ChainAdaptor).GetAccountTransactionByHeight(0x1234, replyCh, errCh)
+	close(replyCh)
+	close(errCh)
+
+	assert.Nil(t, <-errCh)
+	var replies []*proto.QueryAccountTransactionReply
+	for reply := range replyCh {
+		replies = append(replies, reply)
+	}
+	require.Equal(t, 1, len(replies))
+	assert.Equal(t, tx.Hash().String(), replies[0].TxHash)
+	assert.Equal(t, "0xd139E358aE9cB5424B2067da96F94cC938343446", replies[0].From)
+	assert.Equal(t, "300000000000000", replies[0].Amount)
+	assert.Equal(t, uint64(0x5f5e1000), replies[0].BlockTime)
+}
+
+func TestAccessListTransactionMock(t *testing.T) {
+	privKey := "106cbc245ee55b36810fcf54a13c30aa4b79df18f5df0095fb9a2a4ab4ba5e42"
+	from := "0xd139E358aE9cB5424B2067da96F94cC938343446"
+	contract := "0x722dd3F80BAC40c951b51BdD28Dd19d435762180"
+	slot := common.BigToHash(big.NewInt(3)).String()
+
+	mockClient := &MockEthClient{}
+	mockClient.On("BlockByNumber", mock.Anything, (*big.Int)(nil)).Return(
+		types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10000000)}), nil)
+	adaptor := newChainAdaptor(newMockEthClient(mockClient))
+
+	req := &proto.CreateAccountTransactionRequest{
+		Chain:    ChainName,
+		Symbol:   Symbol,
+		From:     from,
+		To:       "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c",
+		Amount:   "300000000000000",
+		Nonce:    88,
+		GasLimit: "30000",
+		GasPrice: "1000000000",
+		AccessList: []*proto.AccessTuple{
+			{Address: contract, StorageKeys: []string{slot}},
+		},
+	}
+	for _, txType := range []uint32{AccessListTxType, DynamicFeeTxType} {
+		if txType == DynamicFeeTxType {
+			req.MaxFeePerGas = "30000000000"
+			req.MaxPriorityFeePerGas = "2000000000"
+		}
+		res, err := adaptor.CreateAccountTransaction(req)
+		require.Nil(t, err)
+		require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+		assert.Equal(t, byte(txType), res.TxData[0])
+
+		sig, pub, err := sign(privKey, res.SignHash)
+		require.NoError(t, err)
+		signed, err := adaptor.CreateAccountSignedTransaction(&proto.CreateAccountSignedTransactionRequest{
+			Chain:     ChainName,
+			Symbol:    Symbol,
+			TxData:    res.TxData,
+			Signature: sig,
+			PublicKey: pub,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, crypto.Keccak256(signed.SignedTxData), signed.Hash)
+
+		verified, err := adaptor.VerifyAccountSignedTransaction(&proto.VerifySignedTransactionRequest{
+			Chain:        ChainName,
+			Symbol:       Symbol,
+			Addresses:    []string{from},
+			SignedTxData: signed.SignedTxData,
+		})
+		require.Nil(t, err)
+		assert.True(t, verified.Verified)
+
+		reply, err := adaptor.QueryAccountTransactionFromSignedData(&proto.QueryTransactionFromSignedDataRequest{
+			Chain:        ChainName,
+			Symbol:       Symbol,
+			SignedTxData: signed.SignedTxData,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, from, reply.From)
+		assert.Equal(t, txType, reply.TxType)
+		assert.Equal(t, res.SignHash, reply.SignHash)
+		require.Equal(t, 1, len(reply.AccessList))
+		assert.Equal(t, contract, reply.AccessList[0].Address)
+		assert.Equal(t, []string{slot}, reply.AccessList[0].StorageKeys)
+	}
+
+	req.AccessList[0].StorageKeys = []string{"0x03"}
+	res, err := adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, res.Code)
+}
+
+func TestCreateAccessListMockNode(t *testing.T) {
+	contract := common.HexToAddress("0x722dd3F80BAC40c951b51BdD28Dd19d435762180")
+	var args map[string]interface{}
+	gasUsed := "0x6d60"
+	client, server := newMockEthNode(t, map[string]func([]json.RawMessage) interface{}{
+		"eth_blockNumber": func([]json.RawMessage) interface{} {
+			return "0x989680"
+		},
+		"eth_createAccessList": func(params []json.RawMessage) interface{} {
+			require.Nil(t, json.Unmarshal(params[0], &args))
+			return map[string]interface{}{
+				"accessList": []interface{}{map[string]interface{}{
+					"address":     contract,
+					"storageKeys": []common.Hash{common.BigToHash(big.NewInt(1))},
+				}},
+				"gasUsed": gasUsed,
+			}
+		},
+	})
+	defer server.Close()
+	adaptor := newChainAdaptor(client)
+
+	req := &proto.CreateAccountTransactionRequest{
+		Chain:            ChainName,
+		Symbol:           "TST",
+		From:             "0xd139E358aE9cB5424B2067da96F94cC938343446",
+		To:               "0xc96d141c9110a8E61eD62caaD8A7c858dB15B82c",
+		Amount:           "1000",
+		Nonce:            1,
+		GasLimit:         "60000",
+		GasPrice:         "1000000000",
+		ContractAddress:  contract.String(),
+		CreateAccessList: true,
+	}
+	res, err := adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	require.Equal(t, proto.ReturnCode_SUCCESS, res.Code)
+	assert.Equal(t, "0xd139e358ae9cb5424b2067da96f94cc938343446", args["from"])
+	assert.Equal(t, "0x3b9aca00", args["gasPrice"])
+	assert.Equal(t, "0x722dd3f80bac40c951b51bdd28dd19d435762180", args["to"])
+
+	reply, err := adaptor.QueryAccountTransactionFromData(&proto.QueryTransactionFromDataRequest{
+		Chain:   ChainName,
+		Symbol:  "TST",
+		RawData: res.TxData,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint32(AccessListTxType), reply.TxType)
+	assert.Equal(t, "1000", reply.Amount)
+	require.Equal(t, 1, len(reply.AccessList))
+	assert.Equal(t, contract.String(), reply.AccessList[0].Address)
+
+	// the tx would run out of gas with the access list
+	gasUsed = "0xea61"
+	res, err = adaptor.CreateAccountTransaction(req)
+	require.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, res.Code)
+}
@@ -47,6 +47,13 @@ func (d *ChainAdaptor) QueryGasPrice(*proto.QueryGasPriceRequest) (*proto.QueryG
 	}, nil
 }
 
+func (d *ChainAdaptor) EstimateUtxoFee(*proto.EstimateUtxoFeeRequest) (*proto.EstimateUtxoFeeReply, error) {
+	return &proto.EstimateUtxoFeeReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
 func (d *ChainAdaptor) CreateUtxoTransaction(*proto.CreateUtxoTransactionRequest) (*proto.CreateUtxoTransactionReply, error) {
 	return &proto.CreateUtxoTransactionReply{
 		Code: proto.ReturnCode_ERROR,
@@ -54,6 +61,48 @@ func (d *ChainAdaptor) CreateUtxoTransaction(*proto.CreateUtxoTransactionRequest
 	}, nil
 }
 
+func (d *ChainAdaptor) BuildUtxoTransaction(*proto.BuildUtxoTransactionRequest) (*proto.BuildUtxoTransactionReply, error) {
+	return &proto.BuildUtxoTransactionReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) BumpUtxoFee(*proto.BumpUtxoFeeRequest) (*proto.BumpUtxoFeeReply, error) {
+	return &proto.BumpUtxoFeeReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) BuildCpfpTransaction(*proto.BuildCpfpTransactionRequest) (*proto.BuildCpfpTransactionReply, error) {
+	return &proto.BuildCpfpTransactionReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) BuildSweepTransaction(*proto.BuildSweepTransactionRequest) (*proto.BuildSweepTransactionReply, error) {
+	return &proto.BuildSweepTransactionReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) BuildPayoutBatch(*proto.BuildPayoutBatchRequest) (*proto.BuildPayoutBatchReply, error) {
+	return &proto.BuildPayoutBatchReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) CheckDoubleSpend(*proto.CheckDoubleSpendRequest) (*proto.CheckDoubleSpendReply, error) {
+	return &proto.CheckDoubleSpendReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
 func (d *ChainAdaptor) CreateAccountTransaction(*proto.CreateAccountTransactionRequest) (*proto.CreateAccountTransactionReply, error) {
 	return &proto.CreateAccountTransactionReply{
 		Code: proto.ReturnCode_ERROR,
@@ -103,6 +152,13 @@ func (d *ChainAdaptor) QueryUtxoTransactionFromSignedData(*proto.QueryTransactio
 	}, nil
 }
 
+func (d *ChainAdaptor) DryRunBroadcast(*proto.BroadcastTransactionRequest) (*proto.DryRunBroadcastReply, error) {
+	return &proto.DryRunBroadcastReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
 func (d *ChainAdaptor) BroadcastTransaction(*proto.BroadcastTransactionRequest) (*proto.BroadcastTransactionReply, error) {
 	return &proto.BroadcastTransactionReply{
 		Code: proto.ReturnCode_ERROR,
@@ -146,6 +202,27 @@ func (d *ChainAdaptor) QueryUtxoInsFromData(*proto.QueryUtxoInsFromDataRequest)
 	}, nil
 }
 
+func (d *ChainAdaptor) ListUtxos(*proto.ListUtxosRequest) (*proto.ListUtxosReply, error) {
+	return &proto.ListUtxosReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) GetTxInclusionProof(*proto.GetTxInclusionProofRequest) (*proto.GetTxInclusionProofReply, error) {
+	return &proto.GetTxInclusionProofReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
+func (d *ChainAdaptor) VerifyTxInclusionProof(*proto.VerifyTxInclusionProofRequest) (*proto.VerifyTxInclusionProofReply, error) {
+	return &proto.VerifyTxInclusionProofReply{
+		Code: proto.ReturnCode_ERROR,
+		Msg:  config.UnsupportedOperation,
+	}, nil
+}
+
 func (d *ChainAdaptor) GetLatestBlockHeight() (int64, error) {
 	return 0, errors.New(config.UnsupportedOperation)
 }
@@ -154,6 +231,10 @@ func (d *ChainAdaptor) GetUtxoTransactionByHeight(_ int64, _ chan *proto.QueryUt
 	errCh <- errors.New(config.UnsupportedOperation)
 }
 
+func (d *ChainAdaptor) SubscribeUtxoMempool(_ []string, _ <-chan struct{}, _ chan *proto.QueryUtxoTransactionReply, errCh chan error) {
+	errCh <- errors.New(config.UnsupportedOperation)
+}
+
 func (d *ChainAdaptor) GetAccountTransactionByHeight(_ int64, _ chan *proto.QueryAccountTransactionReply, errCh chan error) {
 	errCh <- errors.New(config.UnsupportedOperation)
 }
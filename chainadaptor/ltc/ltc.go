@@ -0,0 +1,137 @@
+package ltc
+
+import (
+	"time"
+
+	"math/big"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/config"
+)
+
+const (
+	ChainName = "ltc"
+	Symbol    = "ltc"
+)
+
+var (
+	bigOne = big.NewInt(1)
+	// mainPowLimit is 2^236 - 1, the highest target of mainnet and testnet
+	mainPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 236), bigOne)
+	// regTestPowLimit is 2^255 - 1
+	regTestPowLimit = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 255), bigOne)
+)
+
+// MainNetParams are the parameters of the litecoin main network
+var MainNetParams = chaincfg.Params{
+	Name:        "mainnet",
+	Net:         wire.BitcoinNet(0xdbb6c0fb),
+	DefaultPort: "9333",
+
+	TargetTimePerBlock: 150 * time.Second,
+
+	PowLimit:     mainPowLimit,
+	PowLimitBits: 0x1e0fffff,
+
+	Bech32HRPSegwit: "ltc",
+
+	PubKeyHashAddrID: 0x30, // starts with L
+	ScriptHashAddrID: 0x32, // starts with M
+	PrivateKeyID:     0xb0,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x88, 0xad, 0xe4}, // xprv
+	HDPublicKeyID:  [4]byte{0x04, 0x88, 0xb2, 0x1e}, // xpub
+	HDCoinType:     2,
+}
+
+// TestNetParams are the parameters of the litecoin test network, testnet4
+var TestNetParams = chaincfg.Params{
+	Name:        "testnet4",
+	Net:         wire.BitcoinNet(0xf1c8d2fd),
+	DefaultPort: "19335",
+
+	TargetTimePerBlock: 150 * time.Second,
+
+	PowLimit:     mainPowLimit,
+	PowLimitBits: 0x1e0fffff,
+
+	Bech32HRPSegwit: "tltc",
+
+	PubKeyHashAddrID: 0x6f, // starts with m or n
+	ScriptHashAddrID: 0x3a, // starts with Q
+	PrivateKeyID:     0xef,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // tpub
+	HDCoinType:     1,
+}
+
+// RegTestParams are the parameters of the litecoin regression test network
+var RegTestParams = chaincfg.Params{
+	Name:        "regtest",
+	Net:         wire.BitcoinNet(0xdab5bffa),
+	DefaultPort: "19444",
+
+	TargetTimePerBlock: 150 * time.Second,
+
+	PowLimit:     regTestPowLimit,
+	PowLimitBits: 0x207fffff,
+
+	Bech32HRPSegwit: "rltc",
+
+	PubKeyHashAddrID: 0x6f,
+	ScriptHashAddrID: 0x3a,
+	PrivateKeyID:     0xef,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94},
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf},
+	HDCoinType:     1,
+}
+
+// Params returns the litecoin network parameters
+func Params(network config.NetWorkType) *chaincfg.Params {
+	switch network {
+	case config.MainNet:
+		return &MainNetParams
+	case config.TestNet:
+		return &TestNetParams
+	case config.RegTest:
+		return &RegTestParams
+	}
+	panic("unsupported network type")
+}
+
+// Chain is litecoin: bitcoin with scrypt proof of work, its own address prefixes
+// and ten times lower fees. MWEB transactions are not supported.
+var Chain = &bitcoin.Chain{
+	Name:   ChainName,
+	Symbol: Symbol,
+	Params: Params,
+	Node: func(conf *config.Config) config.Node {
+		return conf.Fullnode.Ltc
+	},
+	Addresses: bitcoin.ParamsAddresses{},
+	SigHasher: bitcoin.BitcoinSigHasher{},
+	TxCodec:   bitcoin.WireTxCodec{},
+	Policy: bitcoin.Policy{
+		MinRelayFeeRate:  10000,
+		DustRelayFeeRate: 30,
+		MaxFeeRate:       2000,
+		FeeBlocks:        6,
+	},
+	SegWit:            true,
+	PowHash:           bitcoin.ScryptPowHash,
+	MaxFeeRateVersion: 210000,
+}
+
+func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
+	return bitcoin.NewChainAdaptorWithChain(conf, Chain)
+}
+
+func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
+	return bitcoin.NewLocalChainAdaptorWithChain(network, Chain)
+}
@@ -0,0 +1,146 @@
+package ltc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin/bitcointest"
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestAddressesOffline(t *testing.T) {
+	mainnet := NewLocalChainAdaptor(config.MainNet)
+	testnet := NewLocalChainAdaptor(config.TestNet)
+
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("ltc address key"))
+	reply, err := mainnet.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	assert.Equal(t, byte('L'), reply.Address[0])
+	legacy := reply.Address
+
+	hash, err := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	require.Nil(t, err)
+	p2sh, err := btcutil.NewAddressScriptHashFromHash(hash, &MainNetParams)
+	require.Nil(t, err)
+	assert.Equal(t, byte('M'), p2sh.EncodeAddress()[0])
+
+	valid := []string{legacy, p2sh.EncodeAddress(), "ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n9"}
+	for _, addr := range valid {
+		reply, err := mainnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: addr})
+		require.Nil(t, err, addr)
+		assert.Equal(t, addr, reply.CanonicalAddress)
+
+		// no address of one network is valid on the other
+		_, err = testnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: addr})
+		assert.NotNil(t, err, addr)
+	}
+
+	invalid := []string{
+		// bitcoin addresses
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2",
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy",
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4",
+		// wrong checksum
+		"ltc1qw508d6qejxtdg4y5r3zarvary0c5xw7kgmn4n8",
+	}
+	for _, addr := range invalid {
+		_, err := mainnet.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: addr})
+		assert.NotNil(t, err, addr)
+	}
+}
+
+func TestCreateSignedTransactionOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet)
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("ltc offline sign key"))
+	convertReply, err := adaptor.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	from := convertReply.Address
+
+	vins := []*proto.Vin{
+		{Hash: "c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9", Index: 0, Amount: 32000000, Address: from},
+		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: 1, Address: from},
+	}
+	create := func(change int64) (*proto.CreateUtxoTransactionReply, error) {
+		vins[1].Amount = change + 20000
+		return adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+			Chain:  ChainName,
+			Symbol: Symbol,
+			Vins:   vins,
+			Vouts: []*proto.Vout{
+				{Address: "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0", Amount: 32000000},
+				{Address: from, Amount: change},
+			},
+			Fee: "20000",
+		})
+	}
+
+	// litecoin relays no output below 10 times the bitcoin dust
+	_, err = create(5459)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "dust")
+
+	createReply, err := create(5460)
+	require.Nil(t, err)
+
+	signedReply := bitcointest.SignUtxoTransaction(t, adaptor, Chain, createReply, vins, privKey)
+
+	queryReply, err := adaptor.QueryUtxoTransactionFromSignedData(&proto.QueryTransactionFromSignedDataRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		SignedTxData: signedReply.SignedTxData,
+		Vins:         vins,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "tltc1qw508d6qejxtdg4y5r3zarvary0c5xw7klfsuq0", queryReply.Vouts[0].Address)
+	assert.Equal(t, from, queryReply.Vouts[1].Address)
+}
+
+func TestVerifyTxInclusionProofOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.MainNet)
+
+	// the genesis block, its scrypt hash meets the target, its block hash does not
+	merkleRoot, err := chainhash.NewHashFromStr("97ddfbbae6be97fd6cdf3e7ca13232a3afff2353e29badfab7f73011edd4ced9")
+	require.Nil(t, err)
+	coinbase, err := hex.DecodeString("01000000010000000000000000000000000000000000000000000000000000000000000000ffffffff4804ffff001d0104404e592054696d65732030352f4f63742f32303131205374657665204a6f62732c204170706c65e280997320566973696f6e6172792c2044696573206174203536ffffffff0100f2052a010000004341040184710fa689ad5023690c80f3a49c8f13f8d45b8c857fbcbc8bc4a8e4d3eb4b10f4d4604fa08dce601aaf0f470216fe1b51850b4acf21b179c45070ac7b03a9ac00000000")
+	require.Nil(t, err)
+	header := wire.BlockHeader{
+		Version:    1,
+		MerkleRoot: *merkleRoot,
+		Timestamp:  time.Unix(1317972665, 0),
+		Bits:       0x1e0ffff0,
+		Nonce:      2084524493,
+	}
+	var buf bytes.Buffer
+	require.Nil(t, header.Serialize(&buf))
+	assert.Equal(t, "12a765e31ffd4059bada1e25190f6e98c99d9714d334efa41a195a7e7e04bfe2", header.BlockHash().String())
+
+	proof := &proto.TxInclusionProof{
+		TxHash:      merkleRoot.String(),
+		BlockHeader: buf.Bytes(),
+		BlockHash:   header.BlockHash().String(),
+		TxCount:     1,
+		RawTx:       coinbase,
+	}
+	reply, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: proof})
+	require.Nil(t, err)
+	assert.True(t, reply.Verified)
+
+	header.Nonce++
+	buf.Reset()
+	require.Nil(t, header.Serialize(&buf))
+	proof.BlockHeader = buf.Bytes()
+	proof.BlockHash = ""
+	_, err = adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{Chain: ChainName, Proof: proof})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,212 @@
+package zec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+)
+
+// sigHasher hashes transparent inputs with ZIP-244 in v5 transactions and with
+// ZIP-243 in v4 ones, both commit to the consensus branch id and the amounts spent
+type sigHasher struct {
+	branchID uint32
+}
+
+func (sigHasher) HashType(hashType txscript.SigHashType) txscript.SigHashType {
+	return hashType
+}
+
+func (h sigHasher) SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType) ([]byte, error) {
+	if idx < 0 || idx >= len(tx.TxIn) || len(prevOuts) != len(tx.TxIn) {
+		return nil, fmt.Errorf("idx %d with %d prevouts for %d txins", idx, len(prevOuts), len(tx.TxIn))
+	}
+	switch hashType &^ txscript.SigHashAnyOneCanPay {
+	case txscript.SigHashAll, txscript.SigHashNone, txscript.SigHashSingle:
+	default:
+		return nil, fmt.Errorf("invalid sighash type %d", hashType)
+	}
+	switch tx.Version {
+	case TxVersionNU5:
+		return zip244SignHash(tx, idx, prevOuts, hashType, h.branchID), nil
+	case TxVersionSapling:
+		return zip243SignHash(tx, idx, prevOuts, hashType, h.branchID), nil
+	}
+	return nil, fmt.Errorf("unsupported transaction version %d", tx.Version)
+}
+
+// VerifySignature checks a p2pkh spend, the txscript engine knows no zcash sighash
+func (h sigHasher) VerifySignature(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut) error {
+	return bitcoin.VerifyP2PKHSignature(tx, idx, prevOuts, func(hashType txscript.SigHashType) ([]byte, error) {
+		return h.SignHash(tx, idx, prevOuts, hashType)
+	})
+}
+
+// branchPersonal returns the personalization of the prefix and the consensus branch id
+func branchPersonal(prefix string, branchID uint32) []byte {
+	personal := make([]byte, 16)
+	copy(personal, prefix)
+	binary.LittleEndian.PutUint32(personal[12:], branchID)
+	return personal
+}
+
+func uint32LE(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.LittleEndian.PutUint32(b, v)
+	return b
+}
+
+func int64LE(v int64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(v))
+	return b
+}
+
+func outPointBytes(op *wire.OutPoint) []byte {
+	var buf bytes.Buffer
+	writeOutPoint(&buf, op)
+	return buf.Bytes()
+}
+
+func txOutBytes(out *wire.TxOut) []byte {
+	var buf bytes.Buffer
+	writeTxOut(&buf, out)
+	return buf.Bytes()
+}
+
+func scriptBytes(script []byte) []byte {
+	var buf bytes.Buffer
+	wire.WriteVarBytes(&buf, 0, script)
+	return buf.Bytes()
+}
+
+// ZIP-244 digests
+
+func headerDigest(tx *wire.MsgTx, branchID uint32) []byte {
+	return personalHash([]byte("ZTxIdHeadersHash"),
+		uint32LE(overwinteredFlag|TxVersionNU5), uint32LE(nu5VersionGroupID), uint32LE(branchID),
+		uint32LE(tx.LockTime), uint32LE(0))
+}
+
+func prevoutsDigest(tx *wire.MsgTx) []byte {
+	d := newBlake2b256([]byte("ZTxIdPrevoutHash"))
+	for _, in := range tx.TxIn {
+		d.Write(outPointBytes(&in.PreviousOutPoint))
+	}
+	return d.Sum()
+}
+
+func sequenceDigest(tx *wire.MsgTx) []byte {
+	d := newBlake2b256([]byte("ZTxIdSequencHash"))
+	for _, in := range tx.TxIn {
+		d.Write(uint32LE(in.Sequence))
+	}
+	return d.Sum()
+}
+
+func outputsDigest(outs []*wire.TxOut) []byte {
+	d := newBlake2b256([]byte("ZTxIdOutputsHash"))
+	for _, out := range outs {
+		d.Write(txOutBytes(out))
+	}
+	return d.Sum()
+}
+
+// shieldedDigests returns the digests of the empty sapling and orchard bundles
+func shieldedDigests() []byte {
+	return append(personalHash([]byte("ZTxIdSaplingHash")), personalHash([]byte("ZTxIdOrchardHash"))...)
+}
+
+// txIDDigest is the ZIP-244 txid of a transparent v5 tx
+func txIDDigest(tx *wire.MsgTx, branchID uint32) []byte {
+	transparent := personalHash([]byte("ZTxIdTranspaHash"))
+	if len(tx.TxIn) > 0 || len(tx.TxOut) > 0 {
+		transparent = personalHash([]byte("ZTxIdTranspaHash"), prevoutsDigest(tx), sequenceDigest(tx), outputsDigest(tx.TxOut))
+	}
+	return personalHash(branchPersonal("ZcashTxHash_", branchID), headerDigest(tx, branchID), transparent, shieldedDigests())
+}
+
+// zip244SignHash is the signature digest of transparent input idx of a v5 tx
+func zip244SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType, branchID uint32) []byte {
+	anyoneCanPay := hashType&txscript.SigHashAnyOneCanPay != 0
+
+	prevouts := personalHash([]byte("ZTxIdPrevoutHash"))
+	amounts := newBlake2b256([]byte("ZTxTrAmountsHash"))
+	scripts := newBlake2b256([]byte("ZTxTrScriptsHash"))
+	sequence := personalHash([]byte("ZTxIdSequencHash"))
+	if !anyoneCanPay {
+		prevouts = prevoutsDigest(tx)
+		for _, prevOut := range prevOuts {
+			amounts.Write(int64LE(prevOut.Value))
+			scripts.Write(scriptBytes(prevOut.PkScript))
+		}
+		sequence = sequenceDigest(tx)
+	}
+
+	var outputs []byte
+	switch hashType &^ txscript.SigHashAnyOneCanPay {
+	case txscript.SigHashAll:
+		outputs = outputsDigest(tx.TxOut)
+	case txscript.SigHashSingle:
+		if idx < len(tx.TxOut) {
+			outputs = outputsDigest(tx.TxOut[idx : idx+1])
+		} else {
+			outputs = outputsDigest(nil)
+		}
+	default:
+		outputs = outputsDigest(nil)
+	}
+
+	in := tx.TxIn[idx]
+	txIn := personalHash([]byte("Zcash___TxInHash"),
+		outPointBytes(&in.PreviousOutPoint), int64LE(prevOuts[idx].Value), scriptBytes(prevOuts[idx].PkScript), uint32LE(in.Sequence))
+
+	transparent := personalHash([]byte("ZTxIdTranspaHash"),
+		[]byte{byte(hashType)}, prevouts, amounts.Sum(), scripts.Sum(), sequence, outputs, txIn)
+	return personalHash(branchPersonal("ZcashTxHash_", branchID), headerDigest(tx, branchID), transparent, shieldedDigests())
+}
+
+// zip243SignHash is the signature hash of transparent input idx of a v4 tx
+func zip243SignHash(tx *wire.MsgTx, idx int, prevOuts []*wire.TxOut, hashType txscript.SigHashType, branchID uint32) []byte {
+	anyoneCanPay := hashType&txscript.SigHashAnyOneCanPay != 0
+	baseType := hashType &^ txscript.SigHashAnyOneCanPay
+	zero := make([]byte, 32)
+
+	hashPrevouts, hashSequence, hashOutputs := zero, zero, zero
+	if !anyoneCanPay {
+		d := newBlake2b256([]byte("ZcashPrevoutHash"))
+		for _, in := range tx.TxIn {
+			d.Write(outPointBytes(&in.PreviousOutPoint))
+		}
+		hashPrevouts = d.Sum()
+	}
+	if !anyoneCanPay && baseType != txscript.SigHashSingle && baseType != txscript.SigHashNone {
+		d := newBlake2b256([]byte("ZcashSequencHash"))
+		for _, in := range tx.TxIn {
+			d.Write(uint32LE(in.Sequence))
+		}
+		hashSequence = d.Sum()
+	}
+	if baseType != txscript.SigHashSingle && baseType != txscript.SigHashNone {
+		d := newBlake2b256([]byte("ZcashOutputsHash"))
+		for _, out := range tx.TxOut {
+			d.Write(txOutBytes(out))
+		}
+		hashOutputs = d.Sum()
+	} else if baseType == txscript.SigHashSingle && idx < len(tx.TxOut) {
+		hashOutputs = personalHash([]byte("ZcashOutputsHash"), txOutBytes(tx.TxOut[idx]))
+	}
+
+	in := tx.TxIn[idx]
+	return personalHash(branchPersonal("ZcashSigHash", branchID),
+		uint32LE(overwinteredFlag|TxVersionSapling), uint32LE(saplingVersionGroupID),
+		hashPrevouts, hashSequence, hashOutputs,
+		// no joinsplits, sapling spends nor sapling outputs
+		zero, zero, zero,
+		uint32LE(tx.LockTime), uint32LE(0), int64LE(0), uint32LE(uint32(hashType)),
+		outPointBytes(&in.PreviousOutPoint), scriptBytes(prevOuts[idx].PkScript), int64LE(prevOuts[idx].Value), uint32LE(in.Sequence))
+}
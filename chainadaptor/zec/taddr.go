@@ -0,0 +1,75 @@
+package zec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/base58"
+)
+
+// tAddrPrefixes are the two byte base58check prefixes of transparent addresses
+type tAddrPrefixes struct {
+	pubKeyHash [2]byte
+	scriptHash [2]byte
+}
+
+// tAddrPrefixesOf returns the transparent address prefixes of the network
+func tAddrPrefixesOf(params *chaincfg.Params) (tAddrPrefixes, error) {
+	switch params.Name {
+	case MainNetParams.Name:
+		return tAddrPrefixes{[2]byte{0x1c, 0xb8}, [2]byte{0x1c, 0xbd}}, nil
+	case TestNetParams.Name, RegTestParams.Name:
+		return tAddrPrefixes{[2]byte{0x1d, 0x25}, [2]byte{0x1c, 0xba}}, nil
+	}
+	return tAddrPrefixes{}, fmt.Errorf("no t-address prefixes for network %s", params.Name)
+}
+
+// tAddrCodec encodes transparent p2pkh and p2sh addresses
+type tAddrCodec struct{}
+
+func (tAddrCodec) DecodeAddress(address string, params *chaincfg.Params) (btcutil.Address, error) {
+	prefixes, err := tAddrPrefixesOf(params)
+	if err != nil {
+		return nil, err
+	}
+	decoded := base58.Decode(address)
+	if len(decoded) != 2+20+4 {
+		return nil, errors.New("decoded t-address is of unknown size")
+	}
+	payload, checksum := decoded[:22], decoded[22:]
+	if !bytes.Equal(chainhash.DoubleHashB(payload)[:4], checksum) {
+		return nil, base58.ErrChecksum
+	}
+	switch {
+	case bytes.Equal(payload[:2], prefixes.pubKeyHash[:]):
+		return btcutil.NewAddressPubKeyHash(payload[2:], params)
+	case bytes.Equal(payload[:2], prefixes.scriptHash[:]):
+		return btcutil.NewAddressScriptHashFromHash(payload[2:], params)
+	}
+	return nil, btcutil.ErrUnknownAddressType
+}
+
+func (tAddrCodec) EncodeAddress(address btcutil.Address, params *chaincfg.Params) string {
+	prefixes, err := tAddrPrefixesOf(params)
+	if err != nil {
+		return ""
+	}
+	switch addr := address.(type) {
+	case *btcutil.AddressPubKeyHash:
+		return encodeTAddr(prefixes.pubKeyHash, addr.Hash160()[:])
+	case *btcutil.AddressScriptHash:
+		return encodeTAddr(prefixes.scriptHash, addr.Hash160()[:])
+	case *btcutil.AddressPubKey:
+		return encodeTAddr(prefixes.pubKeyHash, addr.AddressPubKeyHash().Hash160()[:])
+	}
+	return ""
+}
+
+func encodeTAddr(prefix [2]byte, hash []byte) string {
+	payload := append(prefix[:], hash...)
+	return base58.Encode(append(payload, chainhash.DoubleHashB(payload)[:4]...))
+}
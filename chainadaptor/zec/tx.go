@@ -0,0 +1,262 @@
+package zec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// TxVersionSapling is the v4 transaction format of ZIP-243
+	TxVersionSapling = 4
+	// TxVersionNU5 is the v5 transaction format of ZIP-225
+	TxVersionNU5 = 5
+
+	overwinteredFlag      = 1 << 31
+	saplingVersionGroupID = 0x892f2085
+	nu5VersionGroupID     = 0x26a7270a
+
+	// maxTxCount bounds the inputs and outputs read, a 2MB block holds fewer
+	maxTxCount = 2000000 / 9
+	// maxScriptSize is the consensus MAX_SCRIPT_SIZE
+	maxScriptSize = 10000
+)
+
+// NU61BranchID is the consensus branch id of NU6.1, the network upgrade active on
+// the main and test networks. v5 transactions carry the branch id and all
+// sighashes commit to it, nodes of another upgrade need theirs configured.
+const NU61BranchID = 0x4dec4df0
+
+// txCodec encodes transparent v4 and v5 transactions of the consensus branch.
+// They have no expiry height and the shielded parts are empty.
+type txCodec struct {
+	branchID uint32
+}
+
+func (txCodec) NewTx() *wire.MsgTx {
+	return &wire.MsgTx{Version: TxVersionNU5}
+}
+
+func (c txCodec) Serialize(w io.Writer, tx *wire.MsgTx) error {
+	var err error
+	switch tx.Version {
+	case TxVersionNU5:
+		err = writeUint32s(w, overwinteredFlag|TxVersionNU5, nu5VersionGroupID, c.branchID, tx.LockTime, 0)
+		if err == nil {
+			err = writeTransparent(w, tx)
+		}
+	case TxVersionSapling:
+		err = writeUint32s(w, overwinteredFlag|TxVersionSapling, saplingVersionGroupID)
+		if err == nil {
+			err = writeTransparent(w, tx)
+		}
+		if err == nil {
+			err = writeUint32s(w, tx.LockTime, 0)
+		}
+		if err == nil {
+			// no shielded value flows in or out
+			err = binary.Write(w, binary.LittleEndian, int64(0))
+		}
+	default:
+		return fmt.Errorf("unsupported transaction version %d", tx.Version)
+	}
+	if err != nil {
+		return err
+	}
+	// no sapling spends and outputs, then no orchard actions or joinsplits
+	for i := 0; i < 3; i++ {
+		if err := wire.WriteVarInt(w, 0, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeUint32s writes the values little endian, the expiry height is always 0
+func writeUint32s(w io.Writer, values ...uint32) error {
+	for _, v := range values {
+		if err := binary.Write(w, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeTransparent writes the transparent inputs and outputs of tx
+func writeTransparent(w io.Writer, tx *wire.MsgTx) error {
+	if err := wire.WriteVarInt(w, 0, uint64(len(tx.TxIn))); err != nil {
+		return err
+	}
+	for _, in := range tx.TxIn {
+		if err := writeOutPoint(w, &in.PreviousOutPoint); err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, in.SignatureScript); err != nil {
+			return err
+		}
+		if err := binary.Write(w, binary.LittleEndian, in.Sequence); err != nil {
+			return err
+		}
+	}
+	if err := wire.WriteVarInt(w, 0, uint64(len(tx.TxOut))); err != nil {
+		return err
+	}
+	for _, out := range tx.TxOut {
+		if err := writeTxOut(w, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeOutPoint(w io.Writer, op *wire.OutPoint) error {
+	if _, err := w.Write(op.Hash[:]); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, op.Index)
+}
+
+func writeTxOut(w io.Writer, out *wire.TxOut) error {
+	if err := binary.Write(w, binary.LittleEndian, out.Value); err != nil {
+		return err
+	}
+	return wire.WriteVarBytes(w, 0, out.PkScript)
+}
+
+func (c txCodec) Deserialize(r io.Reader) (*wire.MsgTx, error) {
+	var header, versionGroupID uint32
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if header&overwinteredFlag == 0 {
+		return nil, errors.New("transaction is not overwintered")
+	}
+	if err := binary.Read(r, binary.LittleEndian, &versionGroupID); err != nil {
+		return nil, err
+	}
+
+	tx := &wire.MsgTx{Version: int32(header &^ overwinteredFlag)}
+	var expiryHeight uint32
+	switch {
+	case tx.Version == TxVersionNU5 && versionGroupID == nu5VersionGroupID:
+		var branchID uint32
+		if err := binary.Read(r, binary.LittleEndian, &branchID); err != nil {
+			return nil, err
+		}
+		if branchID != c.branchID {
+			return nil, fmt.Errorf("consensus branch id %08x is not the current %08x", branchID, c.branchID)
+		}
+		if err := readUint32s(r, &tx.LockTime, &expiryHeight); err != nil {
+			return nil, err
+		}
+		if err := readTransparent(r, tx); err != nil {
+			return nil, err
+		}
+	case tx.Version == TxVersionSapling && versionGroupID == saplingVersionGroupID:
+		if err := readTransparent(r, tx); err != nil {
+			return nil, err
+		}
+		if err := readUint32s(r, &tx.LockTime, &expiryHeight); err != nil {
+			return nil, err
+		}
+		var valueBalance int64
+		if err := binary.Read(r, binary.LittleEndian, &valueBalance); err != nil {
+			return nil, err
+		}
+		if valueBalance != 0 {
+			return nil, errors.New("shielded value balance is not supported")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported transaction version %d of group %08x", tx.Version, versionGroupID)
+	}
+	if expiryHeight != 0 {
+		return nil, fmt.Errorf("expiry height %d is not supported", expiryHeight)
+	}
+
+	// sapling spends and outputs, then orchard actions or joinsplits
+	for i := 0; i < 3; i++ {
+		count, err := wire.ReadVarInt(r, 0)
+		if err != nil {
+			return nil, err
+		}
+		if count != 0 {
+			return nil, errors.New("shielded transactions are not supported")
+		}
+	}
+	return tx, nil
+}
+
+func readUint32s(r io.Reader, values ...*uint32) error {
+	for _, v := range values {
+		if err := binary.Read(r, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTransparent reads the transparent inputs and outputs into tx
+func readTransparent(r io.Reader, tx *wire.MsgTx) error {
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return err
+	}
+	if count > maxTxCount {
+		return fmt.Errorf("too many inputs: %d", count)
+	}
+	tx.TxIn = make([]*wire.TxIn, count)
+	for i := range tx.TxIn {
+		in := &wire.TxIn{}
+		if _, err := io.ReadFull(r, in.PreviousOutPoint.Hash[:]); err != nil {
+			return err
+		}
+		if err := binary.Read(r, binary.LittleEndian, &in.PreviousOutPoint.Index); err != nil {
+			return err
+		}
+		if in.SignatureScript, err = wire.ReadVarBytes(r, 0, maxScriptSize, "SignatureScript"); err != nil {
+			return err
+		}
+		if err := binary.Read(r, binary.LittleEndian, &in.Sequence); err != nil {
+			return err
+		}
+		tx.TxIn[i] = in
+	}
+
+	if count, err = wire.ReadVarInt(r, 0); err != nil {
+		return err
+	}
+	if count > maxTxCount {
+		return fmt.Errorf("too many outputs: %d", count)
+	}
+	tx.TxOut = make([]*wire.TxOut, count)
+	for i := range tx.TxOut {
+		out := &wire.TxOut{}
+		if err := binary.Read(r, binary.LittleEndian, &out.Value); err != nil {
+			return err
+		}
+		if out.PkScript, err = wire.ReadVarBytes(r, 0, maxScriptSize, "PkScript"); err != nil {
+			return err
+		}
+		tx.TxOut[i] = out
+	}
+	return nil
+}
+
+// TxHash returns the ZIP-244 txid of v5 transactions, the double sha256 of the
+// serialization before
+func (c txCodec) TxHash(tx *wire.MsgTx) chainhash.Hash {
+	if tx.Version == TxVersionNU5 {
+		hash, _ := chainhash.NewHash(txIDDigest(tx, c.branchID))
+		return *hash
+	}
+	var buf bytes.Buffer
+	if err := c.Serialize(&buf, tx); err != nil {
+		return chainhash.Hash{}
+	}
+	return chainhash.DoubleHashH(buf.Bytes())
+}
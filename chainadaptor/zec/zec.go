@@ -0,0 +1,154 @@
+// Package zec is the zcash adaptor for transparent addresses, built on the
+// bitcoin utxo adaptor. Shielded pools are out of scope: transactions with
+// sapling or orchard data are rejected. zcashd has no estimatesmartfee and its
+// equihash headers are no bitcoin headers, so gas price queries and inclusion
+// proofs are not supported; fees follow ZIP-317.
+package zec
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/chainadaptor/fallback"
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+const (
+	ChainName = "zec"
+	Symbol    = "zec"
+)
+
+// MainNetParams are the parameters of the zcash main network. Transparent
+// addresses have two byte prefixes, the address ids are their second bytes.
+var MainNetParams = chaincfg.Params{
+	Name:        "mainnet",
+	Net:         wire.BitcoinNet(0x6427e924),
+	DefaultPort: "8233",
+
+	TargetTimePerBlock: 75 * time.Second,
+
+	PubKeyHashAddrID: 0xb8, // 0x1cb8, starts with t1
+	ScriptHashAddrID: 0xbd, // 0x1cbd, starts with t3
+	PrivateKeyID:     0x80,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x88, 0xad, 0xe4}, // xprv
+	HDPublicKeyID:  [4]byte{0x04, 0x88, 0xb2, 0x1e}, // xpub
+	HDCoinType:     133,
+}
+
+// TestNetParams are the parameters of the zcash test network
+var TestNetParams = chaincfg.Params{
+	Name:        "testnet",
+	Net:         wire.BitcoinNet(0xbff91afa),
+	DefaultPort: "18233",
+
+	TargetTimePerBlock: 75 * time.Second,
+
+	PubKeyHashAddrID: 0x25, // 0x1d25, starts with tm
+	ScriptHashAddrID: 0xba, // 0x1cba, starts with t2
+	PrivateKeyID:     0xef,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94}, // tprv
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf}, // tpub
+	HDCoinType:     1,
+}
+
+// RegTestParams are the parameters of the zcash regression test network, which
+// shares the address prefixes of the test network
+var RegTestParams = chaincfg.Params{
+	Name:        "regtest",
+	Net:         wire.BitcoinNet(0x5f3fe8aa),
+	DefaultPort: "18344",
+
+	TargetTimePerBlock: 75 * time.Second,
+
+	PubKeyHashAddrID: 0x25,
+	ScriptHashAddrID: 0xba,
+	PrivateKeyID:     0xef,
+
+	HDPrivateKeyID: [4]byte{0x04, 0x35, 0x83, 0x94},
+	HDPublicKeyID:  [4]byte{0x04, 0x35, 0x87, 0xcf},
+	HDCoinType:     1,
+}
+
+// Params returns the zcash network parameters
+func Params(network config.NetWorkType) *chaincfg.Params {
+	switch network {
+	case config.MainNet:
+		return &MainNetParams
+	case config.TestNet:
+		return &TestNetParams
+	case config.RegTest:
+		return &RegTestParams
+	}
+	panic("unsupported network type")
+}
+
+// Chain is zcash restricted to its transparent pool: bitcoin scripts in v5
+// transactions signed with ZIP-244 sighashes, or ZIP-243 ones for v4. Its
+// transactions commit to the branch id of NU6.1.
+var Chain = newChain(NU61BranchID)
+
+func newChain(branchID uint32) *bitcoin.Chain {
+	return &bitcoin.Chain{
+		Name:   ChainName,
+		Symbol: Symbol,
+		Params: Params,
+		Node: func(conf *config.Config) config.Node {
+			return conf.Fullnode.Zec
+		},
+		Addresses: tAddrCodec{},
+		SigHasher: sigHasher{branchID: branchID},
+		TxCodec:   txCodec{branchID: branchID},
+		Policy: bitcoin.Policy{
+			MinRelayFeeRate: 100,
+			DustLimit:       54,
+			MaxFeeRate:      1000,
+			ActionFee:       5000,
+		},
+		// sendrawtransaction of zcashd still takes allowhighfees
+		MaxFeeRateVersion: 0,
+	}
+}
+
+// ChainAdaptor is the bitcoin adaptor of the chain without the operations
+// zcashd and its equihash headers can not serve
+type ChainAdaptor struct {
+	chainadaptor.ChainAdaptor
+	unsupported fallback.ChainAdaptor
+}
+
+// NewChainAdaptor uses the consensus branch id of the config, if any, for the
+// networks whose nodes run another upgrade than NU6.1
+func NewChainAdaptor(conf *config.Config) (chainadaptor.ChainAdaptor, error) {
+	chain := Chain
+	if branchID := conf.Fullnode.Zec.ConsensusBranchID; branchID != 0 {
+		chain = newChain(branchID)
+	}
+	adaptor, err := bitcoin.NewChainAdaptorWithChain(conf, chain)
+	if err != nil {
+		return nil, err
+	}
+	return &ChainAdaptor{ChainAdaptor: adaptor}, nil
+}
+
+func NewLocalChainAdaptor(network config.NetWorkType) chainadaptor.ChainAdaptor {
+	return &ChainAdaptor{ChainAdaptor: bitcoin.NewLocalChainAdaptorWithChain(network, Chain)}
+}
+
+func (a *ChainAdaptor) QueryGasPrice(req *proto.QueryGasPriceRequest) (*proto.QueryGasPriceReply, error) {
+	return a.unsupported.QueryGasPrice(req)
+}
+
+func (a *ChainAdaptor) GetTxInclusionProof(req *proto.GetTxInclusionProofRequest) (*proto.GetTxInclusionProofReply, error) {
+	return a.unsupported.GetTxInclusionProof(req)
+}
+
+func (a *ChainAdaptor) VerifyTxInclusionProof(req *proto.VerifyTxInclusionProofRequest) (*proto.VerifyTxInclusionProofReply, error) {
+	return a.unsupported.VerifyTxInclusionProof(req)
+}
@@ -0,0 +1,283 @@
+package zec
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin/bitcointest"
+	"github.com/hbtc-chain/chainnode/config"
+	"github.com/hbtc-chain/chainnode/proto"
+)
+
+func TestBlake2b(t *testing.T) {
+	data := make([]byte, 300)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	// without personalization it is plain BLAKE2b-256, across block boundaries
+	for _, n := range []int{0, 1, 127, 128, 129, 256, 300} {
+		want := blake2b.Sum256(data[:n])
+		assert.Equal(t, want[:], personalHash(nil, data[:n]), n)
+		// writes may be split anywhere
+		assert.Equal(t, want[:], personalHash(nil, data[:n/2], data[n/2:n]), n)
+	}
+
+	// the empty sapling bundle digest of ZIP-244
+	assert.Equal(t, "6f2fc8f98feafd94e74a0df4bed74391ee0b5a69945e4ced8ca8a095206f00ae",
+		hex.EncodeToString(personalHash([]byte("ZTxIdSaplingHash"))))
+	assert.Equal(t, "d188c8e62e952a0d7126f30e449d7827144cea6af1900d60eb7425cf47b5ba18",
+		hex.EncodeToString(personalHash([]byte("ZcashSigHash\xf0\x4d\xec\x4d"), data[:200])))
+}
+
+func TestTAddr(t *testing.T) {
+	hash, err := hex.DecodeString("751e76e8199196d454941c45d1b3a323f1433bd6")
+	require.Nil(t, err)
+
+	codec := tAddrCodec{}
+	vectors := []struct {
+		params *chaincfg.Params
+		p2pkh  string
+		p2sh   string
+	}{
+		{&MainNetParams, "t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzs", "t3VEtV2oBtHxjq7wKHJb3PHsqXHvMRgUmVw"},
+		{&TestNetParams, "tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs", "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt"},
+	}
+	for _, v := range vectors {
+		p2pkh, err := btcutil.NewAddressPubKeyHash(hash, v.params)
+		require.Nil(t, err)
+		p2sh, err := btcutil.NewAddressScriptHashFromHash(hash, v.params)
+		require.Nil(t, err)
+		assert.Equal(t, v.p2pkh, codec.EncodeAddress(p2pkh, v.params))
+		assert.Equal(t, v.p2sh, codec.EncodeAddress(p2sh, v.params))
+
+		decoded, err := codec.DecodeAddress(v.p2pkh, v.params)
+		require.Nil(t, err)
+		assert.IsType(t, &btcutil.AddressPubKeyHash{}, decoded)
+		assert.Equal(t, hash, decoded.ScriptAddress())
+		decoded, err = codec.DecodeAddress(v.p2sh, v.params)
+		require.Nil(t, err)
+		assert.IsType(t, &btcutil.AddressScriptHash{}, decoded)
+	}
+
+	illegal := []string{
+		// testnet addresses
+		"tmLPctKo9j49rtCSKpwEBpLBeykiTGomGQs",
+		"t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt",
+		// wrong checksum
+		"t1UYsZVJkLPeMjxEtACvSxfWuNmddpWfxzt",
+		// bitcoin address of the same hash
+		"1BgGZ9tcN4rm9KBzDn7KprQz87SZ26SAMH",
+		// shielded addresses are out of scope
+		"zs1z7rejlpsa98s2rrrfkwmaxu53e4ue0ulcrw0h4x5g8jl04tak0d3mm47vdtahatqrlkngh9sly",
+	}
+	for _, addr := range illegal {
+		_, err := codec.DecodeAddress(addr, &MainNetParams)
+		assert.NotNil(t, err, addr)
+	}
+}
+
+func TestTxCodec(t *testing.T) {
+	prevHash, err := chainhash.NewHashFromStr("c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9")
+	require.Nil(t, err)
+	codec := txCodec{branchID: NU61BranchID}
+
+	tx := codec.NewTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 1), []byte{0x51}, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	tx.LockTime = 7
+
+	var buf bytes.Buffer
+	require.Nil(t, codec.Serialize(&buf, tx))
+	// header, version group id and consensus branch id lead v5 transactions
+	assert.Equal(t, "050000800a27a726f04dec4d0700000000000000", hex.EncodeToString(buf.Bytes()[:20]))
+	decoded, err := codec.Deserialize(bytes.NewReader(buf.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, tx, decoded)
+	v5Hash := codec.TxHash(tx)
+
+	tx.Version = TxVersionSapling
+	buf.Reset()
+	require.Nil(t, codec.Serialize(&buf, tx))
+	assert.Equal(t, "0400008085202f89", hex.EncodeToString(buf.Bytes()[:8]))
+	decoded, err = codec.Deserialize(bytes.NewReader(buf.Bytes()))
+	require.Nil(t, err)
+	assert.Equal(t, tx, decoded)
+	// the v4 txid is the double sha256 of the serialization
+	assert.Equal(t, chainhash.DoubleHashH(buf.Bytes()), codec.TxHash(tx))
+	assert.NotEqual(t, v5Hash, codec.TxHash(tx))
+
+	tx.Version = TxVersionNU5
+	buf.Reset()
+	require.Nil(t, codec.Serialize(&buf, tx))
+	v5 := buf.Bytes()
+	illegal := map[string][]byte{
+		// another consensus branch
+		"branch id": append(append(append([]byte{}, v5[:8]...), 0xf0, 0x4d, 0xec, 0x00), v5[12:]...),
+		"expiry":    append(append(append([]byte{}, v5[:16]...), 0x01), v5[17:]...),
+		// a sapling spend
+		"shielded": append(append([]byte{}, v5[:len(v5)-3]...), 0x01, 0x00, 0x00),
+		// a pre-overwinter bitcoin style tx
+		"legacy": append([]byte{0x01, 0x00, 0x00, 0x00}, v5[4:]...),
+	}
+	for name, data := range illegal {
+		_, err := codec.Deserialize(bytes.NewReader(data))
+		assert.NotNil(t, err, name)
+	}
+}
+
+func TestCreateSignedTransactionOffline(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.TestNet)
+
+	privKey, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), []byte("zec offline sign key"))
+	convertReply, err := adaptor.ConvertAddress(&proto.ConvertAddressRequest{Chain: ChainName, PublicKey: pubKey.SerializeCompressed()})
+	require.Nil(t, err)
+	from := convertReply.Address
+	assert.Equal(t, "tm", from[:2])
+
+	validReply, err := adaptor.ValidAddress(&proto.ValidAddressRequest{Chain: ChainName, Symbol: Symbol, Address: from})
+	require.Nil(t, err)
+	assert.Equal(t, from, validReply.CanonicalAddress)
+
+	vins := []*proto.Vin{
+		{Hash: "c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9", Index: 0, Amount: 32000000, Address: from},
+		{Hash: "37a890e02a48f515a574eb6c2e7f22542fe362a2df2f64b1ceb3d841c00f1dcf", Index: 1, Amount: 500000, Address: from},
+	}
+	create := func(fee int64) (*proto.CreateUtxoTransactionReply, error) {
+		return adaptor.CreateUtxoTransaction(&proto.CreateUtxoTransactionRequest{
+			Chain:  ChainName,
+			Symbol: Symbol,
+			Vins:   vins,
+			Vouts: []*proto.Vout{
+				{Address: "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt", Amount: 32000000},
+				{Address: from, Amount: 500000 - fee},
+			},
+			Fee: strconv.FormatInt(fee, 10),
+		})
+	}
+
+	// ZIP-317 asks 5000 zatoshis for each of the two logical actions
+	_, err = create(9999)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "conventional fee")
+
+	createReply, err := create(10000)
+	require.Nil(t, err)
+
+	signedReply := bitcointest.SignUtxoTransaction(t, adaptor, Chain, createReply, vins, privKey)
+
+	msgTx, err := Chain.TxCodec.Deserialize(bytes.NewReader(signedReply.SignedTxData))
+	require.Nil(t, err)
+	assert.Equal(t, int32(TxVersionNU5), msgTx.Version)
+	txHash := Chain.TxCodec.TxHash(msgTx)
+	assert.Equal(t, txHash[:], signedReply.Hash)
+	pushes, err := txscript.PushedData(msgTx.TxIn[0].SignatureScript)
+	require.Nil(t, err)
+	assert.Equal(t, byte(txscript.SigHashAll), pushes[0][len(pushes[0])-1])
+
+	queryReply, err := adaptor.QueryUtxoTransactionFromSignedData(&proto.QueryTransactionFromSignedDataRequest{
+		Chain:        ChainName,
+		Symbol:       Symbol,
+		SignedTxData: signedReply.SignedTxData,
+		Vins:         vins,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, "t2HE5XhuKkka7NpX4D3b5vv4Udn9XGqUwEt", queryReply.Vouts[0].Address)
+	assert.Equal(t, from, queryReply.Vouts[1].Address)
+	assert.Equal(t, txHash.String(), queryReply.TxHash)
+
+	// ZIP-244 commits to the amounts spent by all inputs, so a wrong amount of
+	// the second input invalidates the signature of the first as well
+	vins[1].Amount++
+	msgTx.TxIn[1].SignatureScript = nil
+	var buf bytes.Buffer
+	require.Nil(t, Chain.TxCodec.Serialize(&buf, msgTx))
+	prevOuts := make([]*wire.TxOut, len(vins))
+	for i, vin := range vins {
+		addr, err := tAddrCodec{}.DecodeAddress(vin.Address, &TestNetParams)
+		require.Nil(t, err)
+		pkScript, err := txscript.PayToAddrScript(addr)
+		require.Nil(t, err)
+		prevOuts[i] = wire.NewTxOut(vin.Amount, pkScript)
+	}
+	assert.NotNil(t, Chain.SigHasher.VerifySignature(msgTx, 0, prevOuts))
+	prevOuts[1].Value--
+	assert.Nil(t, Chain.SigHasher.VerifySignature(msgTx, 0, prevOuts))
+}
+
+func TestSignHashVersions(t *testing.T) {
+	prevHash, err := chainhash.NewHashFromStr("c94a4debf11bfef9316681f61fb663d15ed7a6ad8698063c4f1348575aec57a9")
+	require.Nil(t, err)
+	tx := Chain.TxCodec.NewTx()
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 0), nil, nil))
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(prevHash, 1), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+	prevOuts := []*wire.TxOut{wire.NewTxOut(600, []byte{0x51}), wire.NewTxOut(500, []byte{0x52})}
+
+	hashes := map[string]bool{}
+	for _, version := range []int32{TxVersionNU5, TxVersionSapling} {
+		tx.Version = version
+		for _, hashType := range []txscript.SigHashType{
+			txscript.SigHashAll, txscript.SigHashNone, txscript.SigHashSingle,
+			txscript.SigHashAll | txscript.SigHashAnyOneCanPay,
+		} {
+			hash, err := Chain.SigHasher.SignHash(tx, 1, prevOuts, hashType)
+			require.Nil(t, err)
+			hashes[hex.EncodeToString(hash)] = true
+		}
+	}
+	// every version and hash type signs a different digest
+	assert.Len(t, hashes, 8)
+
+	// and every consensus branch
+	other := newChain(NU61BranchID + 1)
+	for _, version := range []int32{TxVersionNU5, TxVersionSapling} {
+		tx.Version = version
+		hash, err := other.SigHasher.SignHash(tx, 1, prevOuts, txscript.SigHashAll)
+		require.Nil(t, err)
+		assert.False(t, hashes[hex.EncodeToString(hash)])
+	}
+	// only v5 transactions carry the branch id
+	tx.Version = TxVersionNU5
+	var buf bytes.Buffer
+	require.Nil(t, other.TxCodec.Serialize(&buf, tx))
+	_, err = other.TxCodec.Deserialize(bytes.NewReader(buf.Bytes()))
+	assert.Nil(t, err)
+	_, err = Chain.TxCodec.Deserialize(bytes.NewReader(buf.Bytes()))
+	assert.NotNil(t, err)
+
+	_, err = Chain.SigHasher.SignHash(tx, 1, prevOuts, txscript.SigHashOld)
+	assert.NotNil(t, err)
+	_, err = Chain.SigHasher.SignHash(tx, 2, prevOuts, txscript.SigHashAll)
+	assert.NotNil(t, err)
+}
+
+func TestUnsupportedOperations(t *testing.T) {
+	adaptor := NewLocalChainAdaptor(config.MainNet)
+
+	gasReply, err := adaptor.QueryGasPrice(&proto.QueryGasPriceRequest{Chain: ChainName})
+	assert.Nil(t, err)
+	assert.Equal(t, config.UnsupportedOperation, gasReply.Msg)
+	proofReply, err := adaptor.GetTxInclusionProof(&proto.GetTxInclusionProofRequest{Chain: ChainName})
+	assert.Nil(t, err)
+	assert.Equal(t, config.UnsupportedOperation, proofReply.Msg)
+	// a bitcoin sized header is no zcash one
+	verifyReply, err := adaptor.VerifyTxInclusionProof(&proto.VerifyTxInclusionProofRequest{
+		Chain: ChainName,
+		Proof: &proto.TxInclusionProof{BlockHeader: make([]byte, wire.MaxBlockHeaderPayload)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, proto.ReturnCode_ERROR, verifyReply.Code)
+	assert.Equal(t, config.UnsupportedOperation, verifyReply.Msg)
+}
@@ -7,9 +7,13 @@ import (
 	"strings"
 
 	"github.com/hbtc-chain/chainnode/chainadaptor"
+	"github.com/hbtc-chain/chainnode/chainadaptor/bch"
 	"github.com/hbtc-chain/chainnode/chainadaptor/bitcoin"
+	"github.com/hbtc-chain/chainnode/chainadaptor/doge"
 	"github.com/hbtc-chain/chainnode/chainadaptor/ethereum"
+	"github.com/hbtc-chain/chainnode/chainadaptor/ltc"
 	"github.com/hbtc-chain/chainnode/chainadaptor/tron"
+	"github.com/hbtc-chain/chainnode/chainadaptor/zec"
 	"github.com/hbtc-chain/chainnode/config"
 	"github.com/hbtc-chain/chainnode/proto"
 
@@ -38,11 +42,15 @@ func New(conf *config.Config) (*ChainDispatcher, error) {
 
 	chainAdaptorFactoryMap := map[string]func(conf *config.Config) (chainadaptor.ChainAdaptor, error){
 		bitcoin.ChainName:  bitcoin.NewChainAdaptor,
+		bch.ChainName:      bch.NewChainAdaptor,
+		ltc.ChainName:      ltc.NewChainAdaptor,
+		doge.ChainName:     doge.NewChainAdaptor,
+		zec.ChainName:      zec.NewChainAdaptor,
 		ethereum.ChainName: ethereum.NewChainAdaptor,
 		tron.ChainName:     tron.NewChainAdaptor,
 	}
 
-	supportedChains := []string{bitcoin.ChainName, ethereum.ChainName, tron.ChainName}
+	supportedChains := []string{bitcoin.ChainName, bch.ChainName, ltc.ChainName, doge.ChainName, zec.ChainName, ethereum.ChainName, tron.ChainName}
 
 	for _, c := range conf.Chains {
 		if factory, ok := chainAdaptorFactoryMap[c]; ok {
@@ -65,10 +73,14 @@ func NewLocal(network config.NetWorkType) *ChainDispatcher {
 
 	chainAdaptorFactoryMap := map[string]func(network config.NetWorkType) chainadaptor.ChainAdaptor{
 		bitcoin.ChainName:  bitcoin.NewLocalChainAdaptor,
+		bch.ChainName:      bch.NewLocalChainAdaptor,
+		ltc.ChainName:      ltc.NewLocalChainAdaptor,
+		doge.ChainName:     doge.NewLocalChainAdaptor,
+		zec.ChainName:      zec.NewLocalChainAdaptor,
 		ethereum.ChainName: ethereum.NewLocalChainAdaptor,
 		tron.ChainName:     tron.NewLocalChainAdaptor,
 	}
-	supportedChains := []string{bitcoin.ChainName, ethereum.ChainName, tron.ChainName}
+	supportedChains := []string{bitcoin.ChainName, bch.ChainName, ltc.ChainName, doge.ChainName, zec.ChainName, ethereum.ChainName, tron.ChainName}
 
 	for _, c := range supportedChains {
 		if factory, ok := chainAdaptorFactoryMap[c]; ok {
@@ -193,6 +205,17 @@ func (d *ChainDispatcher) QueryGasPrice(_ context.Context, req *proto.QueryGasPr
 	return d.registry[req.Chain].QueryGasPrice(req)
 }
 
+func (d *ChainDispatcher) EstimateUtxoFee(_ context.Context, req *proto.EstimateUtxoFeeRequest) (*proto.EstimateUtxoFeeReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.EstimateUtxoFeeReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].EstimateUtxoFee(req)
+}
+
 func (d *ChainDispatcher) QueryUtxoTransaction(_ context.Context, req *proto.QueryTransactionRequest) (*proto.QueryUtxoTransactionReply, error) {
 	resp := d.preHandler(req)
 	if resp != nil {
@@ -226,6 +249,72 @@ func (d *ChainDispatcher) CreateUtxoTransaction(_ context.Context, req *proto.Cr
 	return d.registry[req.Chain].CreateUtxoTransaction(req)
 }
 
+func (d *ChainDispatcher) BuildUtxoTransaction(_ context.Context, req *proto.BuildUtxoTransactionRequest) (*proto.BuildUtxoTransactionReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.BuildUtxoTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].BuildUtxoTransaction(req)
+}
+
+func (d *ChainDispatcher) BumpUtxoFee(_ context.Context, req *proto.BumpUtxoFeeRequest) (*proto.BumpUtxoFeeReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.BumpUtxoFeeReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].BumpUtxoFee(req)
+}
+
+func (d *ChainDispatcher) BuildCpfpTransaction(_ context.Context, req *proto.BuildCpfpTransactionRequest) (*proto.BuildCpfpTransactionReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.BuildCpfpTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].BuildCpfpTransaction(req)
+}
+
+func (d *ChainDispatcher) BuildSweepTransaction(_ context.Context, req *proto.BuildSweepTransactionRequest) (*proto.BuildSweepTransactionReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.BuildSweepTransactionReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].BuildSweepTransaction(req)
+}
+
+func (d *ChainDispatcher) BuildPayoutBatch(_ context.Context, req *proto.BuildPayoutBatchRequest) (*proto.BuildPayoutBatchReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.BuildPayoutBatchReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].BuildPayoutBatch(req)
+}
+
+func (d *ChainDispatcher) CheckDoubleSpend(_ context.Context, req *proto.CheckDoubleSpendRequest) (*proto.CheckDoubleSpendReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.CheckDoubleSpendReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].CheckDoubleSpend(req)
+}
+
 func (d *ChainDispatcher) CreateAccountTransaction(_ context.Context, req *proto.CreateAccountTransactionRequest) (*proto.CreateAccountTransactionReply, error) {
 	resp := d.preHandler(req)
 	if resp != nil {
@@ -281,6 +370,28 @@ func (d *ChainDispatcher) VerifyUtxoSignedTransaction(_ context.Context, req *pr
 	return d.registry[req.Chain].VerifyUtxoSignedTransaction(req)
 }
 
+func (d *ChainDispatcher) GetTxInclusionProof(_ context.Context, req *proto.GetTxInclusionProofRequest) (*proto.GetTxInclusionProofReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.GetTxInclusionProofReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].GetTxInclusionProof(req)
+}
+
+func (d *ChainDispatcher) VerifyTxInclusionProof(_ context.Context, req *proto.VerifyTxInclusionProofRequest) (*proto.VerifyTxInclusionProofReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.VerifyTxInclusionProofReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].VerifyTxInclusionProof(req)
+}
+
 func (d *ChainDispatcher) QueryAccountTransactionFromData(_ context.Context, req *proto.QueryTransactionFromDataRequest) (*proto.QueryAccountTransactionReply, error) {
 	resp := d.preHandler(req)
 	if resp != nil {
@@ -326,6 +437,17 @@ func (d *ChainDispatcher) QueryUtxoTransactionFromSignedData(_ context.Context,
 
 }
 
+func (d *ChainDispatcher) DryRunBroadcast(_ context.Context, req *proto.BroadcastTransactionRequest) (*proto.DryRunBroadcastReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.DryRunBroadcastReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].DryRunBroadcast(req)
+}
+
 func (d *ChainDispatcher) BroadcastTransaction(_ context.Context, req *proto.BroadcastTransactionRequest) (*proto.BroadcastTransactionReply, error) {
 	resp := d.preHandler(req)
 	if resp != nil {
@@ -348,6 +470,17 @@ func (d *ChainDispatcher) QueryUtxoInsFromData(_ context.Context, req *proto.Que
 	return d.registry[req.Chain].QueryUtxoInsFromData(req)
 }
 
+func (d *ChainDispatcher) ListUtxos(_ context.Context, req *proto.ListUtxosRequest) (*proto.ListUtxosReply, error) {
+	resp := d.preHandler(req)
+	if resp != nil {
+		return &proto.ListUtxosReply{
+			Code: proto.ReturnCode_ERROR,
+			Msg:  config.UnsupportedChain,
+		}, nil
+	}
+	return d.registry[req.Chain].ListUtxos(req)
+}
+
 func (d *ChainDispatcher) GetLatestBlockHeight(chain string) (int64, error) {
 	if handler, ok := d.registry[chain]; ok {
 		return handler.GetLatestBlockHeight()
@@ -388,3 +521,21 @@ func (d *ChainDispatcher) GetUtxoTransactionByHeight(chain string, height int64)
 	}()
 	return replyCh, errCh
 }
+
+// SubscribeUtxoMempool streams the mempool transactions paying the addresses as
+// the nodes announce them, until quit is closed
+func (d *ChainDispatcher) SubscribeUtxoMempool(chain string, addresses []string, quit <-chan struct{}) (<-chan *proto.QueryUtxoTransactionReply, <-chan error) {
+	errCh := make(chan error, 1)
+	replyCh := make(chan *proto.QueryUtxoTransactionReply)
+
+	go func() {
+		defer close(errCh)
+		defer close(replyCh)
+		if handler, ok := d.registry[chain]; ok {
+			handler.SubscribeUtxoMempool(addresses, quit, replyCh, errCh)
+		} else {
+			errCh <- errors.New(config.UnsupportedChain)
+		}
+	}()
+	return replyCh, errCh
+}
@@ -18,18 +18,41 @@ type RPC struct {
 	RPCURL  string `yaml:"rpc_url"`
 	RPCUser string `yaml:"rpc_user"`
 	RPCPass string `yaml:"rpc_pass"`
+	// Backend is the kind of service at the url for chains with more than one,
+	// empty for the full node of the chain
+	Backend string `yaml:"backend"`
+	// ZMQHashBlock and ZMQRawTx are the zmq endpoints the node publishes new
+	// blocks and transactions at, such as tcp://127.0.0.1:28332
+	ZMQHashBlock string `yaml:"zmq_hashblock"`
+	ZMQRawTx     string `yaml:"zmq_rawtx"`
+}
+
+// Contract is a contract whose calls are encoded and decoded with its ABI
+type Contract struct {
+	Address string `yaml:"address"`
+	// ABI is the path of the json ABI file
+	ABI string `yaml:"abi"`
 }
 
 type Node struct {
 	RPCs          []*RPC `yaml:"rpcs"`
 	Confirmations uint64 `yaml:"confirmations"`
+	// Contracts are the contracts registered for the chains running them
+	Contracts []*Contract `yaml:"contracts"`
+	// ConsensusBranchID is the branch id zcash transactions commit to, the one
+	// of the current network upgrade if unset
+	ConsensusBranchID uint32 `yaml:"consensus_branch_id"`
 }
 
 // Fullnode define
 type Fullnode struct {
-	Btc Node `yaml:"btc"`
-	Eth Node `yaml:"eth"`
-	Trx Node `yaml:"trx"`
+	Btc  Node `yaml:"btc"`
+	Bch  Node `yaml:"bch"`
+	Ltc  Node `yaml:"ltc"`
+	Doge Node `yaml:"doge"`
+	Zec  Node `yaml:"zec"`
+	Eth  Node `yaml:"eth"`
+	Trx  Node `yaml:"trx"`
 }
 
 // Config instance define
@@ -24,6 +24,7 @@ require (
 	github.com/stretchr/objx v0.2.1-0.20190415111823-35313a95ee26 // indirect
 	github.com/stretchr/testify v1.5.1
 	go.uber.org/atomic v1.6.0
+	golang.org/x/crypto v0.0.0-20200429183012-4b2356b1ed79
 	google.golang.org/grpc v1.29.1
 	gopkg.in/sourcemap.v1 v1.0.5 // indirect
 	gopkg.in/yaml.v2 v2.2.8
@@ -49,6 +49,52 @@ func (ReturnCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_748c1225f0901a7a, []int{0}
 }
 
+type RejectCode int32
+
+const (
+	RejectCode_REJECT_NONE             RejectCode = 0
+	RejectCode_REJECT_INSUFFICIENT_FEE RejectCode = 1
+	RejectCode_REJECT_NON_STANDARD     RejectCode = 2
+	RejectCode_REJECT_MISSING_INPUTS   RejectCode = 3
+	RejectCode_REJECT_ALREADY_SPENT    RejectCode = 4
+	RejectCode_REJECT_ALREADY_KNOWN    RejectCode = 5
+	RejectCode_REJECT_FEE_TOO_HIGH     RejectCode = 6
+	RejectCode_REJECT_INVALID          RejectCode = 7
+	RejectCode_REJECT_OTHER            RejectCode = 8
+)
+
+var RejectCode_name = map[int32]string{
+	0: "REJECT_NONE",
+	1: "REJECT_INSUFFICIENT_FEE",
+	2: "REJECT_NON_STANDARD",
+	3: "REJECT_MISSING_INPUTS",
+	4: "REJECT_ALREADY_SPENT",
+	5: "REJECT_ALREADY_KNOWN",
+	6: "REJECT_FEE_TOO_HIGH",
+	7: "REJECT_INVALID",
+	8: "REJECT_OTHER",
+}
+
+var RejectCode_value = map[string]int32{
+	"REJECT_NONE":             0,
+	"REJECT_INSUFFICIENT_FEE": 1,
+	"REJECT_NON_STANDARD":     2,
+	"REJECT_MISSING_INPUTS":   3,
+	"REJECT_ALREADY_SPENT":    4,
+	"REJECT_ALREADY_KNOWN":    5,
+	"REJECT_FEE_TOO_HIGH":     6,
+	"REJECT_INVALID":          7,
+	"REJECT_OTHER":            8,
+}
+
+func (x RejectCode) String() string {
+	return proto.EnumName(RejectCode_name, int32(x))
+}
+
+func (RejectCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{1}
+}
+
 type TxStatus int32
 
 const (
@@ -83,7 +129,35 @@ func (x TxStatus) String() string {
 }
 
 func (TxStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{1}
+	return fileDescriptor_748c1225f0901a7a, []int{2}
+}
+
+type SpendStatus int32
+
+const (
+	SpendStatus_SPENT            SpendStatus = 0
+	SpendStatus_SPENDER_UNKNOWN  SpendStatus = 1
+	SpendStatus_UNKNOWN_OUTPOINT SpendStatus = 2
+)
+
+var SpendStatus_name = map[int32]string{
+	0: "SPENT",
+	1: "SPENDER_UNKNOWN",
+	2: "UNKNOWN_OUTPOINT",
+}
+
+var SpendStatus_value = map[string]int32{
+	"SPENT":            0,
+	"SPENDER_UNKNOWN":  1,
+	"UNKNOWN_OUTPOINT": 2,
+}
+
+func (x SpendStatus) String() string {
+	return proto.EnumName(SpendStatus_name, int32(x))
+}
+
+func (SpendStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{3}
 }
 
 type SupportChainRequest struct {
@@ -787,12 +861,14 @@ func (m *QueryGasPriceRequest) GetChain() string {
 }
 
 type QueryGasPriceReply struct {
-	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
-	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	GasPrice             string     `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Code                 ReturnCode     `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string         `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	GasPrice             string         `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	BaseFee              string         `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3" json:"base_fee,omitempty"`
+	PriorityFees         []*PriorityFee `protobuf:"bytes,5,rep,name=priority_fees,json=priorityFees,proto3" json:"priority_fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *QueryGasPriceReply) Reset()         { *m = QueryGasPriceReply{} }
@@ -841,6 +917,67 @@ func (m *QueryGasPriceReply) GetGasPrice() string {
 	return ""
 }
 
+func (m *QueryGasPriceReply) GetBaseFee() string {
+	if m != nil {
+		return m.BaseFee
+	}
+	return ""
+}
+
+func (m *QueryGasPriceReply) GetPriorityFees() []*PriorityFee {
+	if m != nil {
+		return m.PriorityFees
+	}
+	return nil
+}
+
+type PriorityFee struct {
+	Percentile           float64  `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Fee                  string   `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriorityFee) Reset()         { *m = PriorityFee{} }
+func (m *PriorityFee) String() string { return proto.CompactTextString(m) }
+func (*PriorityFee) ProtoMessage()    {}
+func (*PriorityFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{14}
+}
+
+func (m *PriorityFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriorityFee.Unmarshal(m, b)
+}
+func (m *PriorityFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriorityFee.Marshal(b, m, deterministic)
+}
+func (m *PriorityFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriorityFee.Merge(m, src)
+}
+func (m *PriorityFee) XXX_Size() int {
+	return xxx_messageInfo_PriorityFee.Size(m)
+}
+func (m *PriorityFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriorityFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriorityFee proto.InternalMessageInfo
+
+func (m *PriorityFee) GetPercentile() float64 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *PriorityFee) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 type QueryTransactionRequest struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -855,7 +992,7 @@ func (m *QueryTransactionRequest) Reset()         { *m = QueryTransactionRequest
 func (m *QueryTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTransactionRequest) ProtoMessage()    {}
 func (*QueryTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{14}
+	return fileDescriptor_748c1225f0901a7a, []int{15}
 }
 
 func (m *QueryTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -906,26 +1043,28 @@ func (m *QueryTransactionRequest) GetAsyncMode() bool {
 }
 
 type QueryUtxoTransactionReply struct {
-	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
-	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	TxHash               string     `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	TxStatus             TxStatus   `protobuf:"varint,4,opt,name=tx_status,json=txStatus,proto3,enum=proto.TxStatus" json:"tx_status,omitempty"`
-	Vins                 []*Vin     `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
-	Vouts                []*Vout    `protobuf:"bytes,6,rep,name=vouts,proto3" json:"vouts,omitempty"`
-	SignHashes           [][]byte   `protobuf:"bytes,7,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
-	CostFee              string     `protobuf:"bytes,8,opt,name=cost_fee,json=costFee,proto3" json:"cost_fee,omitempty"`
-	BlockHeight          uint64     `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	BlockTime            uint64     `protobuf:"varint,10,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Code                 ReturnCode    `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string        `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TxHash               string        `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxStatus             TxStatus      `protobuf:"varint,4,opt,name=tx_status,json=txStatus,proto3,enum=proto.TxStatus" json:"tx_status,omitempty"`
+	Vins                 []*Vin        `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout       `protobuf:"bytes,6,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	SignHashes           [][]byte      `protobuf:"bytes,7,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	CostFee              string        `protobuf:"bytes,8,opt,name=cost_fee,json=costFee,proto3" json:"cost_fee,omitempty"`
+	BlockHeight          uint64        `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime            uint64        `protobuf:"varint,10,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	OmniTransfer         *OmniTransfer `protobuf:"bytes,11,opt,name=omni_transfer,json=omniTransfer,proto3" json:"omni_transfer,omitempty"`
+	Mempool              *MempoolEntry `protobuf:"bytes,12,opt,name=mempool,proto3" json:"mempool,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *QueryUtxoTransactionReply) Reset()         { *m = QueryUtxoTransactionReply{} }
 func (m *QueryUtxoTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*QueryUtxoTransactionReply) ProtoMessage()    {}
 func (*QueryUtxoTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{15}
+	return fileDescriptor_748c1225f0901a7a, []int{16}
 }
 
 func (m *QueryUtxoTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -1016,33 +1155,141 @@ func (m *QueryUtxoTransactionReply) GetBlockTime() uint64 {
 	return 0
 }
 
+func (m *QueryUtxoTransactionReply) GetOmniTransfer() *OmniTransfer {
+	if m != nil {
+		return m.OmniTransfer
+	}
+	return nil
+}
+
+func (m *QueryUtxoTransactionReply) GetMempool() *MempoolEntry {
+	if m != nil {
+		return m.Mempool
+	}
+	return nil
+}
+
+type MempoolEntry struct {
+	Time                 int64    `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Fee                  string   `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeRate              uint64   `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	Vsize                int64    `protobuf:"varint,4,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	Replaceable          bool     `protobuf:"varint,5,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	AncestorCount        int64    `protobuf:"varint,6,opt,name=ancestor_count,json=ancestorCount,proto3" json:"ancestor_count,omitempty"`
+	DescendantCount      int64    `protobuf:"varint,7,opt,name=descendant_count,json=descendantCount,proto3" json:"descendant_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MempoolEntry) Reset()         { *m = MempoolEntry{} }
+func (m *MempoolEntry) String() string { return proto.CompactTextString(m) }
+func (*MempoolEntry) ProtoMessage()    {}
+func (*MempoolEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{17}
+}
+
+func (m *MempoolEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MempoolEntry.Unmarshal(m, b)
+}
+func (m *MempoolEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MempoolEntry.Marshal(b, m, deterministic)
+}
+func (m *MempoolEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MempoolEntry.Merge(m, src)
+}
+func (m *MempoolEntry) XXX_Size() int {
+	return xxx_messageInfo_MempoolEntry.Size(m)
+}
+func (m *MempoolEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MempoolEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MempoolEntry proto.InternalMessageInfo
+
+func (m *MempoolEntry) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *MempoolEntry) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetVsize() int64 {
+	if m != nil {
+		return m.Vsize
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+func (m *MempoolEntry) GetAncestorCount() int64 {
+	if m != nil {
+		return m.AncestorCount
+	}
+	return 0
+}
+
+func (m *MempoolEntry) GetDescendantCount() int64 {
+	if m != nil {
+		return m.DescendantCount
+	}
+	return 0
+}
+
 type QueryAccountTransactionReply struct {
-	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
-	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
-	TxHash               string     `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
-	TxStatus             TxStatus   `protobuf:"varint,4,opt,name=tx_status,json=txStatus,proto3,enum=proto.TxStatus" json:"tx_status,omitempty"`
-	From                 string     `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string     `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
-	Amount               string     `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
-	Memo                 string     `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
-	Nonce                uint64     `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	GasLimit             string     `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	GasPrice             string     `protobuf:"bytes,11,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	CostFee              string     `protobuf:"bytes,12,opt,name=cost_fee,json=costFee,proto3" json:"cost_fee,omitempty"`
-	BlockHeight          uint64     `protobuf:"varint,13,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	BlockTime            uint64     `protobuf:"varint,14,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
-	SignHash             []byte     `protobuf:"bytes,15,opt,name=sign_hash,json=signHash,proto3" json:"sign_hash,omitempty"`
-	ContractAddress      string     `protobuf:"bytes,16,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Code                 ReturnCode     `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string         `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TxHash               string         `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxStatus             TxStatus       `protobuf:"varint,4,opt,name=tx_status,json=txStatus,proto3,enum=proto.TxStatus" json:"tx_status,omitempty"`
+	From                 string         `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string         `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               string         `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo                 string         `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	Nonce                uint64         `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasLimit             string         `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice             string         `protobuf:"bytes,11,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	CostFee              string         `protobuf:"bytes,12,opt,name=cost_fee,json=costFee,proto3" json:"cost_fee,omitempty"`
+	BlockHeight          uint64         `protobuf:"varint,13,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime            uint64         `protobuf:"varint,14,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	SignHash             []byte         `protobuf:"bytes,15,opt,name=sign_hash,json=signHash,proto3" json:"sign_hash,omitempty"`
+	ContractAddress      string         `protobuf:"bytes,16,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	TxType               uint32         `protobuf:"varint,17,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	MaxFeePerGas         string         `protobuf:"bytes,18,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string         `protobuf:"bytes,19,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	AccessList           []*AccessTuple `protobuf:"bytes,20,rep,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	Data                 []byte         `protobuf:"bytes,21,opt,name=data,proto3" json:"data,omitempty"`
+	Method               string         `protobuf:"bytes,22,opt,name=method,proto3" json:"method,omitempty"`
+	Args                 string         `protobuf:"bytes,23,opt,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *QueryAccountTransactionReply) Reset()         { *m = QueryAccountTransactionReply{} }
 func (m *QueryAccountTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountTransactionReply) ProtoMessage()    {}
 func (*QueryAccountTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{16}
+	return fileDescriptor_748c1225f0901a7a, []int{18}
 }
 
 func (m *QueryAccountTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -1175,6 +1422,55 @@ func (m *QueryAccountTransactionReply) GetContractAddress() string {
 	return ""
 }
 
+func (m *QueryAccountTransactionReply) GetTxType() uint32 {
+	if m != nil {
+		return m.TxType
+	}
+	return 0
+}
+
+func (m *QueryAccountTransactionReply) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *QueryAccountTransactionReply) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (m *QueryAccountTransactionReply) GetAccessList() []*AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *QueryAccountTransactionReply) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *QueryAccountTransactionReply) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *QueryAccountTransactionReply) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
 type QueryTransactionFromSignedDataRequest struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -1190,7 +1486,7 @@ func (m *QueryTransactionFromSignedDataRequest) Reset()         { *m = QueryTran
 func (m *QueryTransactionFromSignedDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTransactionFromSignedDataRequest) ProtoMessage()    {}
 func (*QueryTransactionFromSignedDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{17}
+	return fileDescriptor_748c1225f0901a7a, []int{19}
 }
 
 func (m *QueryTransactionFromSignedDataRequest) XXX_Unmarshal(b []byte) error {
@@ -1252,6 +1548,7 @@ type QueryTransactionFromDataRequest struct {
 	RawData              []byte   `protobuf:"bytes,3,opt,name=raw_data,json=rawData,proto3" json:"raw_data,omitempty"`
 	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
 	Vins                 []*Vin   `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
+	Abi                  string   `protobuf:"bytes,6,opt,name=abi,proto3" json:"abi,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1261,7 +1558,7 @@ func (m *QueryTransactionFromDataRequest) Reset()         { *m = QueryTransactio
 func (m *QueryTransactionFromDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTransactionFromDataRequest) ProtoMessage()    {}
 func (*QueryTransactionFromDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{18}
+	return fileDescriptor_748c1225f0901a7a, []int{20}
 }
 
 func (m *QueryTransactionFromDataRequest) XXX_Unmarshal(b []byte) error {
@@ -1317,11 +1614,24 @@ func (m *QueryTransactionFromDataRequest) GetVins() []*Vin {
 	return nil
 }
 
+func (m *QueryTransactionFromDataRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
 type Vin struct {
 	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	SighashType          uint32   `protobuf:"varint,5,opt,name=sighash_type,json=sighashType,proto3" json:"sighash_type,omitempty"`
+	Sequence             uint32   `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	WitnessScript        []byte   `protobuf:"bytes,7,opt,name=witness_script,json=witnessScript,proto3" json:"witness_script,omitempty"`
+	WitnessBranches      []bool   `protobuf:"varint,8,rep,packed,name=witness_branches,json=witnessBranches,proto3" json:"witness_branches,omitempty"`
+	Coinbase             bool     `protobuf:"varint,9,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	Unresolved           bool     `protobuf:"varint,10,opt,name=unresolved,proto3" json:"unresolved,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1331,7 +1641,7 @@ func (m *Vin) Reset()         { *m = Vin{} }
 func (m *Vin) String() string { return proto.CompactTextString(m) }
 func (*Vin) ProtoMessage()    {}
 func (*Vin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{19}
+	return fileDescriptor_748c1225f0901a7a, []int{21}
 }
 
 func (m *Vin) XXX_Unmarshal(b []byte) error {
@@ -1380,20 +1690,66 @@ func (m *Vin) GetAddress() string {
 	return ""
 }
 
+func (m *Vin) GetSighashType() uint32 {
+	if m != nil {
+		return m.SighashType
+	}
+	return 0
+}
+
+func (m *Vin) GetSequence() uint32 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Vin) GetWitnessScript() []byte {
+	if m != nil {
+		return m.WitnessScript
+	}
+	return nil
+}
+
+func (m *Vin) GetWitnessBranches() []bool {
+	if m != nil {
+		return m.WitnessBranches
+	}
+	return nil
+}
+
+func (m *Vin) GetCoinbase() bool {
+	if m != nil {
+		return m.Coinbase
+	}
+	return false
+}
+
+func (m *Vin) GetUnresolved() bool {
+	if m != nil {
+		return m.Unresolved
+	}
+	return false
+}
+
 type Vout struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Address              string          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64           `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Index                uint32          `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Omni                 *OmniSimpleSend `protobuf:"bytes,4,opt,name=omni,proto3" json:"omni,omitempty"`
+	ScriptType           string          `protobuf:"bytes,5,opt,name=script_type,json=scriptType,proto3" json:"script_type,omitempty"`
+	Script               string          `protobuf:"bytes,6,opt,name=script,proto3" json:"script,omitempty"`
+	Memo                 string          `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *Vout) Reset()         { *m = Vout{} }
 func (m *Vout) String() string { return proto.CompactTextString(m) }
 func (*Vout) ProtoMessage()    {}
 func (*Vout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{20}
+	return fileDescriptor_748c1225f0901a7a, []int{22}
 }
 
 func (m *Vout) XXX_Unmarshal(b []byte) error {
@@ -1435,25 +1791,166 @@ func (m *Vout) GetIndex() uint32 {
 	return 0
 }
 
-type CreateUtxoTransactionRequest struct {
-	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
-	Vins                 []*Vin   `protobuf:"bytes,3,rep,name=vins,proto3" json:"vins,omitempty"`
-	Vouts                []*Vout  `protobuf:"bytes,4,rep,name=vouts,proto3" json:"vouts,omitempty"`
-	Fee                  string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Vout) GetOmni() *OmniSimpleSend {
+	if m != nil {
+		return m.Omni
+	}
+	return nil
 }
 
-func (m *CreateUtxoTransactionRequest) Reset()         { *m = CreateUtxoTransactionRequest{} }
-func (m *CreateUtxoTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateUtxoTransactionRequest) ProtoMessage()    {}
-func (*CreateUtxoTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{21}
+func (m *Vout) GetScriptType() string {
+	if m != nil {
+		return m.ScriptType
+	}
+	return ""
 }
 
-func (m *CreateUtxoTransactionRequest) XXX_Unmarshal(b []byte) error {
+func (m *Vout) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *Vout) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+type OmniSimpleSend struct {
+	PropertyId           uint32   `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OmniSimpleSend) Reset()         { *m = OmniSimpleSend{} }
+func (m *OmniSimpleSend) String() string { return proto.CompactTextString(m) }
+func (*OmniSimpleSend) ProtoMessage()    {}
+func (*OmniSimpleSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{23}
+}
+
+func (m *OmniSimpleSend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OmniSimpleSend.Unmarshal(m, b)
+}
+func (m *OmniSimpleSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OmniSimpleSend.Marshal(b, m, deterministic)
+}
+func (m *OmniSimpleSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OmniSimpleSend.Merge(m, src)
+}
+func (m *OmniSimpleSend) XXX_Size() int {
+	return xxx_messageInfo_OmniSimpleSend.Size(m)
+}
+func (m *OmniSimpleSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_OmniSimpleSend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OmniSimpleSend proto.InternalMessageInfo
+
+func (m *OmniSimpleSend) GetPropertyId() uint32 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *OmniSimpleSend) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type OmniTransfer struct {
+	PropertyId           uint32   `protobuf:"varint,1,opt,name=property_id,json=propertyId,proto3" json:"property_id,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sender               string   `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	Reference            string   `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OmniTransfer) Reset()         { *m = OmniTransfer{} }
+func (m *OmniTransfer) String() string { return proto.CompactTextString(m) }
+func (*OmniTransfer) ProtoMessage()    {}
+func (*OmniTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{24}
+}
+
+func (m *OmniTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OmniTransfer.Unmarshal(m, b)
+}
+func (m *OmniTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OmniTransfer.Marshal(b, m, deterministic)
+}
+func (m *OmniTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OmniTransfer.Merge(m, src)
+}
+func (m *OmniTransfer) XXX_Size() int {
+	return xxx_messageInfo_OmniTransfer.Size(m)
+}
+func (m *OmniTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OmniTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OmniTransfer proto.InternalMessageInfo
+
+func (m *OmniTransfer) GetPropertyId() uint32 {
+	if m != nil {
+		return m.PropertyId
+	}
+	return 0
+}
+
+func (m *OmniTransfer) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OmniTransfer) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *OmniTransfer) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type CreateUtxoTransactionRequest struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,3,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout  `protobuf:"bytes,4,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	Fee                  string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Replaceable          bool     `protobuf:"varint,6,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	Memo                 string   `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	LockTime             uint32   `protobuf:"varint,8,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUtxoTransactionRequest) Reset()         { *m = CreateUtxoTransactionRequest{} }
+func (m *CreateUtxoTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUtxoTransactionRequest) ProtoMessage()    {}
+func (*CreateUtxoTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{25}
+}
+
+func (m *CreateUtxoTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateUtxoTransactionRequest.Unmarshal(m, b)
 }
 func (m *CreateUtxoTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
@@ -1506,11 +2003,33 @@ func (m *CreateUtxoTransactionRequest) GetFee() string {
 	return ""
 }
 
+func (m *CreateUtxoTransactionRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+func (m *CreateUtxoTransactionRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *CreateUtxoTransactionRequest) GetLockTime() uint32 {
+	if m != nil {
+		return m.LockTime
+	}
+	return 0
+}
+
 type CreateUtxoTransactionReply struct {
 	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
 	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	TxData               []byte     `protobuf:"bytes,3,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
 	SignHashes           [][]byte   `protobuf:"bytes,4,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Warnings             []string   `protobuf:"bytes,5,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -1520,7 +2039,7 @@ func (m *CreateUtxoTransactionReply) Reset()         { *m = CreateUtxoTransactio
 func (m *CreateUtxoTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*CreateUtxoTransactionReply) ProtoMessage()    {}
 func (*CreateUtxoTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{22}
+	return fileDescriptor_748c1225f0901a7a, []int{26}
 }
 
 func (m *CreateUtxoTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -1569,27 +2088,42 @@ func (m *CreateUtxoTransactionReply) GetSignHashes() [][]byte {
 	return nil
 }
 
+func (m *CreateUtxoTransactionReply) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 type CreateAccountTransactionRequest struct {
-	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
-	From                 string   `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
-	To                   string   `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Amount               string   `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Memo                 string   `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
-	GasLimit             string   `protobuf:"bytes,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	GasPrice             string   `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	Nonce                uint64   `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	ContractAddress      string   `protobuf:"bytes,10,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Symbol               string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Chain                string         `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	From                 string         `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string         `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               string         `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Memo                 string         `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	GasLimit             string         `protobuf:"bytes,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice             string         `protobuf:"bytes,8,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Nonce                uint64         `protobuf:"varint,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	ContractAddress      string         `protobuf:"bytes,10,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	MaxFeePerGas         string         `protobuf:"bytes,11,opt,name=max_fee_per_gas,json=maxFeePerGas,proto3" json:"max_fee_per_gas,omitempty"`
+	MaxPriorityFeePerGas string         `protobuf:"bytes,12,opt,name=max_priority_fee_per_gas,json=maxPriorityFeePerGas,proto3" json:"max_priority_fee_per_gas,omitempty"`
+	AccessList           []*AccessTuple `protobuf:"bytes,13,rep,name=access_list,json=accessList,proto3" json:"access_list,omitempty"`
+	CreateAccessList     bool           `protobuf:"varint,14,opt,name=create_access_list,json=createAccessList,proto3" json:"create_access_list,omitempty"`
+	Data                 []byte         `protobuf:"bytes,15,opt,name=data,proto3" json:"data,omitempty"`
+	Method               string         `protobuf:"bytes,16,opt,name=method,proto3" json:"method,omitempty"`
+	Args                 string         `protobuf:"bytes,17,opt,name=args,proto3" json:"args,omitempty"`
+	Abi                  string         `protobuf:"bytes,18,opt,name=abi,proto3" json:"abi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *CreateAccountTransactionRequest) Reset()         { *m = CreateAccountTransactionRequest{} }
 func (m *CreateAccountTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountTransactionRequest) ProtoMessage()    {}
 func (*CreateAccountTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{23}
+	return fileDescriptor_748c1225f0901a7a, []int{27}
 }
 
 func (m *CreateAccountTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -1680,6 +2214,109 @@ func (m *CreateAccountTransactionRequest) GetContractAddress() string {
 	return ""
 }
 
+func (m *CreateAccountTransactionRequest) GetMaxFeePerGas() string {
+	if m != nil {
+		return m.MaxFeePerGas
+	}
+	return ""
+}
+
+func (m *CreateAccountTransactionRequest) GetMaxPriorityFeePerGas() string {
+	if m != nil {
+		return m.MaxPriorityFeePerGas
+	}
+	return ""
+}
+
+func (m *CreateAccountTransactionRequest) GetAccessList() []*AccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *CreateAccountTransactionRequest) GetCreateAccessList() bool {
+	if m != nil {
+		return m.CreateAccessList
+	}
+	return false
+}
+
+func (m *CreateAccountTransactionRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *CreateAccountTransactionRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *CreateAccountTransactionRequest) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *CreateAccountTransactionRequest) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+type AccessTuple struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys          []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
+func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
+func (*AccessTuple) ProtoMessage()    {}
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{28}
+}
+
+func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessTuple.Unmarshal(m, b)
+}
+func (m *AccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessTuple.Marshal(b, m, deterministic)
+}
+func (m *AccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessTuple.Merge(m, src)
+}
+func (m *AccessTuple) XXX_Size() int {
+	return xxx_messageInfo_AccessTuple.Size(m)
+}
+func (m *AccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessTuple proto.InternalMessageInfo
+
+func (m *AccessTuple) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccessTuple) GetStorageKeys() []string {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
 type CreateAccountTransactionReply struct {
 	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
 	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1694,7 +2331,7 @@ func (m *CreateAccountTransactionReply) Reset()         { *m = CreateAccountTran
 func (m *CreateAccountTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountTransactionReply) ProtoMessage()    {}
 func (*CreateAccountTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{24}
+	return fileDescriptor_748c1225f0901a7a, []int{29}
 }
 
 func (m *CreateAccountTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -1758,7 +2395,7 @@ func (m *CreateAccountSignedTransactionRequest) Reset()         { *m = CreateAcc
 func (m *CreateAccountSignedTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateAccountSignedTransactionRequest) ProtoMessage()    {}
 func (*CreateAccountSignedTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{25}
+	return fileDescriptor_748c1225f0901a7a, []int{30}
 }
 
 func (m *CreateAccountSignedTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -1820,6 +2457,7 @@ type CreateUtxoSignedTransactionRequest struct {
 	TxData               []byte   `protobuf:"bytes,3,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
 	Signatures           [][]byte `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
 	PublicKeys           [][]byte `protobuf:"bytes,5,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,6,rep,name=vins,proto3" json:"vins,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1829,7 +2467,7 @@ func (m *CreateUtxoSignedTransactionRequest) Reset()         { *m = CreateUtxoSi
 func (m *CreateUtxoSignedTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateUtxoSignedTransactionRequest) ProtoMessage()    {}
 func (*CreateUtxoSignedTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{26}
+	return fileDescriptor_748c1225f0901a7a, []int{31}
 }
 
 func (m *CreateUtxoSignedTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -1885,6 +2523,13 @@ func (m *CreateUtxoSignedTransactionRequest) GetPublicKeys() [][]byte {
 	return nil
 }
 
+func (m *CreateUtxoSignedTransactionRequest) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
 type CreateSignedTransactionReply struct {
 	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
 	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1899,7 +2544,7 @@ func (m *CreateSignedTransactionReply) Reset()         { *m = CreateSignedTransa
 func (m *CreateSignedTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*CreateSignedTransactionReply) ProtoMessage()    {}
 func (*CreateSignedTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{27}
+	return fileDescriptor_748c1225f0901a7a, []int{32}
 }
 
 func (m *CreateSignedTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -1952,6 +2597,8 @@ type BroadcastTransactionRequest struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
 	SignedTxData         []byte   `protobuf:"bytes,3,opt,name=signed_tx_data,json=signedTxData,proto3" json:"signed_tx_data,omitempty"`
+	MaxFeeRate           uint64   `protobuf:"varint,4,opt,name=max_fee_rate,json=maxFeeRate,proto3" json:"max_fee_rate,omitempty"`
+	CheckPolicy          bool     `protobuf:"varint,5,opt,name=check_policy,json=checkPolicy,proto3" json:"check_policy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1961,7 +2608,7 @@ func (m *BroadcastTransactionRequest) Reset()         { *m = BroadcastTransactio
 func (m *BroadcastTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTransactionRequest) ProtoMessage()    {}
 func (*BroadcastTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{28}
+	return fileDescriptor_748c1225f0901a7a, []int{33}
 }
 
 func (m *BroadcastTransactionRequest) XXX_Unmarshal(b []byte) error {
@@ -2003,10 +2650,26 @@ func (m *BroadcastTransactionRequest) GetSignedTxData() []byte {
 	return nil
 }
 
+func (m *BroadcastTransactionRequest) GetMaxFeeRate() uint64 {
+	if m != nil {
+		return m.MaxFeeRate
+	}
+	return 0
+}
+
+func (m *BroadcastTransactionRequest) GetCheckPolicy() bool {
+	if m != nil {
+		return m.CheckPolicy
+	}
+	return false
+}
+
 type BroadcastTransactionReply struct {
 	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
 	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 	TxHash               string     `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	RejectCode           RejectCode `protobuf:"varint,4,opt,name=reject_code,json=rejectCode,proto3,enum=proto.RejectCode" json:"reject_code,omitempty"`
+	RejectReason         string     `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -2016,7 +2679,7 @@ func (m *BroadcastTransactionReply) Reset()         { *m = BroadcastTransactionR
 func (m *BroadcastTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*BroadcastTransactionReply) ProtoMessage()    {}
 func (*BroadcastTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{29}
+	return fileDescriptor_748c1225f0901a7a, []int{34}
 }
 
 func (m *BroadcastTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -2058,36 +2721,145 @@ func (m *BroadcastTransactionReply) GetTxHash() string {
 	return ""
 }
 
-type VerifySignedTransactionRequest struct {
-	Symbol       string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Chain        string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
-	Addresses    []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"` // Deprecated: Do not use.
-	SignedTxData []byte   `protobuf:"bytes,4,opt,name=signed_tx_data,json=signedTxData,proto3" json:"signed_tx_data,omitempty"`
-	// for eth
-	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
-	Height int64  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
-	// for btc
-	Vins                 []*Vin   `protobuf:"bytes,7,rep,name=vins,proto3" json:"vins,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *BroadcastTransactionReply) GetRejectCode() RejectCode {
+	if m != nil {
+		return m.RejectCode
+	}
+	return RejectCode_REJECT_NONE
 }
 
-func (m *VerifySignedTransactionRequest) Reset()         { *m = VerifySignedTransactionRequest{} }
-func (m *VerifySignedTransactionRequest) String() string { return proto.CompactTextString(m) }
-func (*VerifySignedTransactionRequest) ProtoMessage()    {}
-func (*VerifySignedTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{30}
+func (m *BroadcastTransactionReply) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
+	}
+	return ""
 }
 
-func (m *VerifySignedTransactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VerifySignedTransactionRequest.Unmarshal(m, b)
+type DryRunBroadcastReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Allowed              bool       `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	TxHash               string     `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	RejectCode           RejectCode `protobuf:"varint,5,opt,name=reject_code,json=rejectCode,proto3,enum=proto.RejectCode" json:"reject_code,omitempty"`
+	RejectReason         string     `protobuf:"bytes,6,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	Fee                  string     `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Vsize                int64      `protobuf:"varint,8,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *VerifySignedTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VerifySignedTransactionRequest.Marshal(b, m, deterministic)
+
+func (m *DryRunBroadcastReply) Reset()         { *m = DryRunBroadcastReply{} }
+func (m *DryRunBroadcastReply) String() string { return proto.CompactTextString(m) }
+func (*DryRunBroadcastReply) ProtoMessage()    {}
+func (*DryRunBroadcastReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{35}
 }
-func (m *VerifySignedTransactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VerifySignedTransactionRequest.Merge(m, src)
+
+func (m *DryRunBroadcastReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DryRunBroadcastReply.Unmarshal(m, b)
+}
+func (m *DryRunBroadcastReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DryRunBroadcastReply.Marshal(b, m, deterministic)
+}
+func (m *DryRunBroadcastReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunBroadcastReply.Merge(m, src)
+}
+func (m *DryRunBroadcastReply) XXX_Size() int {
+	return xxx_messageInfo_DryRunBroadcastReply.Size(m)
+}
+func (m *DryRunBroadcastReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunBroadcastReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DryRunBroadcastReply proto.InternalMessageInfo
+
+func (m *DryRunBroadcastReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *DryRunBroadcastReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *DryRunBroadcastReply) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *DryRunBroadcastReply) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *DryRunBroadcastReply) GetRejectCode() RejectCode {
+	if m != nil {
+		return m.RejectCode
+	}
+	return RejectCode_REJECT_NONE
+}
+
+func (m *DryRunBroadcastReply) GetRejectReason() string {
+	if m != nil {
+		return m.RejectReason
+	}
+	return ""
+}
+
+func (m *DryRunBroadcastReply) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *DryRunBroadcastReply) GetVsize() int64 {
+	if m != nil {
+		return m.Vsize
+	}
+	return 0
+}
+
+type VerifySignedTransactionRequest struct {
+	Symbol       string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Chain        string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	Addresses    []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"` // Deprecated: Do not use.
+	SignedTxData []byte   `protobuf:"bytes,4,opt,name=signed_tx_data,json=signedTxData,proto3" json:"signed_tx_data,omitempty"`
+	// for eth
+	Sender string `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	Height int64  `protobuf:"varint,6,opt,name=height,proto3" json:"height,omitempty"`
+	// for btc
+	Vins                 []*Vin   `protobuf:"bytes,7,rep,name=vins,proto3" json:"vins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifySignedTransactionRequest) Reset()         { *m = VerifySignedTransactionRequest{} }
+func (m *VerifySignedTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifySignedTransactionRequest) ProtoMessage()    {}
+func (*VerifySignedTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{36}
+}
+
+func (m *VerifySignedTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifySignedTransactionRequest.Unmarshal(m, b)
+}
+func (m *VerifySignedTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifySignedTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifySignedTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifySignedTransactionRequest.Merge(m, src)
 }
 func (m *VerifySignedTransactionRequest) XXX_Size() int {
 	return xxx_messageInfo_VerifySignedTransactionRequest.Size(m)
@@ -2161,7 +2933,7 @@ func (m *VerifySignedTransactionReply) Reset()         { *m = VerifySignedTransa
 func (m *VerifySignedTransactionReply) String() string { return proto.CompactTextString(m) }
 func (*VerifySignedTransactionReply) ProtoMessage()    {}
 func (*VerifySignedTransactionReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{31}
+	return fileDescriptor_748c1225f0901a7a, []int{37}
 }
 
 func (m *VerifySignedTransactionReply) XXX_Unmarshal(b []byte) error {
@@ -2203,6 +2975,315 @@ func (m *VerifySignedTransactionReply) GetVerified() bool {
 	return false
 }
 
+// TxInclusionProof is the SPV proof of a tx in a block: the raw tx hashes to the txid
+// and the merkle branch leads from it to the merkle root of the header.
+type TxInclusionProof struct {
+	TxHash               string   `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHeader          []byte   `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	BlockHash            string   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight          uint64   `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TxIndex              uint32   `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	TxCount              uint32   `protobuf:"varint,6,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	MerkleBranch         [][]byte `protobuf:"bytes,7,rep,name=merkle_branch,json=merkleBranch,proto3" json:"merkle_branch,omitempty"`
+	RawTx                []byte   `protobuf:"bytes,8,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxInclusionProof) Reset()         { *m = TxInclusionProof{} }
+func (m *TxInclusionProof) String() string { return proto.CompactTextString(m) }
+func (*TxInclusionProof) ProtoMessage()    {}
+func (*TxInclusionProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{38}
+}
+
+func (m *TxInclusionProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxInclusionProof.Unmarshal(m, b)
+}
+func (m *TxInclusionProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxInclusionProof.Marshal(b, m, deterministic)
+}
+func (m *TxInclusionProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxInclusionProof.Merge(m, src)
+}
+func (m *TxInclusionProof) XXX_Size() int {
+	return xxx_messageInfo_TxInclusionProof.Size(m)
+}
+func (m *TxInclusionProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxInclusionProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxInclusionProof proto.InternalMessageInfo
+
+func (m *TxInclusionProof) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *TxInclusionProof) GetBlockHeader() []byte {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return nil
+}
+
+func (m *TxInclusionProof) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *TxInclusionProof) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TxInclusionProof) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *TxInclusionProof) GetTxCount() uint32 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func (m *TxInclusionProof) GetMerkleBranch() [][]byte {
+	if m != nil {
+		return m.MerkleBranch
+	}
+	return nil
+}
+
+func (m *TxInclusionProof) GetRawTx() []byte {
+	if m != nil {
+		return m.RawTx
+	}
+	return nil
+}
+
+type GetTxInclusionProofRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxHash               string   `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	BlockHash            string   `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTxInclusionProofRequest) Reset()         { *m = GetTxInclusionProofRequest{} }
+func (m *GetTxInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxInclusionProofRequest) ProtoMessage()    {}
+func (*GetTxInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{39}
+}
+
+func (m *GetTxInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxInclusionProofRequest.Unmarshal(m, b)
+}
+func (m *GetTxInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxInclusionProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTxInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxInclusionProofRequest.Merge(m, src)
+}
+func (m *GetTxInclusionProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxInclusionProofRequest.Size(m)
+}
+func (m *GetTxInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxInclusionProofRequest proto.InternalMessageInfo
+
+func (m *GetTxInclusionProofRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *GetTxInclusionProofRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *GetTxInclusionProofRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+type GetTxInclusionProofReply struct {
+	Code                 ReturnCode        `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string            `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Proof                *TxInclusionProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetTxInclusionProofReply) Reset()         { *m = GetTxInclusionProofReply{} }
+func (m *GetTxInclusionProofReply) String() string { return proto.CompactTextString(m) }
+func (*GetTxInclusionProofReply) ProtoMessage()    {}
+func (*GetTxInclusionProofReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{40}
+}
+
+func (m *GetTxInclusionProofReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxInclusionProofReply.Unmarshal(m, b)
+}
+func (m *GetTxInclusionProofReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxInclusionProofReply.Marshal(b, m, deterministic)
+}
+func (m *GetTxInclusionProofReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxInclusionProofReply.Merge(m, src)
+}
+func (m *GetTxInclusionProofReply) XXX_Size() int {
+	return xxx_messageInfo_GetTxInclusionProofReply.Size(m)
+}
+func (m *GetTxInclusionProofReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxInclusionProofReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTxInclusionProofReply proto.InternalMessageInfo
+
+func (m *GetTxInclusionProofReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *GetTxInclusionProofReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *GetTxInclusionProofReply) GetProof() *TxInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type VerifyTxInclusionProofRequest struct {
+	Chain                string            `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Proof                *TxInclusionProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *VerifyTxInclusionProofRequest) Reset()         { *m = VerifyTxInclusionProofRequest{} }
+func (m *VerifyTxInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyTxInclusionProofRequest) ProtoMessage()    {}
+func (*VerifyTxInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{41}
+}
+
+func (m *VerifyTxInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTxInclusionProofRequest.Unmarshal(m, b)
+}
+func (m *VerifyTxInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTxInclusionProofRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyTxInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTxInclusionProofRequest.Merge(m, src)
+}
+func (m *VerifyTxInclusionProofRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyTxInclusionProofRequest.Size(m)
+}
+func (m *VerifyTxInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTxInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTxInclusionProofRequest proto.InternalMessageInfo
+
+func (m *VerifyTxInclusionProofRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *VerifyTxInclusionProofRequest) GetProof() *TxInclusionProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type VerifyTxInclusionProofReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Verified             bool       `protobuf:"varint,3,opt,name=verified,proto3" json:"verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *VerifyTxInclusionProofReply) Reset()         { *m = VerifyTxInclusionProofReply{} }
+func (m *VerifyTxInclusionProofReply) String() string { return proto.CompactTextString(m) }
+func (*VerifyTxInclusionProofReply) ProtoMessage()    {}
+func (*VerifyTxInclusionProofReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{42}
+}
+
+func (m *VerifyTxInclusionProofReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTxInclusionProofReply.Unmarshal(m, b)
+}
+func (m *VerifyTxInclusionProofReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTxInclusionProofReply.Marshal(b, m, deterministic)
+}
+func (m *VerifyTxInclusionProofReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTxInclusionProofReply.Merge(m, src)
+}
+func (m *VerifyTxInclusionProofReply) XXX_Size() int {
+	return xxx_messageInfo_VerifyTxInclusionProofReply.Size(m)
+}
+func (m *VerifyTxInclusionProofReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTxInclusionProofReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTxInclusionProofReply proto.InternalMessageInfo
+
+func (m *VerifyTxInclusionProofReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *VerifyTxInclusionProofReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *VerifyTxInclusionProofReply) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 type QueryUtxoInsFromDataRequest struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
 	Chain                string   `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -2216,7 +3297,7 @@ func (m *QueryUtxoInsFromDataRequest) Reset()         { *m = QueryUtxoInsFromDat
 func (m *QueryUtxoInsFromDataRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUtxoInsFromDataRequest) ProtoMessage()    {}
 func (*QueryUtxoInsFromDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{32}
+	return fileDescriptor_748c1225f0901a7a, []int{43}
 }
 
 func (m *QueryUtxoInsFromDataRequest) XXX_Unmarshal(b []byte) error {
@@ -2271,7 +3352,7 @@ func (m *QueryUtxoInsReply) Reset()         { *m = QueryUtxoInsReply{} }
 func (m *QueryUtxoInsReply) String() string { return proto.CompactTextString(m) }
 func (*QueryUtxoInsReply) ProtoMessage()    {}
 func (*QueryUtxoInsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_748c1225f0901a7a, []int{33}
+	return fileDescriptor_748c1225f0901a7a, []int{44}
 }
 
 func (m *QueryUtxoInsReply) XXX_Unmarshal(b []byte) error {
@@ -2286,36 +3367,1722 @@ func (m *QueryUtxoInsReply) XXX_Merge(src proto.Message) {
 func (m *QueryUtxoInsReply) XXX_Size() int {
 	return xxx_messageInfo_QueryUtxoInsReply.Size(m)
 }
-func (m *QueryUtxoInsReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryUtxoInsReply.DiscardUnknown(m)
+func (m *QueryUtxoInsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUtxoInsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUtxoInsReply proto.InternalMessageInfo
+
+func (m *QueryUtxoInsReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *QueryUtxoInsReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *QueryUtxoInsReply) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+type ListUtxosRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	MinConf              uint64   `protobuf:"varint,3,opt,name=min_conf,json=minConf,proto3" json:"min_conf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUtxosRequest) Reset()         { *m = ListUtxosRequest{} }
+func (m *ListUtxosRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUtxosRequest) ProtoMessage()    {}
+func (*ListUtxosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{45}
+}
+
+func (m *ListUtxosRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUtxosRequest.Unmarshal(m, b)
+}
+func (m *ListUtxosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUtxosRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUtxosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUtxosRequest.Merge(m, src)
+}
+func (m *ListUtxosRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUtxosRequest.Size(m)
+}
+func (m *ListUtxosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUtxosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUtxosRequest proto.InternalMessageInfo
+
+func (m *ListUtxosRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *ListUtxosRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ListUtxosRequest) GetMinConf() uint64 {
+	if m != nil {
+		return m.MinConf
+	}
+	return 0
+}
+
+type Utxo struct {
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index                uint32   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Amount               int64    `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Address              string   `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	ScriptPubKey         string   `protobuf:"bytes,5,opt,name=script_pub_key,json=scriptPubKey,proto3" json:"script_pub_key,omitempty"`
+	BlockHeight          uint64   `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Confirmations        uint64   `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Utxo) Reset()         { *m = Utxo{} }
+func (m *Utxo) String() string { return proto.CompactTextString(m) }
+func (*Utxo) ProtoMessage()    {}
+func (*Utxo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{46}
+}
+
+func (m *Utxo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Utxo.Unmarshal(m, b)
+}
+func (m *Utxo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Utxo.Marshal(b, m, deterministic)
+}
+func (m *Utxo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Utxo.Merge(m, src)
+}
+func (m *Utxo) XXX_Size() int {
+	return xxx_messageInfo_Utxo.Size(m)
+}
+func (m *Utxo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Utxo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Utxo proto.InternalMessageInfo
+
+func (m *Utxo) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Utxo) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Utxo) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Utxo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Utxo) GetScriptPubKey() string {
+	if m != nil {
+		return m.ScriptPubKey
+	}
+	return ""
+}
+
+func (m *Utxo) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Utxo) GetConfirmations() uint64 {
+	if m != nil {
+		return m.Confirmations
+	}
+	return 0
+}
+
+type ListUtxosReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Utxos                []*Utxo    `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	BlockHeight          uint64     `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListUtxosReply) Reset()         { *m = ListUtxosReply{} }
+func (m *ListUtxosReply) String() string { return proto.CompactTextString(m) }
+func (*ListUtxosReply) ProtoMessage()    {}
+func (*ListUtxosReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{47}
+}
+
+func (m *ListUtxosReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUtxosReply.Unmarshal(m, b)
+}
+func (m *ListUtxosReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUtxosReply.Marshal(b, m, deterministic)
+}
+func (m *ListUtxosReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUtxosReply.Merge(m, src)
+}
+func (m *ListUtxosReply) XXX_Size() int {
+	return xxx_messageInfo_ListUtxosReply.Size(m)
+}
+func (m *ListUtxosReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUtxosReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUtxosReply proto.InternalMessageInfo
+
+func (m *ListUtxosReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *ListUtxosReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *ListUtxosReply) GetUtxos() []*Utxo {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *ListUtxosReply) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type BuildUtxoTransactionRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Utxos                []*Vin   `protobuf:"bytes,2,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	Addresses            []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Vouts                []*Vout  `protobuf:"bytes,4,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	FeeRate              uint64   `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ChangeAddress        string   `protobuf:"bytes,6,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	Replaceable          bool     `protobuf:"varint,7,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildUtxoTransactionRequest) Reset()         { *m = BuildUtxoTransactionRequest{} }
+func (m *BuildUtxoTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildUtxoTransactionRequest) ProtoMessage()    {}
+func (*BuildUtxoTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{48}
+}
+
+func (m *BuildUtxoTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildUtxoTransactionRequest.Unmarshal(m, b)
+}
+func (m *BuildUtxoTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildUtxoTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *BuildUtxoTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildUtxoTransactionRequest.Merge(m, src)
+}
+func (m *BuildUtxoTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildUtxoTransactionRequest.Size(m)
+}
+func (m *BuildUtxoTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildUtxoTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildUtxoTransactionRequest proto.InternalMessageInfo
+
+func (m *BuildUtxoTransactionRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BuildUtxoTransactionRequest) GetUtxos() []*Vin {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionRequest) GetVouts() []*Vout {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *BuildUtxoTransactionRequest) GetChangeAddress() string {
+	if m != nil {
+		return m.ChangeAddress
+	}
+	return ""
+}
+
+func (m *BuildUtxoTransactionRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+type BuildUtxoTransactionReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TxData               []byte     `protobuf:"bytes,3,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
+	SignHashes           [][]byte   `protobuf:"bytes,4,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Vins                 []*Vin     `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout    `protobuf:"bytes,6,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	Fee                  string     `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	ChangeIndex          int32      `protobuf:"varint,8,opt,name=change_index,json=changeIndex,proto3" json:"change_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BuildUtxoTransactionReply) Reset()         { *m = BuildUtxoTransactionReply{} }
+func (m *BuildUtxoTransactionReply) String() string { return proto.CompactTextString(m) }
+func (*BuildUtxoTransactionReply) ProtoMessage()    {}
+func (*BuildUtxoTransactionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{49}
+}
+
+func (m *BuildUtxoTransactionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildUtxoTransactionReply.Unmarshal(m, b)
+}
+func (m *BuildUtxoTransactionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildUtxoTransactionReply.Marshal(b, m, deterministic)
+}
+func (m *BuildUtxoTransactionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildUtxoTransactionReply.Merge(m, src)
+}
+func (m *BuildUtxoTransactionReply) XXX_Size() int {
+	return xxx_messageInfo_BuildUtxoTransactionReply.Size(m)
+}
+func (m *BuildUtxoTransactionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildUtxoTransactionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildUtxoTransactionReply proto.InternalMessageInfo
+
+func (m *BuildUtxoTransactionReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *BuildUtxoTransactionReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BuildUtxoTransactionReply) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionReply) GetSignHashes() [][]byte {
+	if m != nil {
+		return m.SignHashes
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionReply) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionReply) GetVouts() []*Vout {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *BuildUtxoTransactionReply) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *BuildUtxoTransactionReply) GetChangeIndex() int32 {
+	if m != nil {
+		return m.ChangeIndex
+	}
+	return 0
+}
+
+type EstimateUtxoFeeRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	ConfTarget           uint32   `protobuf:"varint,2,opt,name=conf_target,json=confTarget,proto3" json:"conf_target,omitempty"`
+	EstimateMode         string   `protobuf:"bytes,3,opt,name=estimate_mode,json=estimateMode,proto3" json:"estimate_mode,omitempty"`
+	InputTypes           []string `protobuf:"bytes,4,rep,name=input_types,json=inputTypes,proto3" json:"input_types,omitempty"`
+	OutputTypes          []string `protobuf:"bytes,5,rep,name=output_types,json=outputTypes,proto3" json:"output_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EstimateUtxoFeeRequest) Reset()         { *m = EstimateUtxoFeeRequest{} }
+func (m *EstimateUtxoFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateUtxoFeeRequest) ProtoMessage()    {}
+func (*EstimateUtxoFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{50}
+}
+
+func (m *EstimateUtxoFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateUtxoFeeRequest.Unmarshal(m, b)
+}
+func (m *EstimateUtxoFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateUtxoFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *EstimateUtxoFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateUtxoFeeRequest.Merge(m, src)
+}
+func (m *EstimateUtxoFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_EstimateUtxoFeeRequest.Size(m)
+}
+func (m *EstimateUtxoFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateUtxoFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateUtxoFeeRequest proto.InternalMessageInfo
+
+func (m *EstimateUtxoFeeRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *EstimateUtxoFeeRequest) GetConfTarget() uint32 {
+	if m != nil {
+		return m.ConfTarget
+	}
+	return 0
+}
+
+func (m *EstimateUtxoFeeRequest) GetEstimateMode() string {
+	if m != nil {
+		return m.EstimateMode
+	}
+	return ""
+}
+
+func (m *EstimateUtxoFeeRequest) GetInputTypes() []string {
+	if m != nil {
+		return m.InputTypes
+	}
+	return nil
+}
+
+func (m *EstimateUtxoFeeRequest) GetOutputTypes() []string {
+	if m != nil {
+		return m.OutputTypes
+	}
+	return nil
+}
+
+type EstimateUtxoFeeReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FeeRate              uint64     `protobuf:"varint,3,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	Blocks               uint32     `protobuf:"varint,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	MempoolFallback      bool       `protobuf:"varint,5,opt,name=mempool_fallback,json=mempoolFallback,proto3" json:"mempool_fallback,omitempty"`
+	Vsize                int64      `protobuf:"varint,6,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	Fee                  string     `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *EstimateUtxoFeeReply) Reset()         { *m = EstimateUtxoFeeReply{} }
+func (m *EstimateUtxoFeeReply) String() string { return proto.CompactTextString(m) }
+func (*EstimateUtxoFeeReply) ProtoMessage()    {}
+func (*EstimateUtxoFeeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{51}
+}
+
+func (m *EstimateUtxoFeeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EstimateUtxoFeeReply.Unmarshal(m, b)
+}
+func (m *EstimateUtxoFeeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EstimateUtxoFeeReply.Marshal(b, m, deterministic)
+}
+func (m *EstimateUtxoFeeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateUtxoFeeReply.Merge(m, src)
+}
+func (m *EstimateUtxoFeeReply) XXX_Size() int {
+	return xxx_messageInfo_EstimateUtxoFeeReply.Size(m)
+}
+func (m *EstimateUtxoFeeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateUtxoFeeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateUtxoFeeReply proto.InternalMessageInfo
+
+func (m *EstimateUtxoFeeReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *EstimateUtxoFeeReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *EstimateUtxoFeeReply) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *EstimateUtxoFeeReply) GetBlocks() uint32 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *EstimateUtxoFeeReply) GetMempoolFallback() bool {
+	if m != nil {
+		return m.MempoolFallback
+	}
+	return false
+}
+
+func (m *EstimateUtxoFeeReply) GetVsize() int64 {
+	if m != nil {
+		return m.Vsize
+	}
+	return 0
+}
+
+func (m *EstimateUtxoFeeReply) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+type BumpUtxoFeeRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	TxHash               string   `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	SignedTxData         []byte   `protobuf:"bytes,3,opt,name=signed_tx_data,json=signedTxData,proto3" json:"signed_tx_data,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,4,rep,name=vins,proto3" json:"vins,omitempty"`
+	FeeRate              uint64   `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ChangeAddress        string   `protobuf:"bytes,6,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpUtxoFeeRequest) Reset()         { *m = BumpUtxoFeeRequest{} }
+func (m *BumpUtxoFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BumpUtxoFeeRequest) ProtoMessage()    {}
+func (*BumpUtxoFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{52}
+}
+
+func (m *BumpUtxoFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpUtxoFeeRequest.Unmarshal(m, b)
+}
+func (m *BumpUtxoFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpUtxoFeeRequest.Marshal(b, m, deterministic)
+}
+func (m *BumpUtxoFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpUtxoFeeRequest.Merge(m, src)
+}
+func (m *BumpUtxoFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_BumpUtxoFeeRequest.Size(m)
+}
+func (m *BumpUtxoFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpUtxoFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpUtxoFeeRequest proto.InternalMessageInfo
+
+func (m *BumpUtxoFeeRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BumpUtxoFeeRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *BumpUtxoFeeRequest) GetSignedTxData() []byte {
+	if m != nil {
+		return m.SignedTxData
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeRequest) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *BumpUtxoFeeRequest) GetChangeAddress() string {
+	if m != nil {
+		return m.ChangeAddress
+	}
+	return ""
+}
+
+type BumpUtxoFeeReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TxData               []byte     `protobuf:"bytes,3,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
+	SignHashes           [][]byte   `protobuf:"bytes,4,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Vins                 []*Vin     `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout    `protobuf:"bytes,6,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	Fee                  string     `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	OriginalFee          string     `protobuf:"bytes,8,opt,name=original_fee,json=originalFee,proto3" json:"original_fee,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BumpUtxoFeeReply) Reset()         { *m = BumpUtxoFeeReply{} }
+func (m *BumpUtxoFeeReply) String() string { return proto.CompactTextString(m) }
+func (*BumpUtxoFeeReply) ProtoMessage()    {}
+func (*BumpUtxoFeeReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{53}
+}
+
+func (m *BumpUtxoFeeReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpUtxoFeeReply.Unmarshal(m, b)
+}
+func (m *BumpUtxoFeeReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpUtxoFeeReply.Marshal(b, m, deterministic)
+}
+func (m *BumpUtxoFeeReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpUtxoFeeReply.Merge(m, src)
+}
+func (m *BumpUtxoFeeReply) XXX_Size() int {
+	return xxx_messageInfo_BumpUtxoFeeReply.Size(m)
+}
+func (m *BumpUtxoFeeReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpUtxoFeeReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpUtxoFeeReply proto.InternalMessageInfo
+
+func (m *BumpUtxoFeeReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *BumpUtxoFeeReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BumpUtxoFeeReply) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeReply) GetSignHashes() [][]byte {
+	if m != nil {
+		return m.SignHashes
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeReply) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeReply) GetVouts() []*Vout {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *BumpUtxoFeeReply) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *BumpUtxoFeeReply) GetOriginalFee() string {
+	if m != nil {
+		return m.OriginalFee
+	}
+	return ""
+}
+
+type BuildCpfpTransactionRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	ParentTxHash         string   `protobuf:"bytes,2,opt,name=parent_tx_hash,json=parentTxHash,proto3" json:"parent_tx_hash,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	FeeRate              uint64   `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ToAddress            string   `protobuf:"bytes,5,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty"`
+	Replaceable          bool     `protobuf:"varint,6,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildCpfpTransactionRequest) Reset()         { *m = BuildCpfpTransactionRequest{} }
+func (m *BuildCpfpTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildCpfpTransactionRequest) ProtoMessage()    {}
+func (*BuildCpfpTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{54}
+}
+
+func (m *BuildCpfpTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildCpfpTransactionRequest.Unmarshal(m, b)
+}
+func (m *BuildCpfpTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildCpfpTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *BuildCpfpTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildCpfpTransactionRequest.Merge(m, src)
+}
+func (m *BuildCpfpTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildCpfpTransactionRequest.Size(m)
+}
+func (m *BuildCpfpTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildCpfpTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildCpfpTransactionRequest proto.InternalMessageInfo
+
+func (m *BuildCpfpTransactionRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionRequest) GetParentTxHash() string {
+	if m != nil {
+		return m.ParentTxHash
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BuildCpfpTransactionRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *BuildCpfpTransactionRequest) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+type BuildCpfpTransactionReply struct {
+	Code                 ReturnCode `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string     `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TxData               []byte     `protobuf:"bytes,3,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
+	SignHashes           [][]byte   `protobuf:"bytes,4,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Vins                 []*Vin     `protobuf:"bytes,5,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout    `protobuf:"bytes,6,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	Fee                  string     `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	ParentFee            string     `protobuf:"bytes,8,opt,name=parent_fee,json=parentFee,proto3" json:"parent_fee,omitempty"`
+	ParentVsize          int64      `protobuf:"varint,9,opt,name=parent_vsize,json=parentVsize,proto3" json:"parent_vsize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BuildCpfpTransactionReply) Reset()         { *m = BuildCpfpTransactionReply{} }
+func (m *BuildCpfpTransactionReply) String() string { return proto.CompactTextString(m) }
+func (*BuildCpfpTransactionReply) ProtoMessage()    {}
+func (*BuildCpfpTransactionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{55}
+}
+
+func (m *BuildCpfpTransactionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildCpfpTransactionReply.Unmarshal(m, b)
+}
+func (m *BuildCpfpTransactionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildCpfpTransactionReply.Marshal(b, m, deterministic)
+}
+func (m *BuildCpfpTransactionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildCpfpTransactionReply.Merge(m, src)
+}
+func (m *BuildCpfpTransactionReply) XXX_Size() int {
+	return xxx_messageInfo_BuildCpfpTransactionReply.Size(m)
+}
+func (m *BuildCpfpTransactionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildCpfpTransactionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildCpfpTransactionReply proto.InternalMessageInfo
+
+func (m *BuildCpfpTransactionReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *BuildCpfpTransactionReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionReply) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *BuildCpfpTransactionReply) GetSignHashes() [][]byte {
+	if m != nil {
+		return m.SignHashes
+	}
+	return nil
+}
+
+func (m *BuildCpfpTransactionReply) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *BuildCpfpTransactionReply) GetVouts() []*Vout {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *BuildCpfpTransactionReply) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionReply) GetParentFee() string {
+	if m != nil {
+		return m.ParentFee
+	}
+	return ""
+}
+
+func (m *BuildCpfpTransactionReply) GetParentVsize() int64 {
+	if m != nil {
+		return m.ParentVsize
+	}
+	return 0
+}
+
+type BuildSweepTransactionRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Utxos                []*Vin   `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	Destination          string   `protobuf:"bytes,4,opt,name=destination,proto3" json:"destination,omitempty"`
+	FeeRate              uint64   `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	MaxInputs            uint32   `protobuf:"varint,6,opt,name=max_inputs,json=maxInputs,proto3" json:"max_inputs,omitempty"`
+	Replaceable          bool     `protobuf:"varint,7,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildSweepTransactionRequest) Reset()         { *m = BuildSweepTransactionRequest{} }
+func (m *BuildSweepTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildSweepTransactionRequest) ProtoMessage()    {}
+func (*BuildSweepTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{56}
+}
+
+func (m *BuildSweepTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildSweepTransactionRequest.Unmarshal(m, b)
+}
+func (m *BuildSweepTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildSweepTransactionRequest.Marshal(b, m, deterministic)
+}
+func (m *BuildSweepTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildSweepTransactionRequest.Merge(m, src)
+}
+func (m *BuildSweepTransactionRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildSweepTransactionRequest.Size(m)
+}
+func (m *BuildSweepTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildSweepTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildSweepTransactionRequest proto.InternalMessageInfo
+
+func (m *BuildSweepTransactionRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BuildSweepTransactionRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *BuildSweepTransactionRequest) GetUtxos() []*Vin {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *BuildSweepTransactionRequest) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *BuildSweepTransactionRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *BuildSweepTransactionRequest) GetMaxInputs() uint32 {
+	if m != nil {
+		return m.MaxInputs
+	}
+	return 0
+}
+
+func (m *BuildSweepTransactionRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+type SweepTransaction struct {
+	TxData               []byte   `protobuf:"bytes,1,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
+	SignHashes           [][]byte `protobuf:"bytes,2,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,3,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vout                 *Vout    `protobuf:"bytes,4,opt,name=vout,proto3" json:"vout,omitempty"`
+	Fee                  string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Vsize                int64    `protobuf:"varint,6,opt,name=vsize,proto3" json:"vsize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SweepTransaction) Reset()         { *m = SweepTransaction{} }
+func (m *SweepTransaction) String() string { return proto.CompactTextString(m) }
+func (*SweepTransaction) ProtoMessage()    {}
+func (*SweepTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{57}
+}
+
+func (m *SweepTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SweepTransaction.Unmarshal(m, b)
+}
+func (m *SweepTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SweepTransaction.Marshal(b, m, deterministic)
+}
+func (m *SweepTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SweepTransaction.Merge(m, src)
+}
+func (m *SweepTransaction) XXX_Size() int {
+	return xxx_messageInfo_SweepTransaction.Size(m)
+}
+func (m *SweepTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_SweepTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SweepTransaction proto.InternalMessageInfo
+
+func (m *SweepTransaction) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *SweepTransaction) GetSignHashes() [][]byte {
+	if m != nil {
+		return m.SignHashes
+	}
+	return nil
+}
+
+func (m *SweepTransaction) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *SweepTransaction) GetVout() *Vout {
+	if m != nil {
+		return m.Vout
+	}
+	return nil
+}
+
+func (m *SweepTransaction) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *SweepTransaction) GetVsize() int64 {
+	if m != nil {
+		return m.Vsize
+	}
+	return 0
+}
+
+type BuildSweepTransactionReply struct {
+	Code                 ReturnCode          `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Txs                  []*SweepTransaction `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	Skipped              []*Vin              `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BuildSweepTransactionReply) Reset()         { *m = BuildSweepTransactionReply{} }
+func (m *BuildSweepTransactionReply) String() string { return proto.CompactTextString(m) }
+func (*BuildSweepTransactionReply) ProtoMessage()    {}
+func (*BuildSweepTransactionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{58}
+}
+
+func (m *BuildSweepTransactionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildSweepTransactionReply.Unmarshal(m, b)
+}
+func (m *BuildSweepTransactionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildSweepTransactionReply.Marshal(b, m, deterministic)
+}
+func (m *BuildSweepTransactionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildSweepTransactionReply.Merge(m, src)
+}
+func (m *BuildSweepTransactionReply) XXX_Size() int {
+	return xxx_messageInfo_BuildSweepTransactionReply.Size(m)
+}
+func (m *BuildSweepTransactionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildSweepTransactionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildSweepTransactionReply proto.InternalMessageInfo
+
+func (m *BuildSweepTransactionReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *BuildSweepTransactionReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BuildSweepTransactionReply) GetTxs() []*SweepTransaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *BuildSweepTransactionReply) GetSkipped() []*Vin {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
+type Payout struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference            string   `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Payout) Reset()         { *m = Payout{} }
+func (m *Payout) String() string { return proto.CompactTextString(m) }
+func (*Payout) ProtoMessage()    {}
+func (*Payout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{59}
+}
+
+func (m *Payout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payout.Unmarshal(m, b)
+}
+func (m *Payout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payout.Marshal(b, m, deterministic)
+}
+func (m *Payout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payout.Merge(m, src)
+}
+func (m *Payout) XXX_Size() int {
+	return xxx_messageInfo_Payout.Size(m)
+}
+func (m *Payout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Payout proto.InternalMessageInfo
+
+func (m *Payout) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Payout) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *Payout) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type BuildPayoutBatchRequest struct {
+	Chain                string    `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Payouts              []*Payout `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Utxos                []*Vin    `protobuf:"bytes,3,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	Addresses            []string  `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	FeeRate              uint64    `protobuf:"varint,5,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	ChangeAddress        string    `protobuf:"bytes,6,opt,name=change_address,json=changeAddress,proto3" json:"change_address,omitempty"`
+	MaxOutputs           uint32    `protobuf:"varint,7,opt,name=max_outputs,json=maxOutputs,proto3" json:"max_outputs,omitempty"`
+	Replaceable          bool      `protobuf:"varint,8,opt,name=replaceable,proto3" json:"replaceable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *BuildPayoutBatchRequest) Reset()         { *m = BuildPayoutBatchRequest{} }
+func (m *BuildPayoutBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildPayoutBatchRequest) ProtoMessage()    {}
+func (*BuildPayoutBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{60}
+}
+
+func (m *BuildPayoutBatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildPayoutBatchRequest.Unmarshal(m, b)
+}
+func (m *BuildPayoutBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildPayoutBatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BuildPayoutBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildPayoutBatchRequest.Merge(m, src)
+}
+func (m *BuildPayoutBatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildPayoutBatchRequest.Size(m)
+}
+func (m *BuildPayoutBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildPayoutBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildPayoutBatchRequest proto.InternalMessageInfo
+
+func (m *BuildPayoutBatchRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *BuildPayoutBatchRequest) GetPayouts() []*Payout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *BuildPayoutBatchRequest) GetUtxos() []*Vin {
+	if m != nil {
+		return m.Utxos
+	}
+	return nil
+}
+
+func (m *BuildPayoutBatchRequest) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *BuildPayoutBatchRequest) GetFeeRate() uint64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+func (m *BuildPayoutBatchRequest) GetChangeAddress() string {
+	if m != nil {
+		return m.ChangeAddress
+	}
+	return ""
+}
+
+func (m *BuildPayoutBatchRequest) GetMaxOutputs() uint32 {
+	if m != nil {
+		return m.MaxOutputs
+	}
+	return 0
+}
+
+func (m *BuildPayoutBatchRequest) GetReplaceable() bool {
+	if m != nil {
+		return m.Replaceable
+	}
+	return false
+}
+
+type PayoutTransaction struct {
+	TxData               []byte   `protobuf:"bytes,1,opt,name=tx_data,json=txData,proto3" json:"tx_data,omitempty"`
+	SignHashes           [][]byte `protobuf:"bytes,2,rep,name=sign_hashes,json=signHashes,proto3" json:"sign_hashes,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,3,rep,name=vins,proto3" json:"vins,omitempty"`
+	Vouts                []*Vout  `protobuf:"bytes,4,rep,name=vouts,proto3" json:"vouts,omitempty"`
+	Fee                  string   `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	ChangeIndex          int32    `protobuf:"varint,6,opt,name=change_index,json=changeIndex,proto3" json:"change_index,omitempty"`
+	TxHash               string   `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutTransaction) Reset()         { *m = PayoutTransaction{} }
+func (m *PayoutTransaction) String() string { return proto.CompactTextString(m) }
+func (*PayoutTransaction) ProtoMessage()    {}
+func (*PayoutTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{61}
+}
+
+func (m *PayoutTransaction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutTransaction.Unmarshal(m, b)
+}
+func (m *PayoutTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutTransaction.Marshal(b, m, deterministic)
+}
+func (m *PayoutTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutTransaction.Merge(m, src)
+}
+func (m *PayoutTransaction) XXX_Size() int {
+	return xxx_messageInfo_PayoutTransaction.Size(m)
+}
+func (m *PayoutTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutTransaction proto.InternalMessageInfo
+
+func (m *PayoutTransaction) GetTxData() []byte {
+	if m != nil {
+		return m.TxData
+	}
+	return nil
+}
+
+func (m *PayoutTransaction) GetSignHashes() [][]byte {
+	if m != nil {
+		return m.SignHashes
+	}
+	return nil
+}
+
+func (m *PayoutTransaction) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *PayoutTransaction) GetVouts() []*Vout {
+	if m != nil {
+		return m.Vouts
+	}
+	return nil
+}
+
+func (m *PayoutTransaction) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *PayoutTransaction) GetChangeIndex() int32 {
+	if m != nil {
+		return m.ChangeIndex
+	}
+	return 0
+}
+
+func (m *PayoutTransaction) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// PayoutReceipt locates a payout. The txid of a tx spending a non-segwit input
+// changes with its signatures, it is returned by CreateUtxoSignedTransaction.
+type PayoutReceipt struct {
+	Reference            string   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	TxIndex              uint32   `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	Index                uint32   `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	TxHash               string   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayoutReceipt) Reset()         { *m = PayoutReceipt{} }
+func (m *PayoutReceipt) String() string { return proto.CompactTextString(m) }
+func (*PayoutReceipt) ProtoMessage()    {}
+func (*PayoutReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{62}
+}
+
+func (m *PayoutReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayoutReceipt.Unmarshal(m, b)
+}
+func (m *PayoutReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayoutReceipt.Marshal(b, m, deterministic)
+}
+func (m *PayoutReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutReceipt.Merge(m, src)
+}
+func (m *PayoutReceipt) XXX_Size() int {
+	return xxx_messageInfo_PayoutReceipt.Size(m)
+}
+func (m *PayoutReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutReceipt proto.InternalMessageInfo
+
+func (m *PayoutReceipt) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *PayoutReceipt) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *PayoutReceipt) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PayoutReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type BuildPayoutBatchReply struct {
+	Code                 ReturnCode           `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string               `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Txs                  []*PayoutTransaction `protobuf:"bytes,3,rep,name=txs,proto3" json:"txs,omitempty"`
+	Receipts             []*PayoutReceipt     `protobuf:"bytes,4,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BuildPayoutBatchReply) Reset()         { *m = BuildPayoutBatchReply{} }
+func (m *BuildPayoutBatchReply) String() string { return proto.CompactTextString(m) }
+func (*BuildPayoutBatchReply) ProtoMessage()    {}
+func (*BuildPayoutBatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{63}
+}
+
+func (m *BuildPayoutBatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildPayoutBatchReply.Unmarshal(m, b)
+}
+func (m *BuildPayoutBatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildPayoutBatchReply.Marshal(b, m, deterministic)
+}
+func (m *BuildPayoutBatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildPayoutBatchReply.Merge(m, src)
+}
+func (m *BuildPayoutBatchReply) XXX_Size() int {
+	return xxx_messageInfo_BuildPayoutBatchReply.Size(m)
+}
+func (m *BuildPayoutBatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildPayoutBatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildPayoutBatchReply proto.InternalMessageInfo
+
+func (m *BuildPayoutBatchReply) GetCode() ReturnCode {
+	if m != nil {
+		return m.Code
+	}
+	return ReturnCode_SUCCESS
+}
+
+func (m *BuildPayoutBatchReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BuildPayoutBatchReply) GetTxs() []*PayoutTransaction {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
+}
+
+func (m *BuildPayoutBatchReply) GetReceipts() []*PayoutReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+type CheckDoubleSpendRequest struct {
+	Chain                string   `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Vins                 []*Vin   `protobuf:"bytes,2,rep,name=vins,proto3" json:"vins,omitempty"`
+	TxHash               string   `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckDoubleSpendRequest) Reset()         { *m = CheckDoubleSpendRequest{} }
+func (m *CheckDoubleSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckDoubleSpendRequest) ProtoMessage()    {}
+func (*CheckDoubleSpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{64}
+}
+
+func (m *CheckDoubleSpendRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckDoubleSpendRequest.Unmarshal(m, b)
+}
+func (m *CheckDoubleSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckDoubleSpendRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckDoubleSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDoubleSpendRequest.Merge(m, src)
+}
+func (m *CheckDoubleSpendRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckDoubleSpendRequest.Size(m)
+}
+func (m *CheckDoubleSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDoubleSpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckDoubleSpendRequest proto.InternalMessageInfo
+
+func (m *CheckDoubleSpendRequest) GetChain() string {
+	if m != nil {
+		return m.Chain
+	}
+	return ""
+}
+
+func (m *CheckDoubleSpendRequest) GetVins() []*Vin {
+	if m != nil {
+		return m.Vins
+	}
+	return nil
+}
+
+func (m *CheckDoubleSpendRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type DoubleSpend struct {
+	Hash                 string      `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Index                uint32      `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	SpentBy              string      `protobuf:"bytes,3,opt,name=spent_by,json=spentBy,proto3" json:"spent_by,omitempty"`
+	Confirmed            bool        `protobuf:"varint,4,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Status               SpendStatus `protobuf:"varint,5,opt,name=status,proto3,enum=proto.SpendStatus" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DoubleSpend) Reset()         { *m = DoubleSpend{} }
+func (m *DoubleSpend) String() string { return proto.CompactTextString(m) }
+func (*DoubleSpend) ProtoMessage()    {}
+func (*DoubleSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{65}
+}
+
+func (m *DoubleSpend) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSpend.Unmarshal(m, b)
+}
+func (m *DoubleSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSpend.Marshal(b, m, deterministic)
+}
+func (m *DoubleSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSpend.Merge(m, src)
+}
+func (m *DoubleSpend) XXX_Size() int {
+	return xxx_messageInfo_DoubleSpend.Size(m)
+}
+func (m *DoubleSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSpend proto.InternalMessageInfo
+
+func (m *DoubleSpend) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DoubleSpend) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DoubleSpend) GetSpentBy() string {
+	if m != nil {
+		return m.SpentBy
+	}
+	return ""
+}
+
+func (m *DoubleSpend) GetConfirmed() bool {
+	if m != nil {
+		return m.Confirmed
+	}
+	return false
+}
+
+func (m *DoubleSpend) GetStatus() SpendStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SpendStatus_SPENT
+}
+
+type CheckDoubleSpendReply struct {
+	Code                 ReturnCode     `protobuf:"varint,1,opt,name=code,proto3,enum=proto.ReturnCode" json:"code,omitempty"`
+	Msg                  string         `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	DoubleSpent          bool           `protobuf:"varint,3,opt,name=double_spent,json=doubleSpent,proto3" json:"double_spent,omitempty"`
+	DoubleSpends         []*DoubleSpend `protobuf:"bytes,4,rep,name=double_spends,json=doubleSpends,proto3" json:"double_spends,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CheckDoubleSpendReply) Reset()         { *m = CheckDoubleSpendReply{} }
+func (m *CheckDoubleSpendReply) String() string { return proto.CompactTextString(m) }
+func (*CheckDoubleSpendReply) ProtoMessage()    {}
+func (*CheckDoubleSpendReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_748c1225f0901a7a, []int{66}
+}
+
+func (m *CheckDoubleSpendReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckDoubleSpendReply.Unmarshal(m, b)
+}
+func (m *CheckDoubleSpendReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckDoubleSpendReply.Marshal(b, m, deterministic)
+}
+func (m *CheckDoubleSpendReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckDoubleSpendReply.Merge(m, src)
+}
+func (m *CheckDoubleSpendReply) XXX_Size() int {
+	return xxx_messageInfo_CheckDoubleSpendReply.Size(m)
+}
+func (m *CheckDoubleSpendReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckDoubleSpendReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryUtxoInsReply proto.InternalMessageInfo
+var xxx_messageInfo_CheckDoubleSpendReply proto.InternalMessageInfo
 
-func (m *QueryUtxoInsReply) GetCode() ReturnCode {
+func (m *CheckDoubleSpendReply) GetCode() ReturnCode {
 	if m != nil {
 		return m.Code
 	}
 	return ReturnCode_SUCCESS
 }
 
-func (m *QueryUtxoInsReply) GetMsg() string {
+func (m *CheckDoubleSpendReply) GetMsg() string {
 	if m != nil {
 		return m.Msg
 	}
 	return ""
 }
 
-func (m *QueryUtxoInsReply) GetVins() []*Vin {
+func (m *CheckDoubleSpendReply) GetDoubleSpent() bool {
 	if m != nil {
-		return m.Vins
+		return m.DoubleSpent
+	}
+	return false
+}
+
+func (m *CheckDoubleSpendReply) GetDoubleSpends() []*DoubleSpend {
+	if m != nil {
+		return m.DoubleSpends
 	}
 	return nil
 }
 
 func init() {
 	proto.RegisterEnum("proto.ReturnCode", ReturnCode_name, ReturnCode_value)
+	proto.RegisterEnum("proto.RejectCode", RejectCode_name, RejectCode_value)
 	proto.RegisterEnum("proto.TxStatus", TxStatus_name, TxStatus_value)
+	proto.RegisterEnum("proto.SpendStatus", SpendStatus_name, SpendStatus_value)
 	proto.RegisterType((*SupportChainRequest)(nil), "proto.SupportChainRequest")
 	proto.RegisterType((*SupportChainReply)(nil), "proto.SupportChainReply")
 	proto.RegisterType((*ConvertAddressRequest)(nil), "proto.ConvertAddressRequest")
@@ -2330,26 +5097,59 @@ func init() {
 	proto.RegisterType((*QueryNonceReply)(nil), "proto.QueryNonceReply")
 	proto.RegisterType((*QueryGasPriceRequest)(nil), "proto.QueryGasPriceRequest")
 	proto.RegisterType((*QueryGasPriceReply)(nil), "proto.QueryGasPriceReply")
+	proto.RegisterType((*PriorityFee)(nil), "proto.PriorityFee")
 	proto.RegisterType((*QueryTransactionRequest)(nil), "proto.QueryTransactionRequest")
 	proto.RegisterType((*QueryUtxoTransactionReply)(nil), "proto.QueryUtxoTransactionReply")
+	proto.RegisterType((*MempoolEntry)(nil), "proto.MempoolEntry")
 	proto.RegisterType((*QueryAccountTransactionReply)(nil), "proto.QueryAccountTransactionReply")
 	proto.RegisterType((*QueryTransactionFromSignedDataRequest)(nil), "proto.QueryTransactionFromSignedDataRequest")
 	proto.RegisterType((*QueryTransactionFromDataRequest)(nil), "proto.QueryTransactionFromDataRequest")
 	proto.RegisterType((*Vin)(nil), "proto.Vin")
 	proto.RegisterType((*Vout)(nil), "proto.Vout")
+	proto.RegisterType((*OmniSimpleSend)(nil), "proto.OmniSimpleSend")
+	proto.RegisterType((*OmniTransfer)(nil), "proto.OmniTransfer")
 	proto.RegisterType((*CreateUtxoTransactionRequest)(nil), "proto.CreateUtxoTransactionRequest")
 	proto.RegisterType((*CreateUtxoTransactionReply)(nil), "proto.CreateUtxoTransactionReply")
 	proto.RegisterType((*CreateAccountTransactionRequest)(nil), "proto.CreateAccountTransactionRequest")
+	proto.RegisterType((*AccessTuple)(nil), "proto.AccessTuple")
 	proto.RegisterType((*CreateAccountTransactionReply)(nil), "proto.CreateAccountTransactionReply")
 	proto.RegisterType((*CreateAccountSignedTransactionRequest)(nil), "proto.CreateAccountSignedTransactionRequest")
 	proto.RegisterType((*CreateUtxoSignedTransactionRequest)(nil), "proto.CreateUtxoSignedTransactionRequest")
 	proto.RegisterType((*CreateSignedTransactionReply)(nil), "proto.CreateSignedTransactionReply")
 	proto.RegisterType((*BroadcastTransactionRequest)(nil), "proto.BroadcastTransactionRequest")
 	proto.RegisterType((*BroadcastTransactionReply)(nil), "proto.BroadcastTransactionReply")
+	proto.RegisterType((*DryRunBroadcastReply)(nil), "proto.DryRunBroadcastReply")
 	proto.RegisterType((*VerifySignedTransactionRequest)(nil), "proto.VerifySignedTransactionRequest")
 	proto.RegisterType((*VerifySignedTransactionReply)(nil), "proto.VerifySignedTransactionReply")
+	proto.RegisterType((*TxInclusionProof)(nil), "proto.TxInclusionProof")
+	proto.RegisterType((*GetTxInclusionProofRequest)(nil), "proto.GetTxInclusionProofRequest")
+	proto.RegisterType((*GetTxInclusionProofReply)(nil), "proto.GetTxInclusionProofReply")
+	proto.RegisterType((*VerifyTxInclusionProofRequest)(nil), "proto.VerifyTxInclusionProofRequest")
+	proto.RegisterType((*VerifyTxInclusionProofReply)(nil), "proto.VerifyTxInclusionProofReply")
 	proto.RegisterType((*QueryUtxoInsFromDataRequest)(nil), "proto.QueryUtxoInsFromDataRequest")
 	proto.RegisterType((*QueryUtxoInsReply)(nil), "proto.QueryUtxoInsReply")
+	proto.RegisterType((*ListUtxosRequest)(nil), "proto.ListUtxosRequest")
+	proto.RegisterType((*Utxo)(nil), "proto.Utxo")
+	proto.RegisterType((*ListUtxosReply)(nil), "proto.ListUtxosReply")
+	proto.RegisterType((*BuildUtxoTransactionRequest)(nil), "proto.BuildUtxoTransactionRequest")
+	proto.RegisterType((*BuildUtxoTransactionReply)(nil), "proto.BuildUtxoTransactionReply")
+	proto.RegisterType((*EstimateUtxoFeeRequest)(nil), "proto.EstimateUtxoFeeRequest")
+	proto.RegisterType((*EstimateUtxoFeeReply)(nil), "proto.EstimateUtxoFeeReply")
+	proto.RegisterType((*BumpUtxoFeeRequest)(nil), "proto.BumpUtxoFeeRequest")
+	proto.RegisterType((*BumpUtxoFeeReply)(nil), "proto.BumpUtxoFeeReply")
+	proto.RegisterType((*BuildCpfpTransactionRequest)(nil), "proto.BuildCpfpTransactionRequest")
+	proto.RegisterType((*BuildCpfpTransactionReply)(nil), "proto.BuildCpfpTransactionReply")
+	proto.RegisterType((*BuildSweepTransactionRequest)(nil), "proto.BuildSweepTransactionRequest")
+	proto.RegisterType((*SweepTransaction)(nil), "proto.SweepTransaction")
+	proto.RegisterType((*BuildSweepTransactionReply)(nil), "proto.BuildSweepTransactionReply")
+	proto.RegisterType((*Payout)(nil), "proto.Payout")
+	proto.RegisterType((*BuildPayoutBatchRequest)(nil), "proto.BuildPayoutBatchRequest")
+	proto.RegisterType((*PayoutTransaction)(nil), "proto.PayoutTransaction")
+	proto.RegisterType((*PayoutReceipt)(nil), "proto.PayoutReceipt")
+	proto.RegisterType((*BuildPayoutBatchReply)(nil), "proto.BuildPayoutBatchReply")
+	proto.RegisterType((*CheckDoubleSpendRequest)(nil), "proto.CheckDoubleSpendRequest")
+	proto.RegisterType((*DoubleSpend)(nil), "proto.DoubleSpend")
+	proto.RegisterType((*CheckDoubleSpendReply)(nil), "proto.CheckDoubleSpendReply")
 }
 
 func init() {
@@ -2357,112 +5157,251 @@ func init() {
 }
 
 var fileDescriptor_748c1225f0901a7a = []byte{
-	// 1671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x73, 0xdb, 0x44,
-	0x14, 0xaf, 0x6c, 0xf9, 0xdf, 0x8b, 0xe3, 0x38, 0x9b, 0xa4, 0x55, 0x9c, 0x34, 0x4d, 0xd4, 0xa6,
-	0xd3, 0x7f, 0x53, 0x66, 0xc2, 0x91, 0x19, 0x66, 0x5a, 0xb7, 0x69, 0x81, 0xd2, 0x16, 0x25, 0x0d,
-	0x1c, 0x00, 0xb3, 0x91, 0x36, 0xb1, 0xa8, 0xbd, 0x4a, 0xa5, 0x75, 0xe2, 0x70, 0xe5, 0x0e, 0xc3,
-	0x95, 0x1b, 0x5c, 0xe1, 0xc2, 0x30, 0xc3, 0xa7, 0xe1, 0xc6, 0x0c, 0x9f, 0x83, 0xd9, 0xd5, 0x4a,
-	0x96, 0xe4, 0xb5, 0x9d, 0xc6, 0xe9, 0x0c, 0x27, 0x6b, 0x77, 0x9f, 0xdf, 0xfb, 0xed, 0xdb, 0xf7,
-	0x7e, 0x6f, 0xf7, 0xc1, 0xd2, 0x91, 0xef, 0x31, 0xef, 0x3d, 0xbb, 0x8d, 0x5d, 0x4a, 0x3d, 0x87,
-	0xdc, 0x17, 0x63, 0x54, 0x10, 0x3f, 0xe6, 0x5d, 0x58, 0xd8, 0xe9, 0x1d, 0x1d, 0x79, 0x3e, 0x6b,
-	0x72, 0x01, 0x8b, 0xbc, 0xe9, 0x91, 0x80, 0xa1, 0x45, 0x28, 0x88, 0x3f, 0x18, 0xda, 0xba, 0x76,
-	0xab, 0x62, 0x85, 0x03, 0xf3, 0x00, 0xe6, 0xd3, 0xc2, 0x47, 0x9d, 0x53, 0xb4, 0x09, 0xba, 0xed,
-	0x39, 0x44, 0x48, 0xd6, 0xb6, 0xe6, 0x43, 0xf5, 0xf7, 0x2d, 0xc2, 0x7a, 0x3e, 0x6d, 0x7a, 0x0e,
-	0xb1, 0xc4, 0x32, 0xaa, 0x43, 0xbe, 0x1b, 0x1c, 0x1a, 0x39, 0xa1, 0x8f, 0x7f, 0x22, 0x03, 0x4a,
-	0x41, 0xa8, 0xcd, 0xc8, 0xaf, 0x6b, 0xb7, 0xca, 0x56, 0x34, 0x34, 0x9f, 0xc1, 0x52, 0xd3, 0xa3,
-	0xc7, 0xc4, 0x67, 0x0f, 0x1c, 0xc7, 0x27, 0x41, 0x30, 0x16, 0x16, 0xba, 0x0a, 0x70, 0xd4, 0xdb,
-	0xef, 0xb8, 0x76, 0xeb, 0x35, 0x39, 0x15, 0x16, 0xaa, 0x56, 0x25, 0x9c, 0xf9, 0x84, 0x9c, 0x9a,
-	0x6d, 0x58, 0xc8, 0x6a, 0x9b, 0x16, 0x37, 0x0e, 0x15, 0x09, 0xdc, 0x15, 0x2b, 0x1a, 0x9a, 0x5f,
-	0xc1, 0xc2, 0x1e, 0xee, 0xb8, 0x4e, 0x06, 0xf5, 0x65, 0x28, 0x06, 0xa7, 0xdd, 0x7d, 0xaf, 0x23,
-	0x61, 0xcb, 0xd1, 0x60, 0x37, 0xb9, 0xe4, 0x6e, 0x46, 0xab, 0xff, 0x4b, 0x83, 0xf9, 0xb4, 0xfe,
-	0xa9, 0xf6, 0xb1, 0x08, 0x85, 0x63, 0xae, 0x4d, 0x7a, 0x3f, 0x1c, 0xa0, 0x4d, 0xa8, 0xd9, 0x98,
-	0xb6, 0x4e, 0x5c, 0xd6, 0x76, 0x7c, 0x7c, 0x82, 0x3b, 0x86, 0x2e, 0x96, 0x67, 0x6d, 0x4c, 0x3f,
-	0x8f, 0x27, 0xd1, 0x5d, 0x98, 0xb7, 0x31, 0xf5, 0xa8, 0x6b, 0xe3, 0x4e, 0x2b, 0xc2, 0x5b, 0x10,
-	0xca, 0xeb, 0xf1, 0x82, 0xc4, 0x69, 0xfe, 0xa6, 0xc1, 0xc2, 0x67, 0x3d, 0xe2, 0x9f, 0x3e, 0xc4,
-	0x1d, 0x4c, 0x6d, 0x72, 0xc1, 0x8e, 0x41, 0x1b, 0x50, 0xdd, 0xef, 0x78, 0xf6, 0xeb, 0x56, 0x9b,
-	0xb8, 0x87, 0x6d, 0x26, 0x10, 0xeb, 0xd6, 0x8c, 0x98, 0x7b, 0x2a, 0xa6, 0xd0, 0x6d, 0xa8, 0xdb,
-	0x1e, 0x65, 0x3e, 0xb6, 0x59, 0x06, 0xee, 0x5c, 0x34, 0x1f, 0xa1, 0x3d, 0x80, 0xf9, 0x34, 0xd8,
-	0x69, 0xa3, 0x65, 0x3f, 0x54, 0x14, 0xa1, 0x96, 0x43, 0x73, 0x1f, 0xea, 0xc2, 0xce, 0x2b, 0xd6,
-	0xf7, 0x22, 0x8f, 0x34, 0xd2, 0x1e, 0x79, 0x98, 0x33, 0xb4, 0x09, 0x5e, 0x59, 0x85, 0xfc, 0xb1,
-	0x4b, 0x85, 0xee, 0x99, 0x2d, 0x90, 0xb8, 0xf6, 0x5c, 0x6a, 0xf1, 0x69, 0xd3, 0x86, 0x5a, 0xc2,
-	0xc6, 0xb4, 0x1b, 0xe9, 0xd1, 0xe0, 0x88, 0xd0, 0x38, 0x5d, 0xe5, 0xd0, 0x6c, 0x4a, 0x87, 0x3d,
-	0xf7, 0x12, 0x67, 0xab, 0x4e, 0xd5, 0xc4, 0x19, 0xe6, 0xd2, 0xc1, 0xfd, 0x0d, 0xcc, 0x25, 0x95,
-	0x4c, 0x1b, 0xd9, 0xd4, 0x8b, 0x3c, 0xae, 0x5b, 0xe1, 0xc0, 0xbc, 0x07, 0x8b, 0xc2, 0xc2, 0x13,
-	0x1c, 0xbc, 0xf4, 0xdd, 0x09, 0x48, 0xcd, 0x6f, 0x01, 0x65, 0xa4, 0xa7, 0x82, 0xb4, 0x02, 0x95,
-	0x43, 0x1c, 0xb4, 0x8e, 0xb8, 0x2a, 0x19, 0x08, 0xe5, 0x43, 0xa9, 0xda, 0xfc, 0x5e, 0x83, 0x2b,
-	0xc2, 0xd8, 0xae, 0x8f, 0x69, 0x80, 0x6d, 0xe6, 0x7a, 0xf4, 0x7c, 0x39, 0x72, 0x05, 0x4a, 0xac,
-	0xdf, 0x6a, 0xe3, 0xa0, 0x2d, 0x8d, 0x14, 0x59, 0xff, 0x29, 0x0e, 0xda, 0x68, 0x03, 0x00, 0x07,
-	0xa7, 0xd4, 0x6e, 0x75, 0x39, 0x7c, 0x91, 0xd2, 0x22, 0xb8, 0x2a, 0x62, 0xf6, 0x53, 0xcf, 0x21,
-	0xe6, 0xdf, 0x39, 0x58, 0x8e, 0x83, 0x25, 0x85, 0x64, 0xaa, 0x9d, 0x8f, 0x84, 0x74, 0x0f, 0x2a,
-	0xac, 0xdf, 0x0a, 0x18, 0x66, 0xbd, 0x40, 0x20, 0xaa, 0x6d, 0xcd, 0x49, 0xb5, 0xbb, 0xfd, 0x1d,
-	0x31, 0x6d, 0x95, 0x99, 0xfc, 0x42, 0x6b, 0xa0, 0x1f, 0xbb, 0x94, 0x27, 0x6d, 0x3e, 0x13, 0xe8,
-	0x62, 0x1e, 0x6d, 0x40, 0xe1, 0xd8, 0xeb, 0xb1, 0xc0, 0x28, 0x0a, 0x81, 0x99, 0x48, 0xc0, 0xeb,
-	0x31, 0x2b, 0x5c, 0x41, 0xd7, 0x60, 0x26, 0x70, 0x0f, 0xa9, 0xc0, 0x42, 0x02, 0xa3, 0xb4, 0x9e,
-	0xbf, 0x55, 0xb5, 0x80, 0x4f, 0x3d, 0x15, 0x33, 0x68, 0x19, 0xca, 0xb6, 0x17, 0xb0, 0xd6, 0x01,
-	0x21, 0x46, 0x39, 0x0c, 0x4f, 0x3e, 0xde, 0x26, 0x64, 0x88, 0x62, 0x2a, 0xc3, 0x14, 0x73, 0x15,
-	0x20, 0x14, 0x61, 0x6e, 0x97, 0x18, 0x20, 0x04, 0x2a, 0x62, 0x66, 0xd7, 0xed, 0x12, 0xf3, 0x9f,
-	0x3c, 0xac, 0x0a, 0xf7, 0x3e, 0xb0, 0x6d, 0xaf, 0x47, 0xd9, 0xff, 0xce, 0xc3, 0x08, 0xf4, 0x03,
-	0xdf, 0xeb, 0x4a, 0x5a, 0x14, 0xdf, 0xa8, 0x06, 0x39, 0xe6, 0x19, 0x45, 0x31, 0x93, 0x63, 0x1e,
-	0x8f, 0x46, 0xdc, 0xe5, 0xe8, 0x8d, 0x52, 0x68, 0x29, 0x1c, 0xf1, 0xff, 0x76, 0x49, 0xd7, 0x93,
-	0x5e, 0x13, 0xdf, 0x83, 0x2c, 0xac, 0x24, 0xb2, 0x30, 0x4a, 0x84, 0x8e, 0xdb, 0x75, 0x99, 0x70,
-	0x52, 0x98, 0x08, 0xcf, 0xf8, 0x38, 0x9d, 0x25, 0x33, 0xe9, 0x2c, 0x49, 0x9d, 0x4e, 0x75, 0xfc,
-	0xe9, 0xcc, 0x4e, 0x3a, 0x9d, 0x5a, 0xe6, 0x74, 0xb8, 0xe5, 0x38, 0x36, 0x8c, 0x39, 0x71, 0x85,
-	0x28, 0x47, 0x91, 0xa1, 0x2c, 0x1e, 0x75, 0x75, 0xf1, 0xf8, 0x53, 0x83, 0xcd, 0x6c, 0x2a, 0x6f,
-	0xfb, 0x5e, 0x77, 0xc7, 0x3d, 0xa4, 0xc4, 0x79, 0x84, 0x19, 0x3e, 0x5f, 0x62, 0xdf, 0x80, 0x5a,
-	0x20, 0x54, 0xb4, 0x58, 0xbf, 0xe5, 0x60, 0x86, 0xc5, 0x51, 0x57, 0xad, 0x6a, 0x38, 0xbb, 0xdb,
-	0xe7, 0xaa, 0xb9, 0xce, 0x44, 0x09, 0xcc, 0x5b, 0x72, 0x34, 0x29, 0x79, 0xcc, 0x5f, 0x35, 0xb8,
-	0xa6, 0x42, 0x7d, 0x7e, 0xbc, 0xcb, 0x50, 0xf6, 0xf1, 0x49, 0x12, 0x69, 0xc9, 0xc7, 0x27, 0x53,
-	0x81, 0xc4, 0x90, 0xdf, 0x73, 0x29, 0x0f, 0x35, 0x71, 0x48, 0x21, 0x0a, 0xf1, 0xcd, 0x31, 0xb8,
-	0xd4, 0x21, 0x7d, 0x81, 0x61, 0xd6, 0x0a, 0x07, 0x89, 0x60, 0xcd, 0x87, 0x86, 0x64, 0xb0, 0x26,
-	0x8a, 0x90, 0x9e, 0x2e, 0x42, 0xcf, 0x41, 0xe7, 0x84, 0x91, 0x94, 0xd0, 0xd2, 0x57, 0x8d, 0x81,
-	0xce, 0x5c, 0x4a, 0x67, 0x8c, 0x20, 0x9f, 0x40, 0x60, 0xfe, 0xa2, 0xc1, 0x6a, 0xd3, 0x27, 0x98,
-	0x91, 0x21, 0x4e, 0x3d, 0x8f, 0x53, 0x23, 0x0f, 0xe5, 0x27, 0x71, 0xa0, 0x3e, 0x92, 0x03, 0xeb,
-	0x90, 0xe7, 0xf9, 0x13, 0xe6, 0x38, 0xff, 0x34, 0x7f, 0xd4, 0xa0, 0x31, 0x02, 0xe3, 0x05, 0xb0,
-	0x52, 0x22, 0x00, 0x8a, 0x2c, 0x0c, 0xd2, 0x0c, 0x0d, 0xeb, 0x59, 0x1a, 0x36, 0x7f, 0xce, 0xc1,
-	0xb5, 0x10, 0x91, 0x8a, 0x2a, 0xcf, 0xe3, 0xb8, 0x88, 0xda, 0xf2, 0x43, 0xd4, 0xa6, 0x2b, 0xa8,
-	0xad, 0xa0, 0xa4, 0xb6, 0x62, 0x82, 0xda, 0x52, 0x24, 0x56, 0x1a, 0x47, 0x62, 0xe5, 0x0c, 0x89,
-	0xa9, 0x49, 0x51, 0x45, 0x30, 0xa0, 0x26, 0x98, 0x1f, 0x34, 0xb8, 0x3a, 0xda, 0x39, 0xef, 0xe6,
-	0xc4, 0x52, 0xe4, 0xa8, 0xa7, 0xc9, 0x91, 0x5f, 0xee, 0x37, 0x53, 0x80, 0x42, 0xaa, 0xbb, 0xa0,
-	0xab, 0x8c, 0x02, 0xcd, 0x6a, 0x88, 0x06, 0xb3, 0x9e, 0x4f, 0x24, 0x9a, 0xc1, 0x44, 0xe6, 0x31,
-	0x58, 0xc8, 0x3e, 0x06, 0x7f, 0xd7, 0xc0, 0x1c, 0x44, 0xfb, 0xbb, 0x86, 0xba, 0x06, 0x10, 0x23,
-	0x4b, 0x45, 0x7a, 0x38, 0xc3, 0x53, 0x61, 0x00, 0x36, 0x64, 0xbe, 0xaa, 0x05, 0x31, 0xda, 0xc0,
-	0xfc, 0x29, 0x26, 0x10, 0x05, 0xd4, 0xa9, 0x0e, 0xfb, 0x6c, 0x05, 0x25, 0x22, 0xdb, 0xd0, 0xcd,
-	0xe2, 0xdb, 0x7c, 0x03, 0x2b, 0x0f, 0x7d, 0x0f, 0x3b, 0x36, 0x0e, 0xa6, 0xcf, 0xcc, 0x33, 0xc1,
-	0x30, 0xbb, 0xb0, 0xac, 0x36, 0xf9, 0x4e, 0xee, 0x4d, 0xe6, 0xbf, 0x1a, 0xac, 0xed, 0x11, 0xdf,
-	0x3d, 0x38, 0xbd, 0xa0, 0x00, 0x59, 0x87, 0x8a, 0x4c, 0x6b, 0x12, 0xb2, 0x77, 0x45, 0x5e, 0xbe,
-	0xa3, 0x49, 0x85, 0x1f, 0x74, 0x75, 0x7d, 0x0f, 0x08, 0x75, 0x88, 0x1f, 0x71, 0x54, 0x38, 0x4a,
-	0x94, 0xd4, 0xa2, 0xb2, 0xa4, 0x96, 0x46, 0x94, 0xd4, 0x00, 0x56, 0x47, 0xee, 0x73, 0x2a, 0xd7,
-	0x36, 0xa0, 0x7c, 0xcc, 0x15, 0xbb, 0x24, 0x6a, 0x2f, 0xc4, 0x63, 0xb3, 0x05, 0x2b, 0xf1, 0x33,
-	0xe3, 0x23, 0x1a, 0x4c, 0x77, 0xcf, 0x40, 0xa0, 0x27, 0xa2, 0x46, 0x7c, 0x9b, 0x1d, 0xf9, 0x1e,
-	0x95, 0x06, 0xa6, 0xdc, 0xca, 0x84, 0xa2, 0x7b, 0xe7, 0x06, 0xc0, 0x40, 0x0b, 0x9a, 0x81, 0xd2,
-	0xce, 0xab, 0x66, 0xf3, 0xf1, 0xce, 0x4e, 0xfd, 0x12, 0xaa, 0x40, 0xe1, 0xb1, 0x65, 0xbd, 0xb0,
-	0xea, 0xda, 0x1d, 0x07, 0xca, 0xd1, 0x95, 0x1b, 0x55, 0xa1, 0xfc, 0xdc, 0x63, 0xdb, 0x5e, 0x8f,
-	0x3a, 0xf5, 0x4b, 0xfc, 0x1f, 0x2f, 0x09, 0x75, 0x5c, 0x7a, 0x58, 0xd7, 0x10, 0x40, 0x71, 0x1b,
-	0xbb, 0x1d, 0xe2, 0xd4, 0x73, 0x42, 0x55, 0xcf, 0xb6, 0x49, 0x10, 0xd4, 0xf3, 0x68, 0x59, 0xb4,
-	0xc4, 0x44, 0x25, 0x78, 0xdc, 0x27, 0x76, 0x8f, 0x11, 0x29, 0xa7, 0x73, 0x2b, 0x2f, 0x58, 0x9b,
-	0xf8, 0xf5, 0xc2, 0xd6, 0x1f, 0x73, 0x50, 0x69, 0x46, 0x8d, 0x3e, 0xf4, 0x25, 0x2c, 0xaa, 0xb2,
-	0x06, 0x99, 0x72, 0x0f, 0x63, 0xb2, 0xb8, 0xb1, 0x3e, 0x56, 0x86, 0x3b, 0xf4, 0x63, 0xa8, 0xa5,
-	0xdb, 0x6a, 0x68, 0x55, 0xfe, 0x47, 0xd9, 0xbb, 0x6b, 0x34, 0x46, 0xac, 0x72, 0x5d, 0x8f, 0xa0,
-	0x9a, 0x6c, 0x2c, 0xa2, 0x48, 0x56, 0xd1, 0x9a, 0x6c, 0x18, 0xca, 0x35, 0xa9, 0x25, 0xd9, 0x1e,
-	0x8b, 0xb5, 0x28, 0x7a, 0x72, 0xb1, 0x96, 0xe1, 0x7e, 0x5a, 0x00, 0x6b, 0xe3, 0xcb, 0x19, 0xba,
-	0x17, 0xed, 0xe4, 0x2c, 0x55, 0xaf, 0x71, 0x3d, 0x25, 0x3d, 0x22, 0xd1, 0xda, 0x60, 0x8c, 0x2a,
-	0xea, 0xe8, 0xa6, 0xca, 0x9c, 0xc2, 0xd0, 0x8d, 0x89, 0x72, 0xdc, 0x52, 0x17, 0x56, 0xc6, 0xd4,
-	0x3f, 0x74, 0x3b, 0xa5, 0x64, 0x5c, 0x8d, 0x3c, 0xdb, 0xc6, 0x5a, 0xb0, 0xa4, 0xbc, 0x5c, 0xa2,
-	0xeb, 0x43, 0x86, 0x14, 0x26, 0x36, 0xc6, 0x0b, 0x71, 0x03, 0x1f, 0x40, 0x25, 0x4e, 0x76, 0x74,
-	0x45, 0xca, 0x67, 0xfb, 0x6a, 0x8d, 0xa5, 0xe1, 0x05, 0xfe, 0xe7, 0x5d, 0xd9, 0x12, 0xca, 0x50,
-	0x51, 0x9c, 0x21, 0x63, 0x78, 0x2a, 0x8e, 0xa0, 0x61, 0xaa, 0xf9, 0x5a, 0x76, 0x73, 0x14, 0x67,
-	0xb9, 0x96, 0xfc, 0xd3, 0x18, 0x9f, 0x8e, 0x6d, 0x14, 0x7c, 0x91, 0x40, 0xfd, 0x36, 0xca, 0xd7,
-	0xb3, 0x88, 0x87, 0x34, 0x53, 0xf9, 0x0c, 0x1c, 0xb6, 0x1c, 0xbb, 0xe6, 0xe6, 0x08, 0x23, 0x59,
-	0xf7, 0x9c, 0x69, 0x27, 0x6d, 0xd9, 0x12, 0xc9, 0x80, 0x79, 0x6b, 0x63, 0x93, 0x77, 0xf6, 0x9d,
-	0x7c, 0x96, 0xab, 0x77, 0x36, 0x78, 0x9d, 0xc7, 0xc9, 0x7d, 0xa6, 0x47, 0xfc, 0xd9, 0x76, 0xc9,
-	0xc0, 0x1c, 0xb5, 0xcb, 0x73, 0x1b, 0x9e, 0xbc, 0xe3, 0x47, 0x50, 0x4d, 0xb6, 0xb1, 0x63, 0x36,
-	0x54, 0x34, 0xe2, 0xd3, 0xb1, 0x9c, 0xea, 0x7b, 0x3f, 0x81, 0xd9, 0x54, 0x1b, 0x14, 0xad, 0x24,
-	0x45, 0x33, 0xad, 0xd4, 0xc6, 0xb2, 0x7a, 0x91, 0x2b, 0xfa, 0x10, 0x60, 0xd0, 0xdf, 0x45, 0x29,
-	0x83, 0xc9, 0xbe, 0x71, 0xe3, 0xb2, 0x62, 0x85, 0xff, 0xbf, 0x13, 0x5d, 0xc9, 0x46, 0xd2, 0xf2,
-	0x66, 0x44, 0xe9, 0x63, 0x6f, 0x6e, 0xf1, 0x91, 0x8d, 0xbd, 0xf8, 0xb8, 0xb0, 0x12, 0xae, 0xab,
-	0x59, 0xf2, 0x02, 0x4d, 0xed, 0x17, 0x85, 0xcc, 0xfb, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1e,
-	0x2c, 0xa1, 0x9f, 0xa8, 0x1b, 0x00, 0x00,
+	// 3892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0x4d, 0x8f, 0x1b, 0xdb,
+	0x52, 0x69, 0x7f, 0xbb, 0x6c, 0xcf, 0x78, 0xce, 0xcc, 0x64, 0x3c, 0x9e, 0x49, 0x66, 0xd2, 0x49,
+	0x1e, 0x49, 0x5e, 0xde, 0x03, 0xe5, 0x49, 0x80, 0x84, 0x04, 0x9a, 0x78, 0x3c, 0xc9, 0xdc, 0x24,
+	0x33, 0x43, 0xdb, 0xc9, 0x7b, 0x4f, 0x02, 0x9a, 0x76, 0xf7, 0xf1, 0xb8, 0x6f, 0xec, 0xee, 0xa6,
+	0xfb, 0x38, 0x63, 0x5f, 0x89, 0xcd, 0xbd, 0x12, 0x4b, 0x10, 0x7b, 0x58, 0xc2, 0x06, 0x74, 0x37,
+	0x7c, 0x88, 0x15, 0x3b, 0xd8, 0xc0, 0x02, 0x24, 0x58, 0xb0, 0x41, 0x62, 0xc3, 0x0f, 0x40, 0xac,
+	0x90, 0x90, 0xd0, 0xf9, 0xe8, 0x76, 0x77, 0xbb, 0xdb, 0xe3, 0x8c, 0x13, 0x40, 0x77, 0xe5, 0x3e,
+	0x75, 0xca, 0x75, 0xaa, 0x4e, 0xd5, 0xa9, 0xaa, 0x53, 0xd5, 0x0d, 0xdb, 0x8e, 0x6b, 0x13, 0xfb,
+	0x67, 0xf5, 0x81, 0x66, 0x5a, 0x96, 0x6d, 0xe0, 0x1f, 0xb2, 0x31, 0xca, 0xb3, 0x1f, 0xf9, 0xfb,
+	0xb0, 0xd9, 0x19, 0x3b, 0x8e, 0xed, 0x92, 0x16, 0x45, 0x50, 0xf0, 0x6f, 0x8d, 0xb1, 0x47, 0xd0,
+	0x16, 0xe4, 0xd9, 0x1f, 0x1a, 0xd2, 0xa1, 0xf4, 0xa8, 0xac, 0xf0, 0x81, 0xdc, 0x87, 0x8d, 0x28,
+	0xb2, 0x33, 0x9c, 0xa2, 0x87, 0x90, 0xd3, 0x6d, 0x03, 0x33, 0xcc, 0xb5, 0x67, 0x1b, 0x9c, 0xfc,
+	0x0f, 0x15, 0x4c, 0xc6, 0xae, 0xd5, 0xb2, 0x0d, 0xac, 0xb0, 0x69, 0x54, 0x87, 0xec, 0xc8, 0xbb,
+	0x6c, 0x64, 0x18, 0x3d, 0xfa, 0x88, 0x1a, 0x50, 0xf4, 0x38, 0xb5, 0x46, 0xf6, 0x50, 0x7a, 0x54,
+	0x52, 0xfc, 0xa1, 0xfc, 0x1a, 0xb6, 0x5b, 0xb6, 0xf5, 0x01, 0xbb, 0xe4, 0xc8, 0x30, 0x5c, 0xec,
+	0x79, 0x0b, 0xd9, 0x42, 0x77, 0x00, 0x9c, 0x71, 0x6f, 0x68, 0xea, 0xea, 0x7b, 0x3c, 0x65, 0x2b,
+	0x54, 0x95, 0x32, 0x87, 0xbc, 0xc2, 0x53, 0x79, 0x00, 0x9b, 0x71, 0x6a, 0xab, 0xf2, 0xad, 0x71,
+	0x42, 0x8c, 0xef, 0xb2, 0xe2, 0x0f, 0xe5, 0x5f, 0x87, 0xcd, 0x77, 0xda, 0xd0, 0x34, 0x62, 0x5c,
+	0xdf, 0x86, 0x82, 0x37, 0x1d, 0xf5, 0xec, 0xa1, 0x60, 0x5b, 0x8c, 0x66, 0xd2, 0x64, 0xc2, 0xd2,
+	0xa4, 0x93, 0xff, 0x4b, 0x09, 0x36, 0xa2, 0xf4, 0x57, 0x92, 0x63, 0x0b, 0xf2, 0x1f, 0x28, 0x35,
+	0xb1, 0xfb, 0x7c, 0x80, 0x1e, 0xc2, 0x9a, 0xae, 0x59, 0xea, 0x95, 0x49, 0x06, 0x86, 0xab, 0x5d,
+	0x69, 0xc3, 0x46, 0x8e, 0x4d, 0xd7, 0x74, 0xcd, 0xfa, 0x71, 0x00, 0x44, 0xdf, 0x87, 0x0d, 0x5d,
+	0xb3, 0x6c, 0xcb, 0xd4, 0xb5, 0xa1, 0xea, 0xf3, 0x9b, 0x67, 0xc4, 0xeb, 0xc1, 0x84, 0xe0, 0x53,
+	0xfe, 0x13, 0x09, 0x36, 0x7f, 0x75, 0x8c, 0xdd, 0xe9, 0x73, 0x6d, 0xa8, 0x59, 0x3a, 0xfe, 0xc4,
+	0x1b, 0x83, 0xee, 0x41, 0xb5, 0x37, 0xb4, 0xf5, 0xf7, 0xea, 0x00, 0x9b, 0x97, 0x03, 0xc2, 0x38,
+	0xce, 0x29, 0x15, 0x06, 0x7b, 0xc9, 0x40, 0xe8, 0x31, 0xd4, 0x75, 0xdb, 0x22, 0xae, 0xa6, 0x93,
+	0x18, 0xbb, 0xeb, 0x3e, 0xdc, 0xe7, 0xb6, 0x0f, 0x1b, 0x51, 0x66, 0x57, 0xb5, 0x96, 0x1e, 0x27,
+	0xe4, 0x73, 0x2d, 0x86, 0x72, 0x0f, 0xea, 0x6c, 0x9d, 0xb7, 0x64, 0x62, 0xfb, 0x3b, 0xd2, 0x8c,
+	0xee, 0xc8, 0xf3, 0x4c, 0x43, 0xba, 0x66, 0x57, 0xf6, 0x21, 0xfb, 0xc1, 0xb4, 0x18, 0xed, 0xca,
+	0x33, 0x10, 0x7c, 0xbd, 0x33, 0x2d, 0x85, 0x82, 0x65, 0x1d, 0xd6, 0x42, 0x6b, 0xac, 0x2a, 0xc8,
+	0xd8, 0xf2, 0x1c, 0x6c, 0x05, 0xc7, 0x55, 0x0c, 0xe5, 0x96, 0xd8, 0xb0, 0x33, 0x3b, 0xa4, 0xdb,
+	0xe4, 0xa3, 0x1a, 0xd2, 0x61, 0x26, 0x6a, 0xdc, 0xbf, 0x09, 0xeb, 0x61, 0x22, 0xab, 0x5a, 0xb6,
+	0x65, 0xfb, 0x3b, 0x9e, 0x53, 0xf8, 0x40, 0x7e, 0x0a, 0x5b, 0x6c, 0x85, 0x17, 0x9a, 0x77, 0xe1,
+	0x9a, 0xd7, 0x70, 0x2a, 0xff, 0xb5, 0x04, 0x28, 0x86, 0xbe, 0x12, 0x4f, 0x7b, 0x50, 0xbe, 0xd4,
+	0x3c, 0xd5, 0x71, 0x4d, 0xc1, 0x57, 0x59, 0x29, 0x5d, 0x0a, 0xd2, 0x68, 0x17, 0x4a, 0x3d, 0xcd,
+	0xc3, 0x6a, 0x1f, 0xe3, 0x46, 0xce, 0xb7, 0x12, 0x0f, 0x9f, 0x60, 0x8c, 0x7e, 0x01, 0x6a, 0x8e,
+	0x6b, 0xda, 0xae, 0x49, 0xa6, 0x74, 0x9a, 0x5a, 0x6d, 0xf6, 0x51, 0xe5, 0x19, 0x12, 0x2b, 0x5f,
+	0x88, 0xb9, 0x13, 0x8c, 0x95, 0xaa, 0x33, 0x1b, 0x78, 0xf2, 0xaf, 0x40, 0x25, 0x34, 0x89, 0xee,
+	0x02, 0x38, 0xd8, 0xd5, 0xb1, 0x45, 0xcc, 0x21, 0x67, 0x5f, 0x52, 0x42, 0x10, 0xca, 0x31, 0x5d,
+	0x5d, 0x70, 0xdc, 0xc7, 0x58, 0xfe, 0x46, 0x82, 0x1d, 0xb6, 0x03, 0x5d, 0x57, 0xb3, 0x3c, 0x4d,
+	0x27, 0xa6, 0x6d, 0xdd, 0xec, 0xe4, 0xee, 0x40, 0x91, 0x4c, 0xd4, 0x81, 0xe6, 0x0d, 0x84, 0xe4,
+	0x05, 0x32, 0x79, 0xa9, 0x79, 0x03, 0x74, 0x0f, 0x40, 0xf3, 0xa6, 0x96, 0xae, 0x8e, 0x6c, 0x83,
+	0x4b, 0x5e, 0x62, 0x26, 0x5f, 0x66, 0xd0, 0x37, 0xb6, 0x81, 0xe5, 0xbf, 0xc9, 0xc2, 0x6e, 0x60,
+	0xc2, 0x11, 0x4e, 0x56, 0x52, 0x47, 0x2a, 0x4b, 0x4f, 0xa1, 0x4c, 0x26, 0xaa, 0x47, 0x34, 0x32,
+	0xf6, 0x18, 0x47, 0x6b, 0xcf, 0xd6, 0x05, 0xd9, 0xee, 0xa4, 0xc3, 0xc0, 0x4a, 0x89, 0x88, 0x27,
+	0x74, 0x17, 0x72, 0x1f, 0x4c, 0xcb, 0x57, 0x4a, 0xf8, 0xf8, 0x31, 0x38, 0xba, 0x07, 0xf9, 0x0f,
+	0xf6, 0x98, 0x78, 0x8d, 0x02, 0x43, 0xa8, 0xf8, 0x08, 0xf6, 0x98, 0x28, 0x7c, 0x06, 0x1d, 0x40,
+	0xc5, 0x33, 0x2f, 0x2d, 0xc6, 0x0b, 0xf6, 0x1a, 0xc5, 0xc3, 0xec, 0xa3, 0xaa, 0x02, 0x14, 0xf4,
+	0x92, 0x41, 0xa8, 0x71, 0xe8, 0xb6, 0x47, 0x98, 0x71, 0x94, 0xb8, 0x71, 0xd0, 0x31, 0x55, 0x6a,
+	0xdc, 0xf1, 0x95, 0xe7, 0x1d, 0xdf, 0x1d, 0x00, 0x8e, 0x42, 0xcc, 0x11, 0x6e, 0x00, 0x43, 0x28,
+	0x33, 0x48, 0xd7, 0x1c, 0x61, 0xf4, 0x8b, 0x50, 0xb3, 0x47, 0x96, 0xa9, 0x12, 0xba, 0xb3, 0x7d,
+	0xec, 0x36, 0x2a, 0xcc, 0x91, 0x6c, 0x0a, 0x46, 0xcf, 0x47, 0x96, 0xd9, 0x15, 0x53, 0x4a, 0xd5,
+	0x0e, 0x8d, 0xd0, 0x0f, 0xa0, 0x38, 0xc2, 0x23, 0xc7, 0xb6, 0x87, 0x8d, 0x6a, 0xe4, 0x3f, 0x6f,
+	0x38, 0xb4, 0x6d, 0x11, 0x77, 0xaa, 0xf8, 0x38, 0xf2, 0xbf, 0x48, 0x50, 0x0d, 0xcf, 0x20, 0x04,
+	0x39, 0xc6, 0x12, 0x55, 0x5d, 0x56, 0x61, 0xcf, 0xf3, 0x46, 0x48, 0x85, 0xef, 0x63, 0xac, 0xba,
+	0x1a, 0xf1, 0x4f, 0x73, 0xb1, 0x8f, 0xb1, 0xa2, 0x11, 0xcc, 0xe2, 0x97, 0x67, 0x7e, 0xc5, 0xed,
+	0x26, 0xab, 0xf0, 0x01, 0x3a, 0x84, 0x8a, 0x8b, 0x9d, 0xa1, 0xa6, 0x63, 0xad, 0x37, 0xc4, 0xcc,
+	0xc7, 0x97, 0x94, 0x30, 0x88, 0x46, 0x38, 0xea, 0x7f, 0x3d, 0x62, 0xbb, 0xaa, 0x6e, 0x8f, 0x2d,
+	0xd2, 0x28, 0x30, 0x02, 0x35, 0x1f, 0xda, 0xa2, 0x40, 0x1a, 0x31, 0x0c, 0xec, 0xe9, 0xd8, 0x32,
+	0x34, 0x8b, 0x08, 0xc4, 0x22, 0x43, 0x5c, 0x9f, 0xc1, 0x19, 0xaa, 0xfc, 0x0f, 0x79, 0xd8, 0x67,
+	0x36, 0x7a, 0xa4, 0x33, 0xbc, 0xff, 0x77, 0x66, 0x8a, 0x20, 0xd7, 0x77, 0xed, 0x91, 0x88, 0x78,
+	0xec, 0x19, 0xad, 0x41, 0x86, 0xd8, 0x4c, 0xf4, 0xb2, 0x92, 0x21, 0x36, 0x3d, 0xd2, 0xda, 0x28,
+	0x90, 0xb2, 0xac, 0x88, 0x11, 0xfd, 0xef, 0x08, 0x8f, 0x6c, 0x61, 0x7a, 0xec, 0x79, 0xe6, 0x60,
+	0xcb, 0x21, 0x07, 0xeb, 0xbb, 0xb8, 0xa1, 0x39, 0x32, 0x49, 0x03, 0x02, 0x17, 0xf7, 0x9a, 0x8e,
+	0xa3, 0xfe, 0xaf, 0x32, 0xef, 0xff, 0x02, 0x13, 0xaf, 0x2e, 0x36, 0xf1, 0xda, 0x75, 0x26, 0xbe,
+	0x16, 0x37, 0xf1, 0x3d, 0x28, 0x07, 0x07, 0xac, 0xb1, 0xce, 0xb2, 0xc3, 0x92, 0x7f, 0xbc, 0x12,
+	0xf3, 0x82, 0x7a, 0x62, 0x5e, 0x20, 0x74, 0x41, 0xa6, 0x0e, 0x6e, 0x6c, 0x1c, 0x4a, 0x8f, 0x6a,
+	0x54, 0x17, 0xdd, 0xa9, 0x43, 0x0d, 0x6a, 0x7d, 0xa4, 0x4d, 0x28, 0xf3, 0xaa, 0x83, 0x5d, 0xf5,
+	0x52, 0xf3, 0x1a, 0x88, 0x91, 0xa8, 0x8e, 0xb4, 0xc9, 0x09, 0xc6, 0x17, 0xd8, 0x7d, 0xa1, 0x79,
+	0xe8, 0xe7, 0xa1, 0x41, 0xd1, 0xc2, 0xde, 0x3c, 0xc0, 0xdf, 0x64, 0xf8, 0x5b, 0x23, 0x6d, 0x12,
+	0xf2, 0xd9, 0xe2, 0x7f, 0x3f, 0x82, 0x8a, 0xa6, 0xeb, 0xd8, 0xa3, 0x3b, 0xeb, 0x91, 0xc6, 0x56,
+	0xc4, 0xff, 0x1f, 0xb1, 0x99, 0xee, 0xd8, 0x19, 0x62, 0x05, 0x38, 0xda, 0x6b, 0xd3, 0x63, 0x5a,
+	0x33, 0x34, 0xa2, 0x35, 0xb6, 0x99, 0xbc, 0xec, 0x99, 0x6a, 0x78, 0x84, 0xc9, 0xc0, 0x36, 0x1a,
+	0xb7, 0xb9, 0x86, 0xf9, 0x88, 0xe2, 0x6a, 0xee, 0xa5, 0xd7, 0xd8, 0xe1, 0x1a, 0xa6, 0xcf, 0xf2,
+	0x9f, 0x49, 0xf0, 0x30, 0xee, 0xfc, 0x4f, 0x5c, 0x7b, 0xd4, 0x31, 0x2f, 0x2d, 0x6c, 0x1c, 0x6b,
+	0x44, 0xbb, 0x59, 0x28, 0x78, 0x00, 0x6b, 0x1e, 0x23, 0xa1, 0x92, 0x89, 0xca, 0x38, 0xcc, 0x32,
+	0x0e, 0xab, 0x1c, 0xda, 0x9d, 0x1c, 0x0b, 0x4e, 0x43, 0xa9, 0x5c, 0x56, 0x11, 0xa3, 0xeb, 0xdc,
+	0xad, 0xfc, 0xe7, 0x12, 0x1c, 0x24, 0x71, 0x7d, 0x73, 0x7e, 0x77, 0xa1, 0xe4, 0x6a, 0x57, 0x61,
+	0x4e, 0x8b, 0xae, 0x76, 0xb5, 0x0a, 0x93, 0xf4, 0x94, 0x6b, 0x3d, 0x53, 0x9c, 0x3c, 0xfa, 0x28,
+	0x7f, 0x9b, 0x81, 0xec, 0x3b, 0xd3, 0xa2, 0x8a, 0x60, 0x46, 0xca, 0x19, 0x63, 0xcf, 0x94, 0x2d,
+	0xd3, 0x32, 0xf0, 0x84, 0xb1, 0x55, 0x53, 0xf8, 0x20, 0x74, 0x58, 0xb3, 0x7c, 0x6d, 0x3e, 0x0a,
+	0xe7, 0x57, 0xb9, 0xb9, 0x1c, 0xd9, 0x33, 0x2f, 0x29, 0x49, 0x6e, 0xc2, 0x79, 0x46, 0xae, 0x22,
+	0x60, 0xcc, 0x8e, 0x9b, 0x50, 0xf2, 0xe8, 0x26, 0xd1, 0x83, 0x5d, 0x60, 0xd3, 0xc1, 0x98, 0x3a,
+	0xcd, 0x2b, 0x93, 0x58, 0xd4, 0x0a, 0x3d, 0xdd, 0x35, 0x1d, 0xee, 0x25, 0xaa, 0x4a, 0x4d, 0x40,
+	0x3b, 0x0c, 0x48, 0x8f, 0x93, 0x8f, 0xd6, 0x73, 0x35, 0x4b, 0xa7, 0x11, 0xad, 0x74, 0x98, 0x7d,
+	0x54, 0x52, 0xd6, 0x05, 0xfc, 0xb9, 0x00, 0xd3, 0xd5, 0x74, 0xdb, 0xb4, 0x68, 0x9e, 0xc3, 0xdc,
+	0x48, 0x49, 0x09, 0xc6, 0x34, 0x59, 0x19, 0x5b, 0x2e, 0xf6, 0xec, 0xe1, 0x07, 0x6c, 0x30, 0x57,
+	0x52, 0x52, 0x42, 0x10, 0xf9, 0x6f, 0x25, 0xc8, 0xd1, 0x18, 0x1a, 0x96, 0x57, 0x8a, 0xca, 0x3b,
+	0xdb, 0xa1, 0x4c, 0x64, 0x87, 0x82, 0xfd, 0xcc, 0x86, 0xf7, 0xf3, 0x31, 0xe4, 0x68, 0x70, 0x63,
+	0x9b, 0x56, 0x79, 0xb6, 0x1d, 0x8a, 0x7e, 0x1d, 0x73, 0xe4, 0x0c, 0x71, 0x07, 0x5b, 0x86, 0xc2,
+	0x50, 0x58, 0xbc, 0x66, 0xc2, 0xce, 0xf6, 0xb1, 0xac, 0x00, 0x07, 0xb1, 0x6d, 0xa4, 0x06, 0xc6,
+	0x46, 0x42, 0xc5, 0x62, 0x14, 0x38, 0xd2, 0xe2, 0xcc, 0x91, 0xca, 0xa7, 0xb0, 0x16, 0x5d, 0x84,
+	0x92, 0x77, 0x5c, 0xdb, 0xc1, 0x2e, 0x99, 0xaa, 0xa6, 0xc1, 0xa4, 0xaa, 0x29, 0xe0, 0x83, 0x4e,
+	0x8d, 0x34, 0xc1, 0xe4, 0xdf, 0x86, 0x6a, 0x38, 0x5a, 0xdf, 0x98, 0x10, 0xe3, 0x1f, 0x5b, 0x06,
+	0x76, 0xfd, 0x90, 0xc3, 0x47, 0x68, 0x1f, 0xca, 0x2e, 0xee, 0x63, 0x97, 0xd9, 0x07, 0xb7, 0xae,
+	0x19, 0x40, 0xfe, 0x4f, 0x09, 0xf6, 0x5b, 0x2e, 0xd6, 0x08, 0x9e, 0x4b, 0xd4, 0x6e, 0x72, 0xee,
+	0xfc, 0x43, 0x94, 0xbd, 0x2e, 0xb1, 0xca, 0xa5, 0x26, 0x56, 0x22, 0x99, 0xc8, 0xcf, 0x92, 0x89,
+	0x58, 0x6e, 0x50, 0x98, 0xcf, 0x0d, 0x12, 0x74, 0x44, 0xe3, 0xc7, 0x2c, 0xba, 0x94, 0xf8, 0xb9,
+	0xf0, 0x83, 0x0b, 0xbd, 0xda, 0x36, 0x53, 0xc4, 0xfe, 0x04, 0x81, 0x3f, 0xe4, 0x76, 0x0a, 0x84,
+	0xbb, 0xc6, 0x58, 0xba, 0x98, 0x9b, 0x4b, 0x17, 0x9b, 0x50, 0xba, 0xd2, 0x5c, 0xcb, 0xb4, 0x2e,
+	0xb9, 0x0b, 0x2a, 0x2b, 0xc1, 0x58, 0xfe, 0x36, 0x07, 0x07, 0x9c, 0xdb, 0xa4, 0x4c, 0xe5, 0x26,
+	0x7a, 0xf2, 0x33, 0x8b, 0xec, 0x5c, 0x66, 0x91, 0x4b, 0xc8, 0x2c, 0xf2, 0x89, 0x99, 0x45, 0x21,
+	0xba, 0xd9, 0xb3, 0x1c, 0xa2, 0xb8, 0x28, 0x87, 0x28, 0xc5, 0x72, 0x88, 0xe4, 0x9c, 0x24, 0x29,
+	0xbe, 0x43, 0x72, 0x7c, 0x4f, 0x08, 0xe3, 0x95, 0x8f, 0x0c, 0xe3, 0xd5, 0xe5, 0xc3, 0x78, 0x6d,
+	0xa9, 0x30, 0xfe, 0x14, 0x90, 0xce, 0xf4, 0xa5, 0x86, 0xff, 0xbb, 0xc6, 0x0c, 0xb7, 0xae, 0xfb,
+	0x9a, 0x8c, 0x07, 0xfd, 0xf5, 0xc4, 0xa0, 0x5f, 0x4f, 0x0c, 0xfa, 0x1b, 0xb3, 0xa0, 0xef, 0x47,
+	0x26, 0x34, 0x8b, 0x4c, 0x5f, 0x40, 0x25, 0xc4, 0xda, 0x02, 0x77, 0x4b, 0xc3, 0x0b, 0xb1, 0x5d,
+	0xed, 0x12, 0xd3, 0x22, 0x1c, 0xbd, 0xdd, 0x53, 0xcb, 0xab, 0x08, 0xd8, 0x2b, 0x3c, 0xf5, 0xe4,
+	0xdf, 0x95, 0xe0, 0x4e, 0xba, 0xf1, 0x7d, 0x9e, 0xd3, 0x12, 0xc9, 0xfd, 0x72, 0xd1, 0xdc, 0x8f,
+	0x9e, 0xdd, 0x87, 0x11, 0x86, 0x78, 0x72, 0xf3, 0x89, 0xae, 0xbb, 0x09, 0xdc, 0xec, 0x73, 0x6e,
+	0x34, 0x32, 0x76, 0xb1, 0xe0, 0x66, 0x06, 0x88, 0x95, 0x31, 0xf3, 0xf1, 0x32, 0xe6, 0xdf, 0x4b,
+	0x20, 0xcf, 0x3c, 0xcd, 0xe7, 0x66, 0xf5, 0x2e, 0x40, 0xc0, 0x59, 0xc4, 0xcb, 0x70, 0x08, 0x8b,
+	0x2e, 0x01, 0xb3, 0xdc, 0xd1, 0x54, 0x15, 0x08, 0xb8, 0x9d, 0xdd, 0x8c, 0x0b, 0x29, 0xa9, 0xda,
+	0xef, 0x07, 0xf1, 0x22, 0x41, 0x94, 0x95, 0x8c, 0x61, 0xb9, 0x14, 0xd3, 0xcf, 0xb5, 0xb8, 0x1a,
+	0xd8, 0x33, 0x2d, 0xb0, 0xee, 0x3d, 0x77, 0x6d, 0xcd, 0xd0, 0x35, 0x6f, 0x75, 0xd7, 0xb8, 0x1c,
+	0x1f, 0x87, 0x50, 0xf5, 0xbd, 0x0e, 0xbb, 0xe4, 0xf2, 0xda, 0x25, 0x70, 0x97, 0xc3, 0xee, 0xb9,
+	0xf7, 0xa0, 0xaa, 0x0f, 0xb0, 0xfe, 0x5e, 0x75, 0xec, 0xa1, 0xa9, 0x4f, 0xfd, 0x2b, 0x2d, 0x83,
+	0x5d, 0x30, 0x10, 0xcd, 0x87, 0x76, 0x93, 0x19, 0xff, 0x3c, 0xb7, 0xcf, 0x67, 0x34, 0x90, 0x7e,
+	0x89, 0x75, 0x7a, 0x2f, 0x16, 0x85, 0x9b, 0x30, 0x61, 0x3a, 0xc3, 0x08, 0x83, 0x1b, 0x3c, 0xa3,
+	0xfb, 0x50, 0x13, 0xff, 0x71, 0xb1, 0xe6, 0xd9, 0x96, 0x08, 0x06, 0x55, 0x0e, 0x54, 0x18, 0x4c,
+	0xfe, 0x26, 0x03, 0x5b, 0xc7, 0xee, 0x54, 0x19, 0x5b, 0x81, 0x38, 0x9f, 0xa0, 0x5a, 0x3f, 0x1c,
+	0xda, 0x57, 0xd8, 0xaf, 0x73, 0xfb, 0xc3, 0xb0, 0x74, 0xb9, 0x45, 0xd2, 0xe5, 0x6f, 0x24, 0x5d,
+	0x61, 0x5e, 0x3a, 0x3f, 0x23, 0x29, 0xce, 0x32, 0x92, 0xa0, 0x86, 0x51, 0x0a, 0xd5, 0x30, 0xe4,
+	0x7f, 0x97, 0xe0, 0xee, 0x3b, 0xec, 0x9a, 0xfd, 0xe9, 0x27, 0x3a, 0xe6, 0x87, 0x50, 0x16, 0x8e,
+	0x1a, 0xf3, 0x94, 0xaa, 0x2c, 0xca, 0x6c, 0x3e, 0x30, 0xc1, 0x58, 0x73, 0xc9, 0xf7, 0x32, 0x91,
+	0x1a, 0xe6, 0x23, 0xa9, 0xe1, 0xec, 0x2a, 0x54, 0x48, 0xbc, 0x0a, 0x15, 0x53, 0x9c, 0x80, 0x07,
+	0xfb, 0xa9, 0x72, 0xae, 0xa4, 0xf5, 0x26, 0x94, 0x3e, 0x50, 0xc2, 0x66, 0xa0, 0xf6, 0x60, 0x2c,
+	0x7f, 0x9d, 0x81, 0x7a, 0x77, 0x72, 0x6a, 0xe9, 0xc3, 0xb1, 0x67, 0xda, 0xd6, 0x85, 0x6b, 0xdb,
+	0xfd, 0xb0, 0x31, 0x48, 0xb1, 0x12, 0x65, 0x50, 0x7f, 0xd0, 0xa8, 0xe0, 0xbc, 0xbd, 0xe4, 0xd7,
+	0x1f, 0x28, 0x68, 0x56, 0x7f, 0x08, 0x9d, 0x14, 0x5e, 0x7f, 0x88, 0x53, 0x48, 0xeb, 0x4e, 0xec,
+	0x42, 0x89, 0x4c, 0x54, 0x7e, 0x2f, 0xe1, 0x17, 0xb3, 0x22, 0x99, 0x9c, 0xd2, 0xa1, 0x98, 0x9a,
+	0xd5, 0xa9, 0xd8, 0x14, 0xaf, 0x50, 0xdd, 0x87, 0xda, 0x08, 0xbb, 0xef, 0x87, 0x58, 0xdc, 0xb5,
+	0x44, 0xed, 0xb0, 0xca, 0x81, 0xfc, 0xa2, 0x85, 0xb6, 0xa1, 0x40, 0x2f, 0xb0, 0x64, 0xc2, 0x4c,
+	0xac, 0xaa, 0xe4, 0x5d, 0xed, 0xaa, 0x3b, 0x91, 0xbf, 0x84, 0xe6, 0x0b, 0x4c, 0xe2, 0xdb, 0xb0,
+	0xb8, 0x78, 0x1f, 0xda, 0xa3, 0x4c, 0x64, 0x8f, 0x16, 0x6f, 0x80, 0xfc, 0xb5, 0x04, 0x8d, 0xc4,
+	0xc5, 0x56, 0x52, 0xf1, 0x0f, 0x80, 0xb6, 0x30, 0xed, 0xbe, 0x68, 0x7d, 0xec, 0x04, 0xd5, 0xaf,
+	0xd8, 0x2a, 0x1c, 0x4b, 0x36, 0xe0, 0x0e, 0x37, 0xb5, 0x8f, 0x93, 0x39, 0x58, 0x25, 0xb3, 0xd4,
+	0x2a, 0x2e, 0xec, 0xa5, 0xad, 0xf2, 0xd9, 0xec, 0x59, 0x85, 0xbd, 0xa0, 0x40, 0x7e, 0x6a, 0x79,
+	0xab, 0xd5, 0x3b, 0xfc, 0x14, 0x32, 0x3b, 0x4b, 0x21, 0xe5, 0x21, 0x6c, 0x84, 0x17, 0x58, 0x51,
+	0x94, 0x6b, 0x6e, 0x76, 0xb2, 0x06, 0x75, 0x9a, 0xcc, 0xd2, 0xc5, 0xae, 0xe9, 0xfb, 0xee, 0x87,
+	0xbd, 0x1a, 0x4f, 0x38, 0x67, 0x00, 0x7a, 0x70, 0x46, 0xa6, 0xa5, 0xea, 0xb6, 0xd5, 0xf7, 0x2b,
+	0xc7, 0x23, 0xd3, 0x6a, 0xd9, 0x56, 0x5f, 0xfe, 0x47, 0x09, 0x72, 0x94, 0xfe, 0x67, 0x2d, 0xb8,
+	0x50, 0x8f, 0xca, 0xeb, 0x04, 0xce, 0xb8, 0x17, 0xa4, 0x74, 0x65, 0xa5, 0xca, 0xa1, 0x17, 0xe3,
+	0xde, 0x2b, 0x3c, 0x9d, 0x73, 0x0e, 0x85, 0x79, 0xe7, 0xf0, 0x00, 0x6a, 0x54, 0x08, 0xd3, 0x1d,
+	0x69, 0xd4, 0x33, 0x7a, 0x2c, 0x7e, 0xe4, 0x94, 0x28, 0x50, 0xfe, 0x3d, 0x09, 0xd6, 0x42, 0xfb,
+	0xb6, 0x92, 0x8a, 0xee, 0x41, 0x7e, 0x4c, 0xc9, 0x34, 0xb2, 0x91, 0xcb, 0x35, 0x25, 0xad, 0xf0,
+	0x99, 0x25, 0x9c, 0x9a, 0xfc, 0x5f, 0x34, 0x9b, 0x1a, 0x9b, 0x43, 0x23, 0xa5, 0x20, 0x90, 0xac,
+	0xd4, 0x43, 0x7f, 0xed, 0xcc, 0x9c, 0x7d, 0x88, 0xa5, 0xf7, 0xe7, 0x82, 0x59, 0x58, 0xed, 0x4b,
+	0x14, 0x06, 0xc2, 0x3d, 0x85, 0x7c, 0xb4, 0xa7, 0x40, 0xbb, 0xdf, 0x03, 0xcd, 0xba, 0xc4, 0xc1,
+	0x65, 0x91, 0xc7, 0xf1, 0x1a, 0x87, 0xfa, 0x57, 0xc5, 0x58, 0x21, 0xa1, 0x38, 0x57, 0x48, 0x90,
+	0x7f, 0x27, 0x03, 0xbb, 0xc9, 0xc2, 0xff, 0x1f, 0x95, 0x05, 0x3e, 0x41, 0xa7, 0x6a, 0x3e, 0x7d,
+	0x61, 0xa9, 0x29, 0xdb, 0x2e, 0x7e, 0x64, 0x68, 0x88, 0xc9, 0x2b, 0x15, 0x0e, 0x63, 0xf1, 0x4b,
+	0xfe, 0x2b, 0x09, 0x6e, 0xb7, 0x3d, 0x62, 0x8e, 0xc4, 0xc5, 0x85, 0x66, 0xb5, 0x0b, 0x0d, 0xe0,
+	0x00, 0x2a, 0xd4, 0xb2, 0x55, 0xa2, 0xb9, 0x97, 0x98, 0x88, 0x53, 0x08, 0x14, 0xd4, 0x65, 0x10,
+	0x1a, 0xf6, 0xb0, 0x20, 0xc8, 0xfb, 0x86, 0x3c, 0xe0, 0x54, 0x7d, 0x20, 0x6d, 0x1b, 0x52, 0x2a,
+	0xa6, 0xe5, 0x8c, 0x79, 0x91, 0x8e, 0xef, 0x47, 0x59, 0x01, 0x06, 0xa2, 0x45, 0x3a, 0x66, 0xc0,
+	0xf6, 0x98, 0xcc, 0x30, 0x78, 0xa9, 0xa4, 0xc2, 0x61, 0x0c, 0x45, 0xfe, 0x67, 0x09, 0xb6, 0xe6,
+	0x58, 0x5f, 0x49, 0x7d, 0x0b, 0xba, 0x59, 0xb7, 0xa1, 0xc0, 0x0e, 0x0f, 0xf7, 0x23, 0x35, 0x45,
+	0x8c, 0x68, 0x01, 0x43, 0xb4, 0xd0, 0xd4, 0xbe, 0x36, 0x1c, 0xf6, 0x34, 0xfd, 0xbd, 0xb8, 0x01,
+	0xac, 0x0b, 0xf8, 0x89, 0x00, 0xcf, 0x92, 0xc9, 0x42, 0xb8, 0x21, 0x36, 0xa7, 0x35, 0xf9, 0xef,
+	0x24, 0x40, 0xcf, 0xc7, 0x23, 0x67, 0x29, 0x75, 0xa4, 0x06, 0xfd, 0xe5, 0xae, 0x37, 0xbe, 0xd9,
+	0xe5, 0x52, 0xcc, 0x6e, 0xe5, 0xb3, 0x28, 0xff, 0xb7, 0x04, 0xf5, 0x88, 0x34, 0xdf, 0xb1, 0x03,
+	0x66, 0xbb, 0xe6, 0xa5, 0x69, 0x69, 0xc3, 0x50, 0xff, 0xb7, 0xe2, 0xc3, 0x4e, 0xb8, 0x36, 0xb9,
+	0x9b, 0x6d, 0x39, 0x7d, 0x67, 0x69, 0x37, 0xfb, 0x00, 0xd6, 0x1c, 0xcd, 0xc5, 0x16, 0x51, 0xa3,
+	0xda, 0xad, 0x72, 0x68, 0x77, 0xf2, 0x32, 0x12, 0x0b, 0x23, 0xc5, 0xf2, 0xb0, 0xce, 0x72, 0x51,
+	0x9d, 0xdd, 0x01, 0x20, 0x76, 0xec, 0x05, 0x9b, 0x32, 0xb1, 0x53, 0xfc, 0xe6, 0x7c, 0x01, 0x56,
+	0xfe, 0x23, 0xdf, 0x6f, 0xce, 0x49, 0xf3, 0x5d, 0x52, 0x2b, 0x2d, 0xf5, 0xf0, 0xdd, 0x9f, 0x29,
+	0xb5, 0xcc, 0x21, 0xa2, 0xe7, 0x29, 0xa6, 0xf9, 0x79, 0x2e, 0xb3, 0xf3, 0x5c, 0xe1, 0xb0, 0x77,
+	0xec, 0x8a, 0xf8, 0x1f, 0x12, 0xec, 0xb3, 0x7d, 0xea, 0x5c, 0x61, 0xbc, 0xbc, 0xda, 0x17, 0xa7,
+	0x4c, 0x87, 0xd1, 0xb8, 0x9f, 0x10, 0x7b, 0x0f, 0xa1, 0x62, 0x50, 0x3f, 0x6b, 0xb1, 0xac, 0x43,
+	0xa4, 0x3c, 0x61, 0xd0, 0xa2, 0x03, 0x7d, 0x07, 0x68, 0x59, 0x43, 0x65, 0x4e, 0xd8, 0x13, 0x97,
+	0x99, 0xf2, 0x48, 0x9b, 0x9c, 0x32, 0xc0, 0x12, 0x41, 0xf5, 0x2f, 0x24, 0xa8, 0xc7, 0xe5, 0x0d,
+	0xab, 0x56, 0x5a, 0xa4, 0xda, 0x4c, 0xaa, 0x6a, 0xd3, 0x7a, 0x0c, 0x07, 0x90, 0xa3, 0x0a, 0x14,
+	0x4d, 0xa1, 0x88, 0x66, 0xd9, 0x44, 0x42, 0x87, 0x21, 0xd1, 0x05, 0xcb, 0x7f, 0x2c, 0x41, 0x33,
+	0x45, 0x59, 0x2b, 0x59, 0xf5, 0x63, 0xc8, 0x92, 0x89, 0xcf, 0xbf, 0x7f, 0x35, 0x99, 0x5b, 0x83,
+	0xe2, 0xa0, 0x07, 0x50, 0xf4, 0xde, 0x9b, 0x8e, 0x83, 0x8d, 0x04, 0x57, 0xec, 0x4f, 0xc9, 0x3f,
+	0x81, 0xc2, 0x85, 0x36, 0xbd, 0x59, 0x63, 0x2d, 0xd2, 0x1e, 0xca, 0xc6, 0xdb, 0x43, 0x7f, 0x98,
+	0x81, 0x1d, 0xb6, 0x05, 0x9c, 0xfe, 0x73, 0x8d, 0xe8, 0x83, 0xc5, 0xa6, 0xfa, 0x33, 0x50, 0x74,
+	0x18, 0xae, 0x9f, 0x0a, 0xd6, 0xfc, 0x57, 0x9e, 0x18, 0x54, 0xf1, 0x67, 0x97, 0xb0, 0xda, 0x88,
+	0xd5, 0xe7, 0x12, 0x2e, 0x0a, 0x2b, 0xa6, 0x83, 0x07, 0x50, 0xa1, 0x86, 0xcd, 0x73, 0x07, 0x9e,
+	0x9f, 0xd7, 0x58, 0x09, 0xef, 0x7c, 0x4c, 0x92, 0x4c, 0xbb, 0x34, 0x6f, 0xda, 0xff, 0x2a, 0xc1,
+	0x06, 0x17, 0xec, 0x7f, 0xc7, 0xb6, 0x6f, 0xd4, 0x3f, 0x8b, 0xa7, 0x7b, 0x85, 0xb9, 0x74, 0x2f,
+	0x9c, 0x2e, 0x14, 0xc3, 0xe9, 0x82, 0x7c, 0x05, 0x35, 0xa1, 0x38, 0xac, 0x63, 0xd3, 0x89, 0xd9,
+	0x8b, 0x14, 0xb3, 0x97, 0x48, 0x45, 0x24, 0x13, 0xad, 0x88, 0x24, 0x07, 0xa5, 0xb4, 0x6a, 0x9e,
+	0xfc, 0xad, 0x04, 0xdb, 0xf3, 0x96, 0xb7, 0xd2, 0xb9, 0x7b, 0x12, 0x3e, 0x77, 0x8d, 0x88, 0x59,
+	0xce, 0x1d, 0xbc, 0x9f, 0x83, 0x92, 0xcb, 0x25, 0xf6, 0xf7, 0x7a, 0x2b, 0x6a, 0xc7, 0x7c, 0x52,
+	0x09, 0xb0, 0xe4, 0x01, 0xec, 0xb4, 0x68, 0x6d, 0xf7, 0xd8, 0x1e, 0xf7, 0x86, 0xb8, 0xe3, 0xd0,
+	0xd6, 0xf3, 0xc2, 0x93, 0xe2, 0xeb, 0x3a, 0x93, 0xa2, 0xeb, 0xb4, 0x32, 0xae, 0xfc, 0x07, 0x12,
+	0x54, 0x42, 0xab, 0x7c, 0xc4, 0x75, 0x78, 0x17, 0x4a, 0xec, 0xdd, 0x4f, 0xb5, 0x37, 0x15, 0x34,
+	0x8b, 0x6c, 0xfc, 0x7c, 0x4a, 0xf5, 0x2a, 0x6e, 0xa6, 0xcc, 0xd7, 0x50, 0x4b, 0x9f, 0x01, 0xd0,
+	0x13, 0x28, 0x88, 0x97, 0x96, 0x78, 0x59, 0xd5, 0x6f, 0x80, 0x31, 0x06, 0xc4, 0x7b, 0x4b, 0x02,
+	0x43, 0xfe, 0x53, 0x09, 0xb6, 0xe7, 0x77, 0x62, 0xc5, 0x9b, 0x6d, 0xd5, 0x60, 0xc4, 0xd4, 0xf0,
+	0x9b, 0xac, 0x15, 0x23, 0x58, 0x80, 0xd0, 0x17, 0x2e, 0x43, 0x28, 0x86, 0xaf, 0x35, 0x9f, 0xd1,
+	0x30, 0x2f, 0xd5, 0xd9, 0xff, 0x0c, 0xef, 0xc9, 0x03, 0x80, 0x19, 0x07, 0xa8, 0x02, 0xc5, 0xce,
+	0xdb, 0x56, 0xab, 0xdd, 0xe9, 0xd4, 0x6f, 0xa1, 0x32, 0xe4, 0xdb, 0x8a, 0x72, 0xae, 0xd4, 0xa5,
+	0x27, 0xff, 0x26, 0x51, 0xb4, 0xa0, 0x6e, 0xbc, 0x0e, 0x15, 0xa5, 0xfd, 0x45, 0xbb, 0xd5, 0x55,
+	0xcf, 0xce, 0xcf, 0xda, 0xf5, 0x5b, 0x68, 0x0f, 0x76, 0x04, 0xe0, 0xf4, 0xac, 0xf3, 0xf6, 0xe4,
+	0xe4, 0xb4, 0x75, 0xda, 0x3e, 0xeb, 0xaa, 0x27, 0xed, 0x76, 0x5d, 0x42, 0x3b, 0xb0, 0x39, 0xc3,
+	0x56, 0x3b, 0xdd, 0xa3, 0xb3, 0xe3, 0x23, 0xe5, 0xb8, 0x9e, 0x41, 0xbb, 0xb0, 0x2d, 0x26, 0xde,
+	0x9c, 0x76, 0x3a, 0xa7, 0x67, 0x2f, 0xd4, 0xd3, 0xb3, 0x8b, 0xb7, 0xdd, 0x4e, 0x3d, 0x8b, 0x1a,
+	0xb0, 0x25, 0xa6, 0x8e, 0x5e, 0x2b, 0xed, 0xa3, 0xe3, 0x9f, 0xaa, 0x9d, 0x8b, 0xf6, 0x59, 0xb7,
+	0x9e, 0x4b, 0x98, 0x79, 0x75, 0x76, 0xfe, 0xe3, 0xb3, 0x7a, 0x3e, 0xb4, 0xce, 0x49, 0xbb, 0xad,
+	0x76, 0xcf, 0xcf, 0xd5, 0x97, 0xa7, 0x2f, 0x5e, 0xd6, 0x0b, 0x08, 0xc1, 0x5a, 0xc0, 0xdd, 0xbb,
+	0xa3, 0xd7, 0xa7, 0xc7, 0xf5, 0x22, 0xaa, 0x43, 0x55, 0xc0, 0xce, 0xbb, 0x2f, 0xdb, 0x4a, 0xbd,
+	0xf4, 0xc4, 0x80, 0x92, 0xff, 0x12, 0x1a, 0xaa, 0x42, 0xe9, 0xcc, 0x26, 0x27, 0xf6, 0xd8, 0x32,
+	0xea, 0xb7, 0xe8, 0xae, 0x5c, 0x60, 0xcb, 0x30, 0xad, 0xcb, 0xba, 0x84, 0x00, 0x0a, 0x27, 0x9a,
+	0x39, 0xc4, 0x46, 0x3d, 0xc3, 0xb6, 0x6b, 0xcc, 0x3a, 0x8d, 0xf5, 0x2c, 0x95, 0xa6, 0x25, 0x9a,
+	0xb3, 0xed, 0x09, 0xd6, 0xc7, 0x04, 0x0b, 0xbc, 0x1c, 0xdd, 0xc9, 0x73, 0x32, 0xc0, 0x6e, 0x3d,
+	0xff, 0xa4, 0x05, 0x95, 0x90, 0xd5, 0xd0, 0x19, 0x2e, 0xd8, 0x2d, 0xb4, 0x09, 0xeb, 0xf4, 0xf1,
+	0xb8, 0xad, 0xa8, 0x6f, 0xcf, 0xb8, 0x4c, 0x12, 0xda, 0x82, 0xba, 0x18, 0xa8, 0xe7, 0x6f, 0xbb,
+	0x17, 0xe7, 0xa7, 0x67, 0xdd, 0x7a, 0xe6, 0xd9, 0x3f, 0xdd, 0x86, 0x72, 0xcb, 0xff, 0x34, 0x02,
+	0xfd, 0x1a, 0x6c, 0x25, 0xb5, 0x51, 0x90, 0x2c, 0x94, 0xbf, 0xa0, 0x39, 0xd4, 0x3c, 0x5c, 0x88,
+	0x43, 0xad, 0x56, 0x81, 0xf5, 0x58, 0x6f, 0x63, 0x29, 0xc2, 0x7b, 0xbe, 0xe5, 0x25, 0xf5, 0x45,
+	0xbe, 0x80, 0xb5, 0xe8, 0xc7, 0x0d, 0x68, 0x5f, 0xa0, 0x27, 0x7e, 0x41, 0xd1, 0x6c, 0xa6, 0xcc,
+	0x52, 0x5a, 0xc7, 0x50, 0x0d, 0x7f, 0xde, 0x81, 0x7c, 0xdc, 0x84, 0x0f, 0x44, 0x9a, 0x8d, 0xc4,
+	0x39, 0x41, 0x25, 0xfc, 0x91, 0x42, 0x40, 0x25, 0xe1, 0xcb, 0x88, 0x66, 0x23, 0x71, 0x8e, 0x52,
+	0xf1, 0xe0, 0xee, 0xe2, 0xd6, 0x2c, 0x7a, 0xea, 0x4b, 0xb2, 0x4c, 0x07, 0xb7, 0x79, 0x3f, 0x82,
+	0x9d, 0xd2, 0x6e, 0x18, 0x40, 0x23, 0xad, 0x41, 0x8d, 0xbe, 0x97, 0xb4, 0x5c, 0xc2, 0x42, 0x0f,
+	0xae, 0xc5, 0xa3, 0x2b, 0x8d, 0x60, 0x6f, 0x41, 0x2f, 0x17, 0x3d, 0x8e, 0x10, 0x59, 0xd4, 0xef,
+	0x5d, 0x4e, 0x30, 0x15, 0xb6, 0x13, 0x5f, 0x52, 0x41, 0xf7, 0xe7, 0x16, 0x4a, 0x58, 0xe2, 0xde,
+	0x62, 0x24, 0xba, 0x00, 0x3d, 0x38, 0x09, 0xd5, 0xae, 0x99, 0x7d, 0xa7, 0xd7, 0x01, 0x9b, 0x87,
+	0x0b, 0x71, 0x28, 0xf5, 0x23, 0xa8, 0x84, 0x6e, 0xf8, 0x68, 0x37, 0xf8, 0x43, 0xbc, 0x86, 0xd1,
+	0xdc, 0x49, 0x9a, 0x0a, 0x33, 0x18, 0xbb, 0x56, 0x46, 0x19, 0x4c, 0xbe, 0x41, 0x37, 0x0f, 0x17,
+	0xe2, 0x88, 0xfd, 0x4d, 0xcc, 0xef, 0x83, 0xfd, 0x5d, 0x74, 0x55, 0x6b, 0xde, 0x5b, 0x8c, 0x44,
+	0x17, 0xb8, 0x80, 0x7a, 0x3c, 0x87, 0x41, 0x77, 0xc3, 0x7f, 0x9b, 0x4f, 0xab, 0x9b, 0xfb, 0xa9,
+	0xf3, 0x94, 0xe2, 0x2f, 0x41, 0x39, 0x28, 0xea, 0x23, 0x7f, 0xdb, 0xe2, 0xdf, 0xa3, 0x34, 0xb7,
+	0xe7, 0x27, 0xe8, 0x9f, 0xbb, 0xb0, 0x15, 0x40, 0x42, 0x2d, 0x87, 0x60, 0x37, 0x17, 0xf4, 0x23,
+	0x9a, 0x8d, 0x04, 0x9c, 0x80, 0xa5, 0xa0, 0x82, 0x1d, 0xb0, 0x14, 0xef, 0x05, 0x34, 0xb7, 0xe7,
+	0x27, 0xc4, 0x0e, 0xc5, 0x73, 0x85, 0x60, 0x87, 0x52, 0xd2, 0xa9, 0xe6, 0x7e, 0xea, 0x3c, 0xa5,
+	0xf8, 0x1b, 0xe2, 0xf3, 0x87, 0x04, 0x67, 0x70, 0x37, 0x2c, 0xc3, 0x82, 0x43, 0xb9, 0xf0, 0xa5,
+	0xf0, 0x9f, 0x84, 0x36, 0xf1, 0x63, 0x88, 0x1f, 0xc6, 0x37, 0x70, 0x8e, 0xb2, 0x05, 0x07, 0x29,
+	0x2b, 0x07, 0x9a, 0xfa, 0x5e, 0xca, 0x22, 0x71, 0x6d, 0x2d, 0x25, 0xc9, 0x00, 0xf6, 0x93, 0x98,
+	0xf9, 0xe8, 0xc5, 0xae, 0x97, 0xec, 0x2b, 0x78, 0x98, 0xc2, 0x49, 0xf4, 0xe5, 0xe4, 0x20, 0x3a,
+	0x2c, 0xf5, 0x0e, 0xf3, 0x72, 0x52, 0x12, 0x90, 0xd3, 0xa4, 0xbc, 0xf1, 0xc2, 0xd7, 0x4b, 0x7c,
+	0x0c, 0xd5, 0xf0, 0xd7, 0x68, 0x41, 0x38, 0x4d, 0xf8, 0x9e, 0xae, 0xd9, 0x48, 0x9c, 0xa3, 0x54,
+	0x5e, 0x40, 0x2d, 0xf2, 0x31, 0x13, 0xda, 0x0b, 0xa3, 0xc6, 0xbe, 0x88, 0x6a, 0xee, 0x26, 0x4f,
+	0x52, 0x42, 0x6f, 0x60, 0x3d, 0x56, 0x12, 0x47, 0x77, 0x04, 0x76, 0x72, 0x95, 0xbf, 0xb9, 0x97,
+	0x36, 0x4d, 0xc9, 0xfd, 0x32, 0xc0, 0xec, 0xab, 0x2f, 0x14, 0xe1, 0x3f, 0xfc, 0x35, 0x59, 0xf3,
+	0x76, 0xc2, 0x0c, 0xfd, 0xff, 0xd0, 0x7f, 0x51, 0x22, 0x35, 0x4d, 0x78, 0xe8, 0xa7, 0x18, 0x0b,
+	0xdf, 0xa7, 0x68, 0xde, 0xbf, 0x0e, 0x8d, 0xae, 0x66, 0xfa, 0xdd, 0xdd, 0xe4, 0xa8, 0xfd, 0x29,
+	0x97, 0xfa, 0x29, 0x6c, 0x26, 0xb4, 0xcc, 0x91, 0x1f, 0x2a, 0xd2, 0x7b, 0xf7, 0xcd, 0x83, 0x45,
+	0x28, 0x94, 0x74, 0x0f, 0x6e, 0x27, 0xf7, 0xa8, 0xd1, 0x83, 0x08, 0x67, 0x69, 0x0b, 0xc8, 0xd7,
+	0x60, 0x39, 0xc3, 0x69, 0xaf, 0xc0, 0x50, 0x7e, 0xf4, 0x3f, 0x03, 0x00, 0xa9, 0x3e, 0xf4, 0xbf,
+	0x7d, 0x3c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2478,6 +5417,7 @@ const _ = grpc.SupportPackageIsVersion6
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ChainnodeClient interface {
 	BroadcastTransaction(ctx context.Context, in *BroadcastTransactionRequest, opts ...grpc.CallOption) (*BroadcastTransactionReply, error)
+	DryRunBroadcast(ctx context.Context, in *BroadcastTransactionRequest, opts ...grpc.CallOption) (*DryRunBroadcastReply, error)
 	ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressReply, error)
 	SupportChain(ctx context.Context, in *SupportChainRequest, opts ...grpc.CallOption) (*SupportChainReply, error)
 	ValidAddress(ctx context.Context, in *ValidAddressRequest, opts ...grpc.CallOption) (*ValidAddressReply, error)
@@ -2485,8 +5425,15 @@ type ChainnodeClient interface {
 	CreateAccountTransaction(ctx context.Context, in *CreateAccountTransactionRequest, opts ...grpc.CallOption) (*CreateAccountTransactionReply, error)
 	CreateUtxoSignedTransaction(ctx context.Context, in *CreateUtxoSignedTransactionRequest, opts ...grpc.CallOption) (*CreateSignedTransactionReply, error)
 	CreateUtxoTransaction(ctx context.Context, in *CreateUtxoTransactionRequest, opts ...grpc.CallOption) (*CreateUtxoTransactionReply, error)
+	BuildUtxoTransaction(ctx context.Context, in *BuildUtxoTransactionRequest, opts ...grpc.CallOption) (*BuildUtxoTransactionReply, error)
+	BumpUtxoFee(ctx context.Context, in *BumpUtxoFeeRequest, opts ...grpc.CallOption) (*BumpUtxoFeeReply, error)
+	BuildCpfpTransaction(ctx context.Context, in *BuildCpfpTransactionRequest, opts ...grpc.CallOption) (*BuildCpfpTransactionReply, error)
+	BuildSweepTransaction(ctx context.Context, in *BuildSweepTransactionRequest, opts ...grpc.CallOption) (*BuildSweepTransactionReply, error)
+	BuildPayoutBatch(ctx context.Context, in *BuildPayoutBatchRequest, opts ...grpc.CallOption) (*BuildPayoutBatchReply, error)
 	QueryUtxo(ctx context.Context, in *QueryUtxoRequest, opts ...grpc.CallOption) (*QueryUtxoReply, error)
 	QueryUtxoInsFromData(ctx context.Context, in *QueryUtxoInsFromDataRequest, opts ...grpc.CallOption) (*QueryUtxoInsReply, error)
+	ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosReply, error)
+	CheckDoubleSpend(ctx context.Context, in *CheckDoubleSpendRequest, opts ...grpc.CallOption) (*CheckDoubleSpendReply, error)
 	QueryAccountTransaction(ctx context.Context, in *QueryTransactionRequest, opts ...grpc.CallOption) (*QueryAccountTransactionReply, error)
 	QueryUtxoTransaction(ctx context.Context, in *QueryTransactionRequest, opts ...grpc.CallOption) (*QueryUtxoTransactionReply, error)
 	QueryAccountTransactionFromData(ctx context.Context, in *QueryTransactionFromDataRequest, opts ...grpc.CallOption) (*QueryAccountTransactionReply, error)
@@ -2495,9 +5442,12 @@ type ChainnodeClient interface {
 	QueryUtxoTransactionFromSignedData(ctx context.Context, in *QueryTransactionFromSignedDataRequest, opts ...grpc.CallOption) (*QueryUtxoTransactionReply, error)
 	QueryBalance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceReply, error)
 	QueryGasPrice(ctx context.Context, in *QueryGasPriceRequest, opts ...grpc.CallOption) (*QueryGasPriceReply, error)
+	EstimateUtxoFee(ctx context.Context, in *EstimateUtxoFeeRequest, opts ...grpc.CallOption) (*EstimateUtxoFeeReply, error)
 	QueryNonce(ctx context.Context, in *QueryNonceRequest, opts ...grpc.CallOption) (*QueryNonceReply, error)
 	VerifyAccountSignedTransaction(ctx context.Context, in *VerifySignedTransactionRequest, opts ...grpc.CallOption) (*VerifySignedTransactionReply, error)
 	VerifyUtxoSignedTransaction(ctx context.Context, in *VerifySignedTransactionRequest, opts ...grpc.CallOption) (*VerifySignedTransactionReply, error)
+	GetTxInclusionProof(ctx context.Context, in *GetTxInclusionProofRequest, opts ...grpc.CallOption) (*GetTxInclusionProofReply, error)
+	VerifyTxInclusionProof(ctx context.Context, in *VerifyTxInclusionProofRequest, opts ...grpc.CallOption) (*VerifyTxInclusionProofReply, error)
 }
 
 type chainnodeClient struct {
@@ -2517,6 +5467,15 @@ func (c *chainnodeClient) BroadcastTransaction(ctx context.Context, in *Broadcas
 	return out, nil
 }
 
+func (c *chainnodeClient) DryRunBroadcast(ctx context.Context, in *BroadcastTransactionRequest, opts ...grpc.CallOption) (*DryRunBroadcastReply, error) {
+	out := new(DryRunBroadcastReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/DryRunBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainnodeClient) ConvertAddress(ctx context.Context, in *ConvertAddressRequest, opts ...grpc.CallOption) (*ConvertAddressReply, error) {
 	out := new(ConvertAddressReply)
 	err := c.cc.Invoke(ctx, "/proto.Chainnode/ConvertAddress", in, out, opts...)
@@ -2580,6 +5539,51 @@ func (c *chainnodeClient) CreateUtxoTransaction(ctx context.Context, in *CreateU
 	return out, nil
 }
 
+func (c *chainnodeClient) BuildUtxoTransaction(ctx context.Context, in *BuildUtxoTransactionRequest, opts ...grpc.CallOption) (*BuildUtxoTransactionReply, error) {
+	out := new(BuildUtxoTransactionReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/BuildUtxoTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) BumpUtxoFee(ctx context.Context, in *BumpUtxoFeeRequest, opts ...grpc.CallOption) (*BumpUtxoFeeReply, error) {
+	out := new(BumpUtxoFeeReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/BumpUtxoFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) BuildCpfpTransaction(ctx context.Context, in *BuildCpfpTransactionRequest, opts ...grpc.CallOption) (*BuildCpfpTransactionReply, error) {
+	out := new(BuildCpfpTransactionReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/BuildCpfpTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) BuildSweepTransaction(ctx context.Context, in *BuildSweepTransactionRequest, opts ...grpc.CallOption) (*BuildSweepTransactionReply, error) {
+	out := new(BuildSweepTransactionReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/BuildSweepTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) BuildPayoutBatch(ctx context.Context, in *BuildPayoutBatchRequest, opts ...grpc.CallOption) (*BuildPayoutBatchReply, error) {
+	out := new(BuildPayoutBatchReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/BuildPayoutBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainnodeClient) QueryUtxo(ctx context.Context, in *QueryUtxoRequest, opts ...grpc.CallOption) (*QueryUtxoReply, error) {
 	out := new(QueryUtxoReply)
 	err := c.cc.Invoke(ctx, "/proto.Chainnode/QueryUtxo", in, out, opts...)
@@ -2598,6 +5602,24 @@ func (c *chainnodeClient) QueryUtxoInsFromData(ctx context.Context, in *QueryUtx
 	return out, nil
 }
 
+func (c *chainnodeClient) ListUtxos(ctx context.Context, in *ListUtxosRequest, opts ...grpc.CallOption) (*ListUtxosReply, error) {
+	out := new(ListUtxosReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/ListUtxos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) CheckDoubleSpend(ctx context.Context, in *CheckDoubleSpendRequest, opts ...grpc.CallOption) (*CheckDoubleSpendReply, error) {
+	out := new(CheckDoubleSpendReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/CheckDoubleSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainnodeClient) QueryAccountTransaction(ctx context.Context, in *QueryTransactionRequest, opts ...grpc.CallOption) (*QueryAccountTransactionReply, error) {
 	out := new(QueryAccountTransactionReply)
 	err := c.cc.Invoke(ctx, "/proto.Chainnode/QueryAccountTransaction", in, out, opts...)
@@ -2670,6 +5692,15 @@ func (c *chainnodeClient) QueryGasPrice(ctx context.Context, in *QueryGasPriceRe
 	return out, nil
 }
 
+func (c *chainnodeClient) EstimateUtxoFee(ctx context.Context, in *EstimateUtxoFeeRequest, opts ...grpc.CallOption) (*EstimateUtxoFeeReply, error) {
+	out := new(EstimateUtxoFeeReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/EstimateUtxoFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chainnodeClient) QueryNonce(ctx context.Context, in *QueryNonceRequest, opts ...grpc.CallOption) (*QueryNonceReply, error) {
 	out := new(QueryNonceReply)
 	err := c.cc.Invoke(ctx, "/proto.Chainnode/QueryNonce", in, out, opts...)
@@ -2697,9 +5728,28 @@ func (c *chainnodeClient) VerifyUtxoSignedTransaction(ctx context.Context, in *V
 	return out, nil
 }
 
+func (c *chainnodeClient) GetTxInclusionProof(ctx context.Context, in *GetTxInclusionProofRequest, opts ...grpc.CallOption) (*GetTxInclusionProofReply, error) {
+	out := new(GetTxInclusionProofReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/GetTxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainnodeClient) VerifyTxInclusionProof(ctx context.Context, in *VerifyTxInclusionProofRequest, opts ...grpc.CallOption) (*VerifyTxInclusionProofReply, error) {
+	out := new(VerifyTxInclusionProofReply)
+	err := c.cc.Invoke(ctx, "/proto.Chainnode/VerifyTxInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChainnodeServer is the server API for Chainnode service.
 type ChainnodeServer interface {
 	BroadcastTransaction(context.Context, *BroadcastTransactionRequest) (*BroadcastTransactionReply, error)
+	DryRunBroadcast(context.Context, *BroadcastTransactionRequest) (*DryRunBroadcastReply, error)
 	ConvertAddress(context.Context, *ConvertAddressRequest) (*ConvertAddressReply, error)
 	SupportChain(context.Context, *SupportChainRequest) (*SupportChainReply, error)
 	ValidAddress(context.Context, *ValidAddressRequest) (*ValidAddressReply, error)
@@ -2707,8 +5757,15 @@ type ChainnodeServer interface {
 	CreateAccountTransaction(context.Context, *CreateAccountTransactionRequest) (*CreateAccountTransactionReply, error)
 	CreateUtxoSignedTransaction(context.Context, *CreateUtxoSignedTransactionRequest) (*CreateSignedTransactionReply, error)
 	CreateUtxoTransaction(context.Context, *CreateUtxoTransactionRequest) (*CreateUtxoTransactionReply, error)
+	BuildUtxoTransaction(context.Context, *BuildUtxoTransactionRequest) (*BuildUtxoTransactionReply, error)
+	BumpUtxoFee(context.Context, *BumpUtxoFeeRequest) (*BumpUtxoFeeReply, error)
+	BuildCpfpTransaction(context.Context, *BuildCpfpTransactionRequest) (*BuildCpfpTransactionReply, error)
+	BuildSweepTransaction(context.Context, *BuildSweepTransactionRequest) (*BuildSweepTransactionReply, error)
+	BuildPayoutBatch(context.Context, *BuildPayoutBatchRequest) (*BuildPayoutBatchReply, error)
 	QueryUtxo(context.Context, *QueryUtxoRequest) (*QueryUtxoReply, error)
 	QueryUtxoInsFromData(context.Context, *QueryUtxoInsFromDataRequest) (*QueryUtxoInsReply, error)
+	ListUtxos(context.Context, *ListUtxosRequest) (*ListUtxosReply, error)
+	CheckDoubleSpend(context.Context, *CheckDoubleSpendRequest) (*CheckDoubleSpendReply, error)
 	QueryAccountTransaction(context.Context, *QueryTransactionRequest) (*QueryAccountTransactionReply, error)
 	QueryUtxoTransaction(context.Context, *QueryTransactionRequest) (*QueryUtxoTransactionReply, error)
 	QueryAccountTransactionFromData(context.Context, *QueryTransactionFromDataRequest) (*QueryAccountTransactionReply, error)
@@ -2717,9 +5774,12 @@ type ChainnodeServer interface {
 	QueryUtxoTransactionFromSignedData(context.Context, *QueryTransactionFromSignedDataRequest) (*QueryUtxoTransactionReply, error)
 	QueryBalance(context.Context, *QueryBalanceRequest) (*QueryBalanceReply, error)
 	QueryGasPrice(context.Context, *QueryGasPriceRequest) (*QueryGasPriceReply, error)
+	EstimateUtxoFee(context.Context, *EstimateUtxoFeeRequest) (*EstimateUtxoFeeReply, error)
 	QueryNonce(context.Context, *QueryNonceRequest) (*QueryNonceReply, error)
 	VerifyAccountSignedTransaction(context.Context, *VerifySignedTransactionRequest) (*VerifySignedTransactionReply, error)
 	VerifyUtxoSignedTransaction(context.Context, *VerifySignedTransactionRequest) (*VerifySignedTransactionReply, error)
+	GetTxInclusionProof(context.Context, *GetTxInclusionProofRequest) (*GetTxInclusionProofReply, error)
+	VerifyTxInclusionProof(context.Context, *VerifyTxInclusionProofRequest) (*VerifyTxInclusionProofReply, error)
 }
 
 // UnimplementedChainnodeServer can be embedded to have forward compatible implementations.
@@ -2729,6 +5789,9 @@ type UnimplementedChainnodeServer struct {
 func (*UnimplementedChainnodeServer) BroadcastTransaction(ctx context.Context, req *BroadcastTransactionRequest) (*BroadcastTransactionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BroadcastTransaction not implemented")
 }
+func (*UnimplementedChainnodeServer) DryRunBroadcast(ctx context.Context, req *BroadcastTransactionRequest) (*DryRunBroadcastReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunBroadcast not implemented")
+}
 func (*UnimplementedChainnodeServer) ConvertAddress(ctx context.Context, req *ConvertAddressRequest) (*ConvertAddressReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConvertAddress not implemented")
 }
@@ -2750,12 +5813,33 @@ func (*UnimplementedChainnodeServer) CreateUtxoSignedTransaction(ctx context.Con
 func (*UnimplementedChainnodeServer) CreateUtxoTransaction(ctx context.Context, req *CreateUtxoTransactionRequest) (*CreateUtxoTransactionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUtxoTransaction not implemented")
 }
+func (*UnimplementedChainnodeServer) BuildUtxoTransaction(ctx context.Context, req *BuildUtxoTransactionRequest) (*BuildUtxoTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildUtxoTransaction not implemented")
+}
+func (*UnimplementedChainnodeServer) BumpUtxoFee(ctx context.Context, req *BumpUtxoFeeRequest) (*BumpUtxoFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpUtxoFee not implemented")
+}
+func (*UnimplementedChainnodeServer) BuildCpfpTransaction(ctx context.Context, req *BuildCpfpTransactionRequest) (*BuildCpfpTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildCpfpTransaction not implemented")
+}
+func (*UnimplementedChainnodeServer) BuildSweepTransaction(ctx context.Context, req *BuildSweepTransactionRequest) (*BuildSweepTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildSweepTransaction not implemented")
+}
+func (*UnimplementedChainnodeServer) BuildPayoutBatch(ctx context.Context, req *BuildPayoutBatchRequest) (*BuildPayoutBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildPayoutBatch not implemented")
+}
 func (*UnimplementedChainnodeServer) QueryUtxo(ctx context.Context, req *QueryUtxoRequest) (*QueryUtxoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUtxo not implemented")
 }
 func (*UnimplementedChainnodeServer) QueryUtxoInsFromData(ctx context.Context, req *QueryUtxoInsFromDataRequest) (*QueryUtxoInsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryUtxoInsFromData not implemented")
 }
+func (*UnimplementedChainnodeServer) ListUtxos(ctx context.Context, req *ListUtxosRequest) (*ListUtxosReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUtxos not implemented")
+}
+func (*UnimplementedChainnodeServer) CheckDoubleSpend(ctx context.Context, req *CheckDoubleSpendRequest) (*CheckDoubleSpendReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDoubleSpend not implemented")
+}
 func (*UnimplementedChainnodeServer) QueryAccountTransaction(ctx context.Context, req *QueryTransactionRequest) (*QueryAccountTransactionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryAccountTransaction not implemented")
 }
@@ -2780,6 +5864,9 @@ func (*UnimplementedChainnodeServer) QueryBalance(ctx context.Context, req *Quer
 func (*UnimplementedChainnodeServer) QueryGasPrice(ctx context.Context, req *QueryGasPriceRequest) (*QueryGasPriceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryGasPrice not implemented")
 }
+func (*UnimplementedChainnodeServer) EstimateUtxoFee(ctx context.Context, req *EstimateUtxoFeeRequest) (*EstimateUtxoFeeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateUtxoFee not implemented")
+}
 func (*UnimplementedChainnodeServer) QueryNonce(ctx context.Context, req *QueryNonceRequest) (*QueryNonceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryNonce not implemented")
 }
@@ -2789,6 +5876,12 @@ func (*UnimplementedChainnodeServer) VerifyAccountSignedTransaction(ctx context.
 func (*UnimplementedChainnodeServer) VerifyUtxoSignedTransaction(ctx context.Context, req *VerifySignedTransactionRequest) (*VerifySignedTransactionReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyUtxoSignedTransaction not implemented")
 }
+func (*UnimplementedChainnodeServer) GetTxInclusionProof(ctx context.Context, req *GetTxInclusionProofRequest) (*GetTxInclusionProofReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTxInclusionProof not implemented")
+}
+func (*UnimplementedChainnodeServer) VerifyTxInclusionProof(ctx context.Context, req *VerifyTxInclusionProofRequest) (*VerifyTxInclusionProofReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTxInclusionProof not implemented")
+}
 
 func RegisterChainnodeServer(s *grpc.Server, srv ChainnodeServer) {
 	s.RegisterService(&_Chainnode_serviceDesc, srv)
@@ -2812,6 +5905,24 @@ func _Chainnode_BroadcastTransaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chainnode_DryRunBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BroadcastTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).DryRunBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/DryRunBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).DryRunBroadcast(ctx, req.(*BroadcastTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chainnode_ConvertAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ConvertAddressRequest)
 	if err := dec(in); err != nil {
@@ -2938,6 +6049,96 @@ func _Chainnode_CreateUtxoTransaction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chainnode_BuildUtxoTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildUtxoTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).BuildUtxoTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/BuildUtxoTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).BuildUtxoTransaction(ctx, req.(*BuildUtxoTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_BumpUtxoFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpUtxoFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).BumpUtxoFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/BumpUtxoFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).BumpUtxoFee(ctx, req.(*BumpUtxoFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_BuildCpfpTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildCpfpTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).BuildCpfpTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/BuildCpfpTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).BuildCpfpTransaction(ctx, req.(*BuildCpfpTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_BuildSweepTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildSweepTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).BuildSweepTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/BuildSweepTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).BuildSweepTransaction(ctx, req.(*BuildSweepTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_BuildPayoutBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildPayoutBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).BuildPayoutBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/BuildPayoutBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).BuildPayoutBatch(ctx, req.(*BuildPayoutBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chainnode_QueryUtxo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryUtxoRequest)
 	if err := dec(in); err != nil {
@@ -2974,6 +6175,42 @@ func _Chainnode_QueryUtxoInsFromData_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chainnode_ListUtxos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtxosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).ListUtxos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/ListUtxos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).ListUtxos(ctx, req.(*ListUtxosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_CheckDoubleSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDoubleSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).CheckDoubleSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/CheckDoubleSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).CheckDoubleSpend(ctx, req.(*CheckDoubleSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chainnode_QueryAccountTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTransactionRequest)
 	if err := dec(in); err != nil {
@@ -3118,6 +6355,24 @@ func _Chainnode_QueryGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chainnode_EstimateUtxoFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateUtxoFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).EstimateUtxoFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/EstimateUtxoFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).EstimateUtxoFee(ctx, req.(*EstimateUtxoFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chainnode_QueryNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryNonceRequest)
 	if err := dec(in); err != nil {
@@ -3172,6 +6427,42 @@ func _Chainnode_VerifyUtxoSignedTransaction_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chainnode_GetTxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).GetTxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/GetTxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).GetTxInclusionProof(ctx, req.(*GetTxInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chainnode_VerifyTxInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTxInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainnodeServer).VerifyTxInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Chainnode/VerifyTxInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainnodeServer).VerifyTxInclusionProof(ctx, req.(*VerifyTxInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Chainnode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "proto.Chainnode",
 	HandlerType: (*ChainnodeServer)(nil),
@@ -3180,6 +6471,10 @@ var _Chainnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BroadcastTransaction",
 			Handler:    _Chainnode_BroadcastTransaction_Handler,
 		},
+		{
+			MethodName: "DryRunBroadcast",
+			Handler:    _Chainnode_DryRunBroadcast_Handler,
+		},
 		{
 			MethodName: "ConvertAddress",
 			Handler:    _Chainnode_ConvertAddress_Handler,
@@ -3208,6 +6503,26 @@ var _Chainnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUtxoTransaction",
 			Handler:    _Chainnode_CreateUtxoTransaction_Handler,
 		},
+		{
+			MethodName: "BuildUtxoTransaction",
+			Handler:    _Chainnode_BuildUtxoTransaction_Handler,
+		},
+		{
+			MethodName: "BumpUtxoFee",
+			Handler:    _Chainnode_BumpUtxoFee_Handler,
+		},
+		{
+			MethodName: "BuildCpfpTransaction",
+			Handler:    _Chainnode_BuildCpfpTransaction_Handler,
+		},
+		{
+			MethodName: "BuildSweepTransaction",
+			Handler:    _Chainnode_BuildSweepTransaction_Handler,
+		},
+		{
+			MethodName: "BuildPayoutBatch",
+			Handler:    _Chainnode_BuildPayoutBatch_Handler,
+		},
 		{
 			MethodName: "QueryUtxo",
 			Handler:    _Chainnode_QueryUtxo_Handler,
@@ -3216,6 +6531,14 @@ var _Chainnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryUtxoInsFromData",
 			Handler:    _Chainnode_QueryUtxoInsFromData_Handler,
 		},
+		{
+			MethodName: "ListUtxos",
+			Handler:    _Chainnode_ListUtxos_Handler,
+		},
+		{
+			MethodName: "CheckDoubleSpend",
+			Handler:    _Chainnode_CheckDoubleSpend_Handler,
+		},
 		{
 			MethodName: "QueryAccountTransaction",
 			Handler:    _Chainnode_QueryAccountTransaction_Handler,
@@ -3248,6 +6571,10 @@ var _Chainnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryGasPrice",
 			Handler:    _Chainnode_QueryGasPrice_Handler,
 		},
+		{
+			MethodName: "EstimateUtxoFee",
+			Handler:    _Chainnode_EstimateUtxoFee_Handler,
+		},
 		{
 			MethodName: "QueryNonce",
 			Handler:    _Chainnode_QueryNonce_Handler,
@@ -3260,6 +6587,14 @@ var _Chainnode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyUtxoSignedTransaction",
 			Handler:    _Chainnode_VerifyUtxoSignedTransaction_Handler,
 		},
+		{
+			MethodName: "GetTxInclusionProof",
+			Handler:    _Chainnode_GetTxInclusionProof_Handler,
+		},
+		{
+			MethodName: "VerifyTxInclusionProof",
+			Handler:    _Chainnode_VerifyTxInclusionProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/chainnode.proto",